@@ -0,0 +1,143 @@
+//go:build js && wasm
+
+// Command stringinspect-wasm exposes the analysis to JavaScript, for a
+// browser playground built on the same engine as the TUI. Loaded with
+// wasm_exec.js it defines a global stringinspect object:
+//
+//	stringinspect.analyze(text)          // the JSON export of text
+//	stringinspect.lookup(query, limit?)  // characters by codepoint or name
+//	stringinspect.normalize(text, form?) // {text, changed}; form defaults to "NFC"
+//
+// Each returns a plain object, or {error: message} when the arguments are
+// invalid.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"syscall/js"
+
+	"stringinspect/internal/analysis"
+	"stringinspect/internal/export"
+)
+
+// lookupLimit is the number of name matches lookup returns by default.
+const lookupLimit = 20
+
+// charInfo describes a character found by lookup.
+type charInfo struct {
+	export.JSONCharacter
+	Name        string `json:"name"`
+	Category    string `json:"category"`
+	Script      string `json:"script"`
+	Block       string `json:"block"`
+	Explanation string `json:"explanation"`
+}
+
+func main() {
+	js.Global().Set("stringinspect", map[string]any{
+		"analyze":   jsFunc(analyze),
+		"lookup":    jsFunc(lookup),
+		"normalize": jsFunc(normalize),
+	})
+	select {} // Keep the functions callable
+}
+
+// jsFunc wraps fn as a JavaScript function returning fn's result as a
+// plain object, converted through JSON.
+func jsFunc(fn func(args []js.Value) (any, error)) js.Func {
+	return js.FuncOf(func(_ js.Value, args []js.Value) any {
+		v, err := fn(args)
+		if err != nil {
+			return map[string]any{"error": err.Error()}
+		}
+		data, err := json.Marshal(v)
+		if err != nil {
+			return map[string]any{"error": err.Error()}
+		}
+		return js.Global().Get("JSON").Call("parse", string(data))
+	})
+}
+
+// stringArg returns the i-th argument, which must be a string unless it is
+// optional and missing.
+func stringArg(args []js.Value, i int, optional bool) (string, error) {
+	if i >= len(args) || args[i].IsUndefined() {
+		if optional {
+			return "", nil
+		}
+		return "", fmt.Errorf("argument %d is missing", i+1)
+	}
+	if args[i].Type() != js.TypeString {
+		return "", fmt.Errorf("argument %d is not a string", i+1)
+	}
+	return args[i].String(), nil
+}
+
+// analyze returns the JSON export of text: every character with its
+// encodings, and the summary.
+func analyze(args []js.Value) (any, error) {
+	text, err := stringArg(args, 0, false)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := export.NewExporter().Write(&buf, analysis.Analyze(text), export.FormatJSON); err != nil {
+		return nil, err
+	}
+	return json.RawMessage(buf.Bytes()), nil
+}
+
+// lookup describes the characters found by codepoint (U+00A0, 0xA0), as a
+// lone character, or by name.
+func lookup(args []js.Value) (any, error) {
+	query, err := stringArg(args, 0, false)
+	if err != nil {
+		return nil, err
+	}
+	limit := lookupLimit
+	if len(args) > 1 && args[1].Type() == js.TypeNumber {
+		limit = max(args[1].Int(), 1)
+	}
+	runes, err := analysis.Lookup(query, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := []charInfo{}
+	for _, r := range runes {
+		c := analysis.Analyze(string(r))[0]
+		info := charInfo{
+			JSONCharacter: export.NewJSONCharacter(c),
+			Name:          analysis.Name(r),
+			Category:      analysis.Category(r),
+			Script:        analysis.Script(r),
+			Block:         analysis.BlockName(r),
+			Explanation:   analysis.Explain(r),
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// normalize returns text in a normalization form, NFC by default.
+func normalize(args []js.Value) (any, error) {
+	text, err := stringArg(args, 0, false)
+	if err != nil {
+		return nil, err
+	}
+	name, err := stringArg(args, 1, true)
+	if err != nil {
+		return nil, err
+	}
+	if name == "" {
+		name = "NFC"
+	}
+	form, err := analysis.ParseNormalForm(name)
+	if err != nil {
+		return nil, err
+	}
+	out := form.String(text)
+	return map[string]any{"text": out, "changed": out != text}, nil
+}
@@ -0,0 +1,205 @@
+package analysis
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// DiffOp is how a position of an alignment relates two texts.
+type DiffOp int
+
+const (
+	DiffSame     DiffOp = iota // The same character in both
+	DiffChanged                // A different character in each
+	DiffDeleted                // A character only in the first text
+	DiffInserted               // A character only in the second text
+)
+
+// String returns the name of the operation.
+func (op DiffOp) String() string {
+	switch op {
+	case DiffChanged:
+		return "changed"
+	case DiffDeleted:
+		return "deleted"
+	case DiffInserted:
+		return "inserted"
+	default:
+		return "same"
+	}
+}
+
+// AlignedPair is a position in the alignment of two texts.
+type AlignedPair struct {
+	Op           DiffOp
+	A, B         int  // Rune offsets in each text, -1 for the side without one
+	RuneA, RuneB rune // Characters at A and B
+	Class        string
+}
+
+// maxAlignCells bounds the table aligning the differing middle of two
+// texts; beyond it the middles are compared position by position.
+const maxAlignCells = 1 << 22
+
+// AlignTexts aligns the runes of two texts along their longest common
+// subsequence, pairing runes that were replaced rather than reporting a
+// deletion and an insertion. Each differing position is classified by
+// DiffClass, or for a rune on one side only by what it is: "invisible",
+// "combining", or "line-ending".
+func AlignTexts(a, b string) []AlignedPair {
+	ra, rb := []rune(a), []rune(b)
+
+	prefix := 0
+	for prefix < len(ra) && prefix < len(rb) && ra[prefix] == rb[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(ra)-prefix && suffix < len(rb)-prefix && ra[len(ra)-1-suffix] == rb[len(rb)-1-suffix] {
+		suffix++
+	}
+
+	var ops []DiffOp
+	for range prefix {
+		ops = append(ops, DiffSame)
+	}
+	ops = append(ops, alignMiddle(ra[prefix:len(ra)-suffix], rb[prefix:len(rb)-suffix])...)
+	for range suffix {
+		ops = append(ops, DiffSame)
+	}
+	return pairOps(ops, ra, rb)
+}
+
+// alignMiddle returns the same, deleted, and inserted steps turning a into
+// b, from a longest common subsequence when the table fits.
+func alignMiddle(a, b []rune) []DiffOp {
+	var ops []DiffOp
+	if len(a)*len(b) > maxAlignCells {
+		n := min(len(a), len(b))
+		for i := range n {
+			if a[i] == b[i] {
+				ops = append(ops, DiffSame)
+			} else {
+				ops = append(ops, DiffDeleted, DiffInserted)
+			}
+		}
+		for range len(a) - n {
+			ops = append(ops, DiffDeleted)
+		}
+		for range len(b) - n {
+			ops = append(ops, DiffInserted)
+		}
+		return ops
+	}
+
+	// lcs[i*w+j] is the length of the longest common subsequence of
+	// a[i:] and b[j:]
+	w := len(b) + 1
+	lcs := make([]int32, (len(a)+1)*w)
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i*w+j] = lcs[(i+1)*w+j+1] + 1
+			} else {
+				lcs[i*w+j] = max(lcs[(i+1)*w+j], lcs[i*w+j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			ops = append(ops, DiffSame)
+			i++
+			j++
+		case j == len(b) || i < len(a) && lcs[(i+1)*w+j] >= lcs[i*w+j+1]:
+			ops = append(ops, DiffDeleted)
+			i++
+		default:
+			ops = append(ops, DiffInserted)
+			j++
+		}
+	}
+	return ops
+}
+
+// pairOps turns the steps into aligned pairs, pairing the deletions and
+// insertions between two runs of matching runes in order as changes.
+func pairOps(ops []DiffOp, a, b []rune) []AlignedPair {
+	var pairs []AlignedPair
+	i, j := 0, 0
+	for k := 0; k < len(ops); {
+		if ops[k] == DiffSame {
+			pairs = append(pairs, AlignedPair{Op: DiffSame, A: i, B: j, RuneA: a[i], RuneB: b[j]})
+			i, j, k = i+1, j+1, k+1
+			continue
+		}
+
+		deleted, inserted := 0, 0
+		for ; k < len(ops) && ops[k] != DiffSame; k++ {
+			if ops[k] == DiffDeleted {
+				deleted++
+			} else {
+				inserted++
+			}
+		}
+		for n := range min(deleted, inserted) {
+			ra, rb := a[i+n], b[j+n]
+			pairs = append(pairs, AlignedPair{Op: DiffChanged, A: i + n, B: j + n, RuneA: ra, RuneB: rb, Class: DiffClass(ra, rb)})
+		}
+		for n := inserted; n < deleted; n++ {
+			r := a[i+n]
+			pairs = append(pairs, AlignedPair{Op: DiffDeleted, A: i + n, B: -1, RuneA: r, Class: unpairedClass(r)})
+		}
+		for n := deleted; n < inserted; n++ {
+			r := b[j+n]
+			pairs = append(pairs, AlignedPair{Op: DiffInserted, A: -1, B: j + n, RuneB: r, Class: unpairedClass(r)})
+		}
+		i, j = i+deleted, j+inserted
+	}
+	return pairs
+}
+
+// DiffClass explains why two different characters may be taken for each
+// other: "whitespace", "case", "compatibility" (the same after NFKC),
+// "lookalike" (the same UTS #39 skeleton), "dash", or "quote". It is ""
+// when nothing relates them.
+func DiffClass(a, b rune) string {
+	sa, sb := string(a), string(b)
+	switch {
+	case isSpaceLike(a) && isSpaceLike(b):
+		return "whitespace"
+	case strings.EqualFold(sa, sb):
+		return "case"
+	case norm.NFKC.String(sa) == norm.NFKC.String(sb):
+		return "compatibility"
+	case Skeleton(sa) == Skeleton(sb):
+		return "lookalike"
+	case unicode.Is(unicode.Pd, a) && unicode.Is(unicode.Pd, b):
+		return "dash"
+	case unicode.Is(unicode.Quotation_Mark, a) && unicode.Is(unicode.Quotation_Mark, b):
+		return "quote"
+	}
+	return ""
+}
+
+// unpairedClass describes a character only one text has, "" if it is
+// nothing special.
+func unpairedClass(r rune) string {
+	switch {
+	case r == '\r':
+		return "line-ending"
+	case isInvisible(r) || isBidiControl(r) || r == 0xFEFF:
+		return "invisible"
+	case unicode.In(r, unicode.Mn, unicode.Me):
+		return "combining"
+	}
+	return ""
+}
+
+// isSpaceLike reports whether r is whitespace of any kind.
+func isSpaceLike(r rune) bool {
+	return unicode.IsSpace(r) || unicode.Is(unicode.Zs, r)
+}
@@ -5,7 +5,9 @@ import (
 )
 
 // Analyzer handles string analysis operations.
-type Analyzer struct{}
+type Analyzer struct {
+	Placeholders Placeholders // How non-printable characters appear in Char
+}
 
 // NewAnalyzer creates a new Analyzer instance.
 func NewAnalyzer() *Analyzer {
@@ -37,7 +39,7 @@ func (a *Analyzer) AnalyzeString(input string) []Character {
 
 		char := Character{
 			Rune:       r,
-			Char:       displayChar(r),
+			Char:       a.Placeholders.Display(r),
 			Hex:        fmt.Sprintf("%02X", r),
 			Dec:        int(r),
 			Bin:        formatBinary(r),
@@ -69,7 +71,7 @@ func (a *Analyzer) AnalyzeBytes(input []byte) []Character {
 		r := rune(b)
 		char := Character{
 			Rune:       r,
-			Char:       displayChar(r),
+			Char:       a.Placeholders.Display(r),
 			Hex:        fmt.Sprintf("%02X", b),
 			Dec:        int(b),
 			Bin:        formatBinaryByte(b),
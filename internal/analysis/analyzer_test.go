@@ -1,7 +1,19 @@
 package analysis
 
 import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
 	"testing"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/unicode/runenames"
 )
 
 func TestAnalyzeString(t *testing.T) {
@@ -153,3 +165,936 @@ func TestAnalyze(t *testing.T) {
 		t.Errorf("Analyze('test') len = %d, want 4", len(chars))
 	}
 }
+
+func TestScript(t *testing.T) {
+	tests := []struct {
+		r    rune
+		want string
+	}{
+		{'A', "Latin"},
+		{'1', "Common"},
+		{'é', "Latin"},
+		{'а', "Cyrillic"}, // U+0430
+		{'日', "Han"},
+		{'ש', "Hebrew"},
+	}
+
+	for _, tt := range tests {
+		if got := Script(tt.r); got != tt.want {
+			t.Errorf("Script(%U) = %s, want %s", tt.r, got, tt.want)
+		}
+	}
+}
+
+func TestRuneWarnings(t *testing.T) {
+	tests := []struct {
+		r    rune
+		want Warning
+	}{
+		{0x200B, WarningInvisible},
+		{0x202E, WarningBidiControl},
+		{0x00A0, WarningUnusualWhitespace},
+		{0xFEFF, WarningBOM},
+		{0xFFFD, WarningReplacement},
+		{0xE000, WarningPrivateUse},
+		{0x1B, WarningControl},
+	}
+
+	for _, tt := range tests {
+		warnings := RuneWarnings(tt.r)
+		if len(warnings) == 0 || warnings[0] != tt.want {
+			t.Errorf("RuneWarnings(%U) = %v, want %v first", tt.r, warnings, tt.want)
+		}
+	}
+
+	for _, r := range "Hello, world!\t\r\n" {
+		if warnings := RuneWarnings(r); len(warnings) != 0 {
+			t.Errorf("RuneWarnings(%U) = %v, want none", r, warnings)
+		}
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	s := Summarize(Analyze("a\r\nb\nc\rд\u200B"))
+
+	if s.Characters != 9 {
+		t.Errorf("Characters = %d, want 9", s.Characters)
+	}
+	if s.Bytes != 12 {
+		t.Errorf("Bytes = %d, want 12", s.Bytes)
+	}
+	if s.LineEndings != (LineEndings{LF: 1, CRLF: 1, CR: 1}) {
+		t.Errorf("LineEndings = %+v, want LF 1, CRLF 1, CR 1", s.LineEndings)
+	}
+	if s.Lines() != 4 {
+		t.Errorf("Lines() = %d, want 4", s.Lines())
+	}
+	for text, want := range map[string]int{"": 0, "a": 1, "a\n": 1, "a\r\n": 1, "a\r": 1, "\n\n": 2, "a\nb": 2} {
+		if got := Summarize(Analyze(text)).Lines(); got != want {
+			t.Errorf("Lines() of %q = %d, want %d", text, got, want)
+		}
+	}
+	if s.Graphemes != 8 { // CRLF is a single grapheme cluster
+		t.Errorf("Graphemes = %d, want 8", s.Graphemes)
+	}
+	if s.Scripts["Cyrillic"] != 1 || s.Scripts["Latin"] != 3 {
+		t.Errorf("Scripts = %v", s.Scripts)
+	}
+	if s.ByteLengths[2] != 1 || s.ByteLengths[3] != 1 {
+		t.Errorf("ByteLengths = %v", s.ByteLengths)
+	}
+	if s.Warnings[WarningInvisible] != 1 {
+		t.Errorf("Warnings = %v", s.Warnings)
+	}
+}
+
+func TestSummaryAdd(t *testing.T) {
+	chars := Analyze("a\r\nb\ne\u0301\rд\u200B")
+	want := Summarize(chars)
+
+	// Split between grapheme clusters, the sums match the whole
+	for _, cut := range []int{0, 1, 3, 5, 7, len(chars)} {
+		got := Summarize(chars[:cut])
+		got.Add(Summarize(chars[cut:]))
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("summaries split at %d add up to %+v, want %+v", cut, got, want)
+		}
+	}
+}
+
+func TestUnique(t *testing.T) {
+	got := Unique(Analyze("abacä"))
+
+	want := []struct {
+		r     rune
+		count int
+		first int
+	}{{'a', 2, 0}, {'b', 1, 1}, {'c', 1, 3}, {'ä', 1, 4}}
+	if len(got) != len(want) {
+		t.Fatalf("Unique() returned %d entries, want %d", len(got), len(want))
+	}
+	for i, w := range want {
+		if got[i].Rune != w.r || got[i].Count != w.count || got[i].First != w.first {
+			t.Errorf("Unique()[%d] = %q x%d at %d, want %q x%d at %d",
+				i, got[i].Rune, got[i].Count, got[i].First, w.r, w.count, w.first)
+		}
+	}
+}
+
+func TestBlocks(t *testing.T) {
+	tests := []struct {
+		r    rune
+		want string
+	}{
+		{'A', "Basic Latin"},
+		{'é', "Latin-1 Supplement"},
+		{'д', "Cyrillic"},
+		{'→', "Arrows"},
+		{0x1F600, "Emoticons"},
+		{0x10FFFF, "Supplementary Private Use Area-B"},
+	}
+
+	for _, tt := range tests {
+		if got := BlockName(tt.r); got != tt.want {
+			t.Errorf("BlockName(%U) = %q, want %q", tt.r, got, tt.want)
+		}
+	}
+
+	if b, ok := FindBlock("latin_1_supplement"); !ok || b.Start != 0x80 {
+		t.Errorf("FindBlock(latin_1_supplement) = %v, %v", b, ok)
+	}
+	if s, ok := FindScript("CYRILLIC"); !ok || s != "Cyrillic" {
+		t.Errorf("FindScript(CYRILLIC) = %q, %v", s, ok)
+	}
+}
+
+func TestNameAndCategory(t *testing.T) {
+	if got := Name('—'); got != "EM DASH" {
+		t.Errorf("Name(U+2014) = %q, want %q", got, "EM DASH")
+	}
+	if !MatchesName('—', "em dash") {
+		t.Error("MatchesName(U+2014, \"em dash\") = false, want true")
+	}
+	if !MatchesName('\n', "LF") {
+		t.Error("MatchesName(LF, \"LF\") = false, want true")
+	}
+
+	tests := []struct {
+		r    rune
+		cat  string
+		want bool
+	}{
+		{'A', "Lu", true},
+		{'A', "L", true},
+		{'a', "lu", false},
+		{'\u200B', "Cf", true},
+		{'\U000E0080', "Cn", true},
+	}
+	for _, tt := range tests {
+		if got := MatchesCategory(tt.r, tt.cat); got != tt.want {
+			t.Errorf("MatchesCategory(%U, %q) = %v, want %v", tt.r, tt.cat, got, tt.want)
+		}
+	}
+}
+
+func TestSearchNames(t *testing.T) {
+	got := SearchNames("no-break space", 5)
+	if len(got) == 0 || got[0] != '\u00A0' {
+		t.Fatalf("SearchNames(\"no-break space\") = %U, want U+00A0 first", got)
+	}
+	for _, r := range got {
+		if !MatchesName(r, "NO-BREAK") {
+			t.Errorf("SearchNames returned %U (%s) without NO-BREAK", r, Name(r))
+		}
+	}
+	if got := SearchNames("latin small letter", 3); len(got) != 3 {
+		t.Errorf("SearchNames limit: got %d results, want 3", len(got))
+	}
+}
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		query string
+		want  rune
+	}{
+		{"U+00A0", '\u00A0'},
+		{"0x1f600", '😀'},
+		{"é", 'é'},
+		{"em dash", '—'},
+	}
+	for _, tt := range tests {
+		got, err := Lookup(tt.query, 5)
+		if err != nil || len(got) == 0 || got[0] != tt.want {
+			t.Errorf("Lookup(%q) = %U, %v, want %U first", tt.query, got, err, tt.want)
+		}
+	}
+	for _, query := range []string{"U+XYZ", "0x110000"} {
+		if _, err := Lookup(query, 5); err == nil {
+			t.Errorf("Lookup(%q) succeeded, want an error", query)
+		}
+	}
+}
+
+func TestNormDiffs(t *testing.T) {
+	got := NormDiffs("cafe\u0301\nn\u0303o \u212B", norm.NFC)
+	want := []NormDiff{
+		{RuneOffset: 3, ByteOffset: 3, Line: 1, Column: 4, Original: "e\u0301", Normalized: "é"},
+		{RuneOffset: 6, ByteOffset: 7, Line: 2, Column: 1, Original: "n\u0303", Normalized: "ñ"},
+		{RuneOffset: 10, ByteOffset: 12, Line: 2, Column: 5, Original: "\u212B", Normalized: "Å"},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("NormDiffs() = %+v, want %+v", got, want)
+	}
+
+	if got := NormDiffs("café ñ 😀", norm.NFC); len(got) != 0 {
+		t.Errorf("NormDiffs(NFC text) = %+v, want none", got)
+	}
+	if got := NormDiffs("é", norm.NFD); len(got) != 1 || got[0].Normalized != "e\u0301" {
+		t.Errorf("NormDiffs(é, NFD) = %+v, want e + U+0301", got)
+	}
+}
+
+func TestSpoofCheck(t *testing.T) {
+	tests := []struct {
+		input string
+		kinds []string
+	}{
+		{"paypal", nil},
+		{"p\u0430ypal", []string{"mixed-script", "confusable"}}, // Cyrillic а
+		{"Иван", nil},
+		{"\u0440\u0430\u0443", []string{"confusable", "confusable", "confusable"}}, // Cyrillic "pay"
+		{"ｐay", []string{"confusable"}},
+		{"田中さん", nil},
+		{"Tokyo東京", nil},
+		{"abc\u202Edef", []string{"bidi-control"}},
+		{"ad\u200Bmin", []string{"invisible"}},
+	}
+	for _, tt := range tests {
+		report := SpoofCheck(tt.input)
+		var kinds []string
+		for _, f := range report.Findings {
+			kinds = append(kinds, f.Kind)
+		}
+		if !slices.Equal(kinds, tt.kinds) {
+			t.Errorf("SpoofCheck(%q) findings = %v, want %v", tt.input, kinds, tt.kinds)
+		}
+		if report.Suspicious() != (len(tt.kinds) > 0) {
+			t.Errorf("SpoofCheck(%q).Suspicious() = %v", tt.input, report.Suspicious())
+		}
+	}
+
+	if got := SpoofCheck("Tokyo東京").Scripts; !slices.Equal(got, []string{"Han", "Latin"}) {
+		t.Errorf("SpoofCheck(Tokyo東京).Scripts = %v, want [Han Latin]", got)
+	}
+}
+
+func TestSkeleton(t *testing.T) {
+	same := [][2]string{
+		{"paypal", "p\u0430ypal"}, // Cyrillic а
+		{"paypal", "paypa1"},
+		{"paypal", "ｐａｙｐａｌ"},
+		{"admin", "ad\u200Bmin"},
+		{"modern", "rnodern"},
+		{"café", "cafe\u0301"},
+	}
+	for _, pair := range same {
+		if a, b := Skeleton(pair[0]), Skeleton(pair[1]); a != b {
+			t.Errorf("Skeleton(%q) = %q, Skeleton(%q) = %q, want equal", pair[0], a, pair[1], b)
+		}
+	}
+	if Skeleton("paypal") == Skeleton("paypai") {
+		t.Error("Skeleton() of different names should differ")
+	}
+}
+
+func TestResolveBidi(t *testing.T) {
+	tests := []struct {
+		input  string
+		rtl    bool
+		levels []int
+		visual string
+	}{
+		{"abc", false, []int{0, 0, 0}, "abc"},
+		{"ab אב", false, []int{0, 0, 0, 1, 1}, "ab בא"},
+		{"אב 12 ג", true, []int{1, 1, 1, 2, 2, 1, 1}, "ג 12 בא"},
+		{"x \u2067ab\u2069 y", false, []int{0, 0, 0, 2, 2, 0, 0, 0}, "x \u2067ab\u2069 y"},
+		{"a\u202Ebc\u202C d", false, []int{0, 0, 1, 1, 1, 0, 0}, "a\u202E\u202Ccb d"},
+		{"אב ", true, []int{1, 1, 1}, " בא"},
+	}
+	for _, tt := range tests {
+		paras := ResolveBidi(tt.input)
+		if len(paras) != 1 {
+			t.Errorf("ResolveBidi(%q) = %d paragraphs, want 1", tt.input, len(paras))
+			continue
+		}
+		p := paras[0]
+		runes := []rune(tt.input)
+		var visual strings.Builder
+		for _, i := range p.Visual() {
+			visual.WriteRune(runes[i])
+		}
+		if p.RTL != tt.rtl || !slices.Equal(p.Levels, tt.levels) || visual.String() != tt.visual {
+			t.Errorf("ResolveBidi(%q) = rtl %v, levels %v, visual %q, want %v, %v, %q",
+				tt.input, p.RTL, p.Levels, visual.String(), tt.rtl, tt.levels, tt.visual)
+		}
+	}
+
+	paras := ResolveBidi("ab\r\nאב\nc")
+	if len(paras) != 3 || paras[1].Start != 4 || !paras[1].RTL || paras[2].Start != 7 {
+		t.Errorf("ResolveBidi() of three lines = %+v", paras)
+	}
+}
+
+func TestLineBreaks(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []LineBreak
+	}{
+		{"hello world", []LineBreak{{Offset: 6}}},
+		{"a\nb", []LineBreak{{Offset: 2, Mandatory: true}}},
+		{"a\u00A0b", nil}, // No-break space
+		{"e\u0301 x", []LineBreak{{Offset: 3}}},
+		{"東京", []LineBreak{{Offset: 1}}},
+		{"trailing\n", nil},
+	}
+	for _, tt := range tests {
+		if got := LineBreaks(tt.input); !slices.Equal(got, tt.want) {
+			t.Errorf("LineBreaks(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestWordBreak(t *testing.T) {
+	tests := map[rune]string{
+		'a':      "ALetter",
+		'7':      "Numeric",
+		',':      "MidNum",
+		'.':      "MidNumLet",
+		':':      "MidLetter",
+		'\'':     "Single_Quote",
+		'_':      "ExtendNumLet",
+		' ':      "WSegSpace",
+		'\u00A0': "Other", // No-break space
+		'\u0301': "Extend",
+		'\u200D': "ZWJ",
+		'\u200B': "Other",
+		'\u00AD': "Format",
+		'א':      "Hebrew_Letter",
+		'カ':      "Katakana",
+		'か':      "Other",
+		'東':      "Other",
+		'ก':      "Other", // Thai is segmented by dictionary
+		'\r':     "CR",
+		'\u2028': "Newline",
+		'🇺':      "Regional_Indicator",
+		'ˇ':      "ALetter",
+	}
+	for r, want := range tests {
+		if got := WordBreak(r); got != want {
+			t.Errorf("WordBreak(%U) = %s, want %s", r, got, want)
+		}
+	}
+}
+
+func TestRegexClasses(t *testing.T) {
+	tests := []struct {
+		r    rune
+		want []string
+	}{
+		{'a', []string{`\w`, `[[:alpha:]]`, `[[:alnum:]]`, `.`, `\p{L}`}},
+		{'é', []string{`.`, `\p{L}`}}, // \w is ASCII only
+		{'٣', []string{`.`, `\p{N}`}},
+		{'\n', []string{`\s`, `[[:space:]]`, `\p{C}`}},
+		{'\u00A0', []string{`.`, `\p{Z}`}},
+		{'!', []string{`[[:punct:]]`, `.`, `\p{P}`}},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, c := range RegexClasses(tt.r) {
+			if c.Matches {
+				got = append(got, c.Pattern)
+			}
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("RegexClasses(%U) matches %v, want %v", tt.r, got, tt.want)
+		}
+	}
+}
+
+func TestLoadData(t *testing.T) {
+	defer func(b []Block, v string) {
+		blocks, dataVersion, loadedNames, loadedConfusables = b, v, nil, nil
+		loadedFiles, confusablesVersion = make(map[string]string), ""
+	}(blocks, dataVersion)
+
+	if DataVersion() != "14.0.0" {
+		t.Errorf("built-in DataVersion() = %q, want 14.0.0", DataVersion())
+	}
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"Blocks.txt":      "# Blocks-99.0.0.txt\n0000..007F; Basic Latin\n10FF00..10FFFF; Test Block\n",
+		"UnicodeData.txt": "0041;LATIN CAPITAL LETTER A;Lu;0;L;;;;;N;;;;0061;\n10FF41;TEST LETTER;Lo;0;L;;;;;N;;;;;\n4E00;<CJK Ideograph, First>;Lo;0;L;;;;;N;;;;;\n",
+		"confusables.txt": "\uFEFF# confusables.txt\n# Version: 99.0.0\n10FF41 ;\t0061 ;\tMA\t# TEST LETTER → a\n0031 ;\t006C ;\tMA\n",
+	}
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	loaded, err := LoadData(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(loaded) != 3 {
+		t.Errorf("LoadData loaded %v, want all three files", loaded)
+	}
+	if DataVersion() != "99.0.0" {
+		t.Errorf("DataVersion() = %q, want 99.0.0", DataVersion())
+	}
+	if b, ok := BlockOf(0x10FF41); !ok || b.Name != "Test Block" {
+		t.Errorf("BlockOf(U+10FF41) = %v, %v; want Test Block", b, ok)
+	}
+	if got := Name(0x10FF41); got != "TEST LETTER" {
+		t.Errorf("Name(U+10FF41) = %q, want TEST LETTER", got)
+	}
+	if got := Name(0x4E00); got != runenames.Name(0x4E00) {
+		t.Errorf("Name(U+4E00) = %q, want the built-in name", got)
+	}
+	if got, ok := Confusable(0x10FF41); got != "a" || !ok {
+		t.Errorf("Confusable(U+10FF41) = %q, %v; want a", got, ok)
+	}
+	if got, ok := Confusable('1'); ok {
+		t.Errorf("Confusable('1') = %q; ASCII characters are not confusables", got)
+	}
+	if got := Skeleton("\U0010FF41pp1e"); got != "apple" {
+		t.Errorf("Skeleton = %q, want apple", got)
+	}
+
+	for _, src := range DataSources() {
+		switch src.Data {
+		case "Block names", "Character names", "Lookalikes":
+			if src.Unicode != "99.0.0" || filepath.Dir(src.Source) != dir {
+				t.Errorf("%s from %s (Unicode %q), want the loaded file", src.Data, src.Source, src.Unicode)
+			}
+		}
+	}
+
+	if _, err := LoadData(t.TempDir()); err != nil {
+		t.Errorf("LoadData of an empty directory: %v", err)
+	}
+}
+
+func TestAlignTexts(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want []string // Differing pairs as "op a b class"
+	}{
+		{"same", "same", nil},
+		{"a\u00A0b", "a b", []string{"changed 1 1 whitespace"}},
+		{"paypal", "pаypal", []string{"changed 1 1 lookalike"}},
+		{"ab", "a\u200Bb", []string{"inserted -1 1 invisible"}},
+		{"line\r\n", "line\n", []string{"deleted 4 -1 line-ending"}},
+		{"Hello", "hello!", []string{"changed 0 0 case", "inserted -1 5 "}},
+		{"a–b", "a-b", []string{"changed 1 1 dash"}},
+		{"abcdef", "abXdef", []string{"changed 2 2 "}},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, p := range AlignTexts(tt.a, tt.b) {
+			if p.Op != DiffSame {
+				got = append(got, fmt.Sprintf("%s %d %d %s", p.Op, p.A, p.B, p.Class))
+			}
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("AlignTexts(%q, %q) = %q, want %q", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestUTF8Structure(t *testing.T) {
+	bits := UTF8Structure([]byte("é"))
+	if len(bits) != 2 {
+		t.Fatalf("UTF8Structure(é) returned %d bytes, want 2", len(bits))
+	}
+	if bits[0].Marker != "110" || bits[0].Payload != "00011" {
+		t.Errorf("lead byte = %+v, want marker 110 payload 00011", bits[0])
+	}
+	if bits[1].Marker != "10" || bits[1].Payload != "101001" {
+		t.Errorf("continuation byte = %+v, want marker 10 payload 101001", bits[1])
+	}
+	if got := UTF8Payload(bits); got != "00011101001" {
+		t.Errorf("UTF8Payload = %q, want %q", got, "00011101001")
+	}
+
+	if got := UTF8Structure([]byte("😀"))[0].Marker; got != "11110" {
+		t.Errorf("4-byte lead marker = %q, want 11110", got)
+	}
+}
+
+func TestExplain(t *testing.T) {
+	tests := []struct {
+		r    rune
+		want []string
+	}{
+		{'A', []string{"LATIN CAPITAL LETTER A (U+0041)", "an uppercase letter", "Basic Latin block", "Latin text"}},
+		{0x200D, []string{"ZERO WIDTH JOINER", "a formatting character", "emoji", "invisible"}},
+		{'\n', []string{"the control character LF", "ends a line"}},
+		{0x1B, []string{"the control character ESC", "escape sequences", "no visible form"}},
+		{0x1F600, []string{"4 bytes in UTF-8", "two UTF-16 code units", "two columns wide"}},
+	}
+
+	for _, tt := range tests {
+		got := Explain(tt.r)
+		for _, w := range tt.want {
+			if !strings.Contains(got, w) {
+				t.Errorf("Explain(%U) = %q, missing %q", tt.r, got, w)
+			}
+		}
+	}
+}
+
+func TestPlaceholders(t *testing.T) {
+	tests := []struct {
+		p    Placeholders
+		r    rune
+		want string
+	}{
+		{PlaceholderGlyphs, 0x1B, "<1B>"},
+		{PlaceholderGlyphs, '\n', "↵"},
+		{PlaceholderPictures, 0x00, "␀"},
+		{PlaceholderPictures, 0x1B, "␛"},
+		{PlaceholderPictures, '\n', "␊"},
+		{PlaceholderPictures, 0x7F, "␡"},
+		{PlaceholderPictures, ' ', "␣"},
+		{PlaceholderPictures, 0x85, "<85>"}, // C1 controls have no pictures
+		{PlaceholderEscapes, '\n', `\n`},
+		{PlaceholderEscapes, 0x1B, `\x1b`},
+		{PlaceholderEscapes, 0x200B, `\u200b`},
+		{PlaceholderEscapes, ' ', `\x20`},
+		{PlaceholderNames, '\n', "LF"},
+		{PlaceholderNames, 0x1B, "ESC"},
+		{PlaceholderNames, ' ', "SP"},
+		{PlaceholderNames, 0x200B, "<200B>"},
+		{PlaceholderNames, 'A', "A"},
+	}
+	for _, tt := range tests {
+		if got := tt.p.Display(tt.r); got != tt.want {
+			t.Errorf("%s.Display(%U) = %q, want %q", tt.p, tt.r, got, tt.want)
+		}
+	}
+
+	a := &Analyzer{Placeholders: PlaceholderPictures}
+	if got := a.AnalyzeString("\x01")[0].Char; got != "␁" {
+		t.Errorf("AnalyzeString with pictures: Char = %q, want %q", got, "␁")
+	}
+
+	for _, p := range AllPlaceholders {
+		if got, err := ParsePlaceholders(p.String()); err != nil || got != p {
+			t.Errorf("ParsePlaceholders(%q) = %v, %v", p, got, err)
+		}
+	}
+}
+
+func TestChecksums(t *testing.T) {
+	want := []Checksum{
+		{"CRC32", "352441c2"},
+		{"MD5", "900150983cd24fb0d6963f7d28e17f72"},
+		{"SHA-1", "a9993e364706816aba3e25717850c26c9cd0d89d"},
+		{"SHA-256", "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"},
+	}
+	got := Checksums([]byte("abc"))
+	if len(got) != len(want) {
+		t.Fatalf("got %d checksums, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("checksum %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestDetectEncoding(t *testing.T) {
+	utf16le := []byte{'H', 0, 'i', 0, 0xE9, 0, 0x3D, 0xD8, 0x00, 0xDE}
+	utf16be := []byte{0, 'H', 0, 'i', 0, 0xE9, 0xD8, 0x3D, 0xDE, 0x00}
+	utf32le := []byte{'H', 0, 0, 0, 'i', 0, 0, 0, 0x00, 0xF6, 0x01, 0}
+	utf32be := []byte{0, 0, 0, 'H', 0, 0, 0, 'i', 0, 0x01, 0xF6, 0x00}
+
+	tests := []struct {
+		name string
+		data []byte
+		want TextEncoding
+		bom  bool
+		text string
+	}{
+		{"plain UTF-8", []byte("héllo"), EncodingUTF8, false, "héllo"},
+		{"UTF-8 BOM", []byte("\xEF\xBB\xBFhi"), EncodingUTF8, true, "\uFEFFhi"},
+		{"UTF-16LE BOM", append([]byte{0xFF, 0xFE}, utf16le...), EncodingUTF16LE, true, "Hié😀"},
+		{"UTF-16BE BOM", append([]byte{0xFE, 0xFF}, utf16be...), EncodingUTF16BE, true, "Hié😀"},
+		{"UTF-32LE BOM", append([]byte{0xFF, 0xFE, 0, 0}, utf32le...), EncodingUTF32LE, true, "Hi😀"},
+		{"UTF-16LE heuristic", utf16le[:6], EncodingUTF16LE, false, "Hié"},
+		{"UTF-16BE heuristic", utf16be[:6], EncodingUTF16BE, false, "Hié"},
+		{"UTF-32LE heuristic", utf32le[:8], EncodingUTF32LE, false, "Hi"},
+		{"UTF-32BE heuristic", utf32be[:8], EncodingUTF32BE, false, "Hi"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			det := DetectEncoding(tt.data)
+			if det.Encoding != tt.want || det.BOM != tt.bom {
+				t.Errorf("DetectEncoding = %v (BOM %v), want %v (BOM %v)", det.Encoding, det.BOM, tt.want, tt.bom)
+			}
+			if got := DecodeText(tt.data, det.Encoding); got != tt.text {
+				t.Errorf("DecodeText = %q, want %q", got, tt.text)
+			}
+		})
+	}
+
+	// Odd trailing byte
+	if got := DecodeText([]byte{'a', 0, 'b'}, EncodingUTF16LE); got != "a\uFFFD" {
+		t.Errorf("DecodeText(odd) = %q", got)
+	}
+	if e, err := ParseTextEncoding("utf16be"); err != nil || e != EncodingUTF16BE {
+		t.Errorf("ParseTextEncoding = %v, %v", e, err)
+	}
+}
+
+func TestTextDecoder(t *testing.T) {
+	// BOMs in the middle of the text are kept, and pieces split units
+	tests := []struct {
+		name string
+		data []byte
+		enc  TextEncoding
+	}{
+		{"UTF-8", []byte("h\xEF\xBB\xBFé😀\xFFx\xE2\x82"), EncodingUTF8},
+		{"UTF-16LE", []byte{0xFF, 0xFE, 'H', 0, 0x3D, 0xD8, 0x00, 0xDE, 0xFF, 0xFE, 0x3D, 0xD8, 'x'}, EncodingUTF16LE},
+		{"UTF-16BE", []byte{0xFE, 0xFF, 0, 'H', 0xD8, 0x3D, 0xDE, 0x00, 0xFE, 0xFF, 0xD8}, EncodingUTF16BE},
+		{"UTF-32LE", []byte{0xFF, 0xFE, 0, 0, 'H', 0, 0, 0, 0xFF, 0xFE, 0, 0, 0x00, 0xF6, 0x01, 0, 'x'}, EncodingUTF32LE},
+	}
+	for _, tt := range tests {
+		want := DecodeText(tt.data, tt.enc)
+		for size := 1; size <= 5; size++ {
+			d := TextDecoder{Encoding: tt.enc}
+			var b strings.Builder
+			for i := 0; i < len(tt.data); i += size {
+				b.WriteString(d.Decode(tt.data[i:min(i+size, len(tt.data))], false))
+			}
+			b.WriteString(d.Decode(nil, true))
+			if got := b.String(); got != want {
+				t.Errorf("%s in pieces of %d decodes to %q, want %q", tt.name, size, got, want)
+			}
+		}
+	}
+}
+
+func TestDetectBinary(t *testing.T) {
+	elf := append([]byte("\x7fELF\x02\x01\x01"), make([]byte, 40)...)
+	latin1 := []byte("caf\xe9 na\xefve r\xe9sum\xe9")
+
+	tests := []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{"text", []byte("plain text\n"), ""},
+		{"UTF-8", []byte("héllo wörld"), ""},
+		{"one NUL", append([]byte(strings.Repeat("text ", 40)), 0), ""},
+		{"NUL bytes", elf, "86% NUL bytes"},
+		{"invalid UTF-8", latin1, "24% invalid UTF-8"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DetectEncoding(tt.data).Binary; got != tt.want {
+				t.Errorf("DetectEncoding().Binary = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompareFiles(t *testing.T) {
+	utf16 := []byte{0xFF, 0xFE, 'a', 0, 'b', 0}
+
+	tests := []struct {
+		name   string
+		a, b   []byte
+		eb     TextEncoding
+		want   DiffKind
+		byteAt int
+		charAt int
+	}{
+		{"identical", []byte("abc"), []byte("abc"), EncodingUTF8, DiffNone, -1, -1},
+		{"content", []byte("héllo"), []byte("hèllo"), EncodingUTF8, DiffContent, 2, 1},
+		{"appended", []byte("ab"), []byte("abc"), EncodingUTF8, DiffContent, 2, 2},
+		{"UTF-8 BOM", []byte("ab"), []byte("\xEF\xBB\xBFab"), EncodingUTF8, DiffEncoding, 0, 0},
+		{"UTF-16", []byte("ab"), utf16, EncodingUTF16LE, DiffEncoding, 0, -1},
+		{"CRLF", []byte("a\nb\n"), []byte("a\r\nb\r\n"), EncodingUTF8, DiffLineEndings, 1, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CompareFiles(tt.a, EncodingUTF8, tt.b, tt.eb)
+			if got.Kind != tt.want || got.Byte != tt.byteAt || got.Char != tt.charAt {
+				t.Errorf("CompareFiles = %+v, want {Kind:%v Byte:%d Char:%d}", got, tt.want, tt.byteAt, tt.charAt)
+			}
+		})
+	}
+}
+
+func TestCP437(t *testing.T) {
+	tests := []struct {
+		data []byte
+		want string
+	}{
+		{[]byte("Hello"), "Hello"},
+		{[]byte{0xC9, 0xCD, 0xBB}, "╔═╗"},
+		{[]byte{0xB0, 0xB1, 0xB2, 0xDB}, "░▒▓█"},
+		{[]byte{0x01, 0x03, 0x7F}, "☺♥⌂"},
+		{[]byte{0x82, 0xE1}, "éß"},
+	}
+
+	for _, tt := range tests {
+		if got := CP437.Decode(tt.data); got != tt.want {
+			t.Errorf("CP437.Decode(% X) = %q, want %q", tt.data, got, tt.want)
+		}
+	}
+}
+
+func TestCodepages(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{"KOI8-R", []byte{0xF0, 0xD2, 0xC9, 0xD7, 0xC5, 0xD4}, "Привет"},
+		{"Windows-1251", []byte{0xCF, 0xF0, 0xE8, 0xE2, 0xE5, 0xF2}, "Привет"},
+		{"ISO-8859-7", []byte{0xE1, 0xE2, 0xE3}, "αβγ"},
+		{"ISO-8859-11", []byte{0xA1, 0xBF}, "กฟ"},
+		{"ISO-8859-15", []byte{0xA4}, "€"},
+		{"windows-1252", []byte{0x80}, "€"},
+	}
+
+	for _, tt := range tests {
+		c, ok := FindCodepage(tt.name)
+		if !ok {
+			t.Fatalf("FindCodepage(%q) not found", tt.name)
+		}
+		if got := c.Decode(tt.data); got != tt.want {
+			t.Errorf("%s Decode(% X) = %q, want %q", tt.name, tt.data, got, tt.want)
+		}
+		if got, ok := c.Encode(tt.want); !ok || !bytes.Equal(got, tt.data) {
+			t.Errorf("%s Encode(%q) = % X, %v, want % X", tt.name, tt.want, got, ok, tt.data)
+		}
+	}
+}
+
+func TestRepair(t *testing.T) {
+	cp1252, _ := FindCodepage("Windows-1252")
+	if got, ok := cp1252.Repair("cafÃ© â€“ naÃ¯ve"); !ok || got != "café – naïve" {
+		t.Errorf("Repair = %q, %v, want %q", got, ok, "café – naïve")
+	}
+	if _, ok := cp1252.Repair("plain ASCII"); ok {
+		t.Error("Repair of ASCII should fail")
+	}
+	if _, ok := cp1252.Repair("café"); ok {
+		t.Error("Repair of text that is not mojibake should fail")
+	}
+}
+
+func TestFallbacks(t *testing.T) {
+	tests := []struct {
+		r    rune
+		want []string
+	}{
+		{'ﬁ', []string{"fi"}},
+		{'ő', []string{"o"}},
+		{'“', []string{`"`}},
+		{'€', []string{"EUR"}},
+		{'ｅ', []string{"e"}},
+		{'😀', nil},
+	}
+	for _, tt := range tests {
+		if got := Fallbacks(tt.r); !slices.Equal(got, tt.want) {
+			t.Errorf("Fallbacks(%q) = %q, want %q", tt.r, got, tt.want)
+		}
+	}
+}
+
+func TestCharsets(t *testing.T) {
+	tests := []struct {
+		charset string
+		text    string
+		want    []int
+	}{
+		{"ascii", "café – ok", []int{3, 5}},
+		{"latin1", "café – ok", []int{5}},
+		{"cp1252", "café – ok", nil},
+		{"sms", "Hi {name}, 5€ Ωk 😀", []int{17}},
+		{"gsm", "ç Ç", []int{0}},
+	}
+
+	for _, tt := range tests {
+		c, err := ParseCharset(tt.charset)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := c.Incompatible(Analyze(tt.text)); !slices.Equal(got, tt.want) {
+			t.Errorf("%s Incompatible(%q) = %v, want %v", c.Name, tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestSMSLengthOf(t *testing.T) {
+	tests := []struct {
+		text string
+		want SMSLength
+	}{
+		{"Hello", SMSLength{true, 5, 1, 160}},
+		{"5€ {x}", SMSLength{true, 9, 1, 160}},
+		{strings.Repeat("a", 161), SMSLength{true, 161, 2, 153}},
+		{"Hi 😀", SMSLength{false, 5, 1, 70}},
+		{strings.Repeat("ж", 71), SMSLength{false, 71, 2, 67}},
+	}
+
+	for _, tt := range tests {
+		if got := SMSLengthOf(tt.text); got != tt.want {
+			t.Errorf("SMSLengthOf(%q) = %+v, want %+v", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestCharsetFix(t *testing.T) {
+	tests := []struct {
+		charset string
+		text    string
+		want    string
+		n       int
+	}{
+		{"ascii", "Hellо wоrld", "Hello world", 2}, // Cyrillic о among Latin letters
+		{"ascii", "Привет, Jürgen — ﬁne", "Privet, Jurgen -- fine", 9},
+		{"ascii", "Straße Ærø Łódź", "Strasse Aero Lodz", 6},
+		{"ascii", "Καλημέρα", "Kalimera", 8},
+		{"ascii", "ok 😀", "ok 😀", 0},
+		{"gsm", "“Grüße” – 5€", "\"Grüße\" - 5€", 3},
+		{"latin1", "naïve – €", "naïve - EUR", 2},
+	}
+
+	for _, tt := range tests {
+		c, _ := ParseCharset(tt.charset)
+		if got, n := c.Fix(tt.text); got != tt.want || n != tt.n {
+			t.Errorf("%s Fix(%q) = %q, %d, want %q, %d", c.Name, tt.text, got, n, tt.want, tt.n)
+		}
+	}
+}
+
+func TestTerminalRisks(t *testing.T) {
+	tests := []struct {
+		text string
+		want []int
+	}{
+		{"plain text", nil},
+		{"日本", nil},
+		{"❤\uFE0F ok", []int{0}},
+		{"a👩\u200D💻b", []int{1}},
+		{"🇩🇪", []int{0}},
+		{"±§", []int{0, 1}},
+		{"\u0301x", []int{0}},
+		{"e\u0301", nil},
+		{"a\u200Bb", []int{1}},
+	}
+
+	for _, tt := range tests {
+		var got []int
+		for _, r := range TerminalRisks(Analyze(tt.text)) {
+			got = append(got, r.Index)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("TerminalRisks(%q) = %v, want %v", tt.text, got, tt.want)
+		}
+	}
+}
+
+// FuzzAnalyze checks that any input, including NUL, escape sequences, and
+// invalid UTF-8, is analyzed in full and shown without control characters,
+// which would garble the terminal.
+func FuzzAnalyze(f *testing.F) {
+	for _, seed := range []string{"", "a\x00b", "\x1b[31mred\x1b[0m", "\r\n\t\x7f\u0085", "\xff\xfe\x00", "e\u0301👍🏽\u200d"} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		a := NewAnalyzer()
+		for _, p := range AllPlaceholders {
+			a.Placeholders = p
+			chars := a.AnalyzeString(s)
+			if len(chars) != utf8.RuneCountInString(s) {
+				t.Fatalf("%v: %d characters for %d runes", p, len(chars), utf8.RuneCountInString(s))
+			}
+			var data []byte
+			for i, c := range chars {
+				if c.RuneOffset != i || c.ByteOffset != len(data) {
+					t.Fatalf("%v: character %d at rune %d, byte %d", p, i, c.RuneOffset, c.ByteOffset)
+				}
+				if strings.ContainsFunc(c.Char, unicode.IsControl) {
+					t.Fatalf("%v: Char of %U is %q", p, c.Rune, c.Char)
+				}
+				data = append(data, c.UTF8Bytes...)
+			}
+			if utf8.ValidString(s) && string(data) != s {
+				t.Fatalf("%v: bytes %q, want %q", p, data, s)
+			}
+			Summarize(chars)
+			TerminalRisks(chars)
+		}
+
+		chars := a.AnalyzeBytes([]byte(s))
+		if len(chars) != len(s) {
+			t.Fatalf("%d bytes analyzed, want %d", len(chars), len(s))
+		}
+		for i, c := range chars {
+			if c.UTF8Bytes[0] != s[i] || strings.ContainsFunc(c.Char, unicode.IsControl) {
+				t.Fatalf("byte %d: %+v", i, c)
+			}
+		}
+	})
+}
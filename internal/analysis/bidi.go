@@ -0,0 +1,339 @@
+package analysis
+
+import (
+	"golang.org/x/text/unicode/bidi"
+)
+
+// maxBidiDepth is the deepest embedding level explicit formatting
+// characters can open (UAX #9 BD2).
+const maxBidiDepth = 125
+
+// bidiClassNames are the Bidi_Class abbreviations.
+var bidiClassNames = [...]string{
+	bidi.L: "L", bidi.R: "R", bidi.EN: "EN", bidi.ES: "ES", bidi.ET: "ET",
+	bidi.AN: "AN", bidi.CS: "CS", bidi.B: "B", bidi.S: "S", bidi.WS: "WS",
+	bidi.ON: "ON", bidi.BN: "BN", bidi.NSM: "NSM", bidi.AL: "AL",
+	bidi.LRO: "LRO", bidi.RLO: "RLO", bidi.LRE: "LRE", bidi.RLE: "RLE",
+	bidi.PDF: "PDF", bidi.LRI: "LRI", bidi.RLI: "RLI", bidi.FSI: "FSI",
+	bidi.PDI: "PDI",
+}
+
+// BidiClass returns the Bidi_Class of r, such as "L", "R", "AL", or "EN".
+func BidiClass(r rune) string {
+	props, _ := bidi.LookupRune(r)
+	if c := props.Class(); int(c) < len(bidiClassNames) && bidiClassNames[c] != "" {
+		return bidiClassNames[c]
+	}
+	return "L"
+}
+
+// BidiParagraph is a paragraph resolved by the Unicode bidirectional
+// algorithm (UAX #9).
+type BidiParagraph struct {
+	Start   int      // Rune offset of the paragraph in the text
+	RTL     bool     // Whether the base direction is right to left
+	Classes []string // Bidi_Class of each character
+	Levels  []int    // Resolved embedding level of each character; odd levels run right to left
+}
+
+// Visual returns the paragraph's rune offsets, relative to Start, in the
+// order they are displayed on one line.
+func (p BidiParagraph) Visual() []int {
+	return VisualOrder(p.Levels)
+}
+
+// ResolveBidi splits s into paragraphs and resolves the embedding level of
+// each character, which decides the order it is displayed in. The
+// direction of each character comes from x/text/unicode/bidi; since it
+// reports directions rather than levels, the levels are rebuilt from the
+// explicit embeddings and the numbers raised within them.
+func ResolveBidi(s string) []BidiParagraph {
+	var paras []BidiParagraph
+	start := 0
+	for s != "" {
+		var p bidi.Paragraph
+		n, err := p.SetString(s)
+		if err != nil || n == 0 {
+			n = len(s) // The rest has no separator
+		}
+		if s[n-1] == '\r' && n < len(s) && s[n] == '\n' {
+			n++ // CRLF separates one paragraph
+		}
+		para := resolveParagraph(&p, []rune(s[:n]))
+		para.Start = start
+		paras = append(paras, para)
+		start += len(para.Levels)
+		s = s[n:]
+	}
+	return paras
+}
+
+// resolveParagraph resolves the levels of a paragraph's runes, which may
+// end with a paragraph separator.
+func resolveParagraph(p *bidi.Paragraph, runes []rune) BidiParagraph {
+	classes := make([]bidi.Class, len(runes))
+	para := BidiParagraph{Classes: make([]string, len(runes)), Levels: make([]int, len(runes))}
+	for i, r := range runes {
+		props, _ := bidi.LookupRune(r)
+		classes[i] = props.Class()
+		para.Classes[i] = BidiClass(r)
+	}
+	rtl, _ := firstStrong(classes)
+	para.RTL = rtl
+	base := 0
+	if rtl {
+		base = 1
+	}
+
+	// Runes are right to left where x/text ordered them so
+	rightToLeft := make([]bool, len(runes))
+	if o, err := p.Order(); err == nil {
+		for i := range o.NumRuns() {
+			run := o.Run(i)
+			start, end := run.Pos()
+			for j := start; j <= end && j < len(runes); j++ {
+				rightToLeft[j] = run.Direction() == bidi.RightToLeft
+			}
+		}
+	}
+
+	embedding, overridden := explicitLevels(classes, base)
+	raised := raisedNumbers(classes, embedding, overridden)
+	for i, e := range embedding {
+		switch {
+		case rightToLeft[i] && e%2 == 0, !rightToLeft[i] && e%2 == 1:
+			para.Levels[i] = e + 1
+		case !rightToLeft[i] && raised[i]:
+			para.Levels[i] = e + 2
+		default:
+			para.Levels[i] = e
+		}
+	}
+
+	// Separators, and the whitespace before them or at the end of the
+	// line, take the paragraph level (rule L1)
+	trailing := true
+	for i := len(classes) - 1; i >= 0; i-- {
+		switch classes[i] {
+		case bidi.B, bidi.S:
+			para.Levels[i] = base
+			trailing = true
+		case bidi.WS, bidi.BN, bidi.LRI, bidi.RLI, bidi.FSI, bidi.PDI,
+			bidi.LRE, bidi.RLE, bidi.LRO, bidi.RLO, bidi.PDF:
+			if trailing {
+				para.Levels[i] = base
+			}
+		default:
+			trailing = false
+		}
+	}
+	return para
+}
+
+// firstStrong finds the first strong direction in classes, skipping
+// isolated text, and reports whether it is right to left and whether there
+// was one (rule P2). It stops at a paragraph separator or at the PDI
+// closing the isolate classes start in.
+func firstStrong(classes []bidi.Class) (rtl, found bool) {
+	depth := 0
+	for _, c := range classes {
+		switch c {
+		case bidi.LRI, bidi.RLI, bidi.FSI:
+			depth++
+		case bidi.PDI:
+			if depth == 0 {
+				return false, false
+			}
+			depth--
+		case bidi.B:
+			return false, false
+		case bidi.L, bidi.R, bidi.AL:
+			if depth == 0 {
+				return c != bidi.L, true
+			}
+		}
+	}
+	return false, false
+}
+
+// explicitLevels returns the embedding level each character gets from the
+// embeddings, overrides, and isolates around it (rules X1–X8), and whether
+// an override forces its direction.
+func explicitLevels(classes []bidi.Class, base int) (levels []int, overridden []bool) {
+	type entry struct {
+		level    int
+		override bool
+		isolate  bool
+	}
+	stack := []entry{{level: base}}
+	overflow := 0
+	levels = make([]int, len(classes))
+	overridden = make([]bool, len(classes))
+	for i, c := range classes {
+		top := stack[len(stack)-1]
+		levels[i], overridden[i] = top.level, top.override
+
+		rtl := false
+		switch c {
+		case bidi.RLE, bidi.RLO, bidi.RLI:
+			rtl = true
+		case bidi.FSI:
+			rtl, _ = firstStrong(classes[i+1:])
+		case bidi.PDF:
+			if overflow > 0 {
+				overflow--
+			} else if len(stack) > 1 && !top.isolate {
+				stack = stack[:len(stack)-1]
+			}
+			continue
+		case bidi.PDI:
+			overflow = 0
+			for j := len(stack) - 1; j > 0; j-- {
+				if stack[j].isolate {
+					stack = stack[:j]
+					break
+				}
+			}
+			top = stack[len(stack)-1]
+			levels[i], overridden[i] = top.level, top.override
+			continue
+		case bidi.LRE, bidi.LRO, bidi.LRI:
+		default:
+			continue
+		}
+
+		next := (top.level + 2) &^ 1 // Next even level
+		if rtl {
+			next = (top.level + 1) | 1 // Next odd level
+		}
+		if next > maxBidiDepth {
+			overflow++
+			continue
+		}
+		stack = append(stack, entry{
+			level:    next,
+			override: c == bidi.LRO || c == bidi.RLO,
+			isolate:  c == bidi.LRI || c == bidi.RLI || c == bidi.FSI,
+		})
+	}
+	return levels, overridden
+}
+
+// raisedNumbers reports which characters resolve as numbers that display
+// left to right within right-to-left text, which rule I1 raises two levels
+// rather than none: Arabic digits, European digits after right-to-left
+// letters, and the separators and terminators that join them (rules
+// W1–W7). Each run of characters at one embedding level is resolved on
+// its own.
+func raisedNumbers(classes []bidi.Class, levels []int, overridden []bool) []bool {
+	types := make([]bidi.Class, len(classes))
+	raised := make([]bool, len(classes))
+	for start := 0; start < len(classes); {
+		end := start
+		for end < len(classes) && levels[end] == levels[start] {
+			end++
+		}
+
+		// W1–W3: marks take the type before them, European digits after
+		// Arabic letters are Arabic digits, and Arabic letters are R
+		prev, strong := bidi.L, bidi.L
+		if levels[start]%2 == 1 {
+			prev, strong = bidi.R, bidi.R
+		}
+		for i := start; i < end; i++ {
+			t := classes[i]
+			if overridden[i] {
+				t = bidi.ON
+			}
+			switch t {
+			case bidi.NSM:
+				t = prev
+			case bidi.L, bidi.R, bidi.AL:
+				strong = t
+			case bidi.EN:
+				if strong == bidi.AL {
+					t = bidi.AN
+				}
+			}
+			if t == bidi.AL {
+				t = bidi.R
+			}
+			types[i], prev = t, t
+		}
+
+		// W4–W5: a separator between two numbers of its kind, and
+		// terminators next to European digits, join the number
+		for i := start + 1; i < end-1; i++ {
+			before, after := types[i-1], types[i+1]
+			if before == after && (before == bidi.EN && (types[i] == bidi.ES || types[i] == bidi.CS) ||
+				before == bidi.AN && types[i] == bidi.CS) {
+				types[i] = before
+			}
+		}
+		for i := start; i < end; i++ {
+			if types[i] != bidi.ET {
+				continue
+			}
+			j := i
+			for j < end && types[j] == bidi.ET {
+				j++
+			}
+			if i > start && types[i-1] == bidi.EN || j < end && types[j] == bidi.EN {
+				for k := i; k < j; k++ {
+					types[k] = bidi.EN
+				}
+			}
+			i = j - 1
+		}
+
+		// W7: European digits after left-to-right letters are L
+		strong = bidi.L
+		if levels[start]%2 == 1 {
+			strong = bidi.R
+		}
+		for i := start; i < end; i++ {
+			switch types[i] {
+			case bidi.L, bidi.R:
+				strong = types[i]
+			case bidi.EN:
+				raised[i] = strong == bidi.R
+			case bidi.AN:
+				raised[i] = true
+			}
+		}
+		start = end
+	}
+	return raised
+}
+
+// VisualOrder returns the indices of levels in display order: from the
+// highest level down to the lowest odd one, every run at that level or
+// above is reversed (rule L2).
+func VisualOrder(levels []int) []int {
+	order := make([]int, len(levels))
+	highest, lowestOdd := 0, maxBidiDepth+2
+	for i, l := range levels {
+		order[i] = i
+		highest = max(highest, l)
+		if l%2 == 1 {
+			lowestOdd = min(lowestOdd, l)
+		}
+	}
+	for level := highest; level >= lowestOdd; level-- {
+		for i := 0; i < len(order); {
+			if levels[order[i]] < level {
+				i++
+				continue
+			}
+			j := i
+			for j < len(order) && levels[order[j]] >= level {
+				j++
+			}
+			for a, b := i, j-1; a < b; a, b = a+1, b-1 {
+				order[a], order[b] = order[b], order[a]
+			}
+			i = j
+		}
+	}
+	return order
+}
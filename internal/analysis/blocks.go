@@ -0,0 +1,116 @@
+package analysis
+
+import (
+	"bufio"
+	_ "embed"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// blocksData is the Unicode Character Database Blocks.txt file, which
+// go generate replaces with that of the latest Unicode version.
+//
+//go:generate go run ../.. -data-dir data update-data Blocks.txt
+//go:embed data/Blocks.txt
+var blocksData string
+
+// Block is a named, contiguous range of Unicode codepoints.
+type Block struct {
+	Start rune
+	End   rune // Inclusive
+	Name  string
+}
+
+// blocks holds all Unicode blocks sorted by start codepoint.
+var blocks = parseBlocks(blocksData)
+
+// parseBlocks parses the "start..end; Name" lines of Blocks.txt.
+func parseBlocks(data string) []Block {
+	var result []Block
+
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+
+		rng, name, ok := strings.Cut(line, ";")
+		if !ok {
+			continue
+		}
+		startHex, endHex, ok := strings.Cut(strings.TrimSpace(rng), "..")
+		if !ok {
+			continue
+		}
+		start, err1 := strconv.ParseUint(startHex, 16, 32)
+		end, err2 := strconv.ParseUint(endHex, 16, 32)
+		if err1 != nil || err2 != nil {
+			continue
+		}
+
+		result = append(result, Block{Start: rune(start), End: rune(end), Name: strings.TrimSpace(name)})
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Start < result[j].Start })
+	return result
+}
+
+// Blocks returns all Unicode blocks in codepoint order.
+func Blocks() []Block {
+	return blocks
+}
+
+// BlockOf returns the block containing r, or false if r is in no block.
+func BlockOf(r rune) (Block, bool) {
+	i := sort.Search(len(blocks), func(i int) bool { return blocks[i].End >= r })
+	if i < len(blocks) && blocks[i].Start <= r {
+		return blocks[i], true
+	}
+	return Block{}, false
+}
+
+// BlockName returns the name of the block containing r, or "No_Block".
+func BlockName(r rune) string {
+	if b, ok := BlockOf(r); ok {
+		return b.Name
+	}
+	return "No_Block"
+}
+
+// FindBlock looks up a block by name using Unicode loose matching
+// (case, spaces, hyphens, and underscores are ignored).
+func FindBlock(name string) (Block, bool) {
+	key := looseName(name)
+	for _, b := range blocks {
+		if looseName(b.Name) == key {
+			return b, true
+		}
+	}
+	return Block{}, false
+}
+
+// FindScript looks up a script by name using Unicode loose matching and
+// returns its canonical name (e.g. "cyrillic" -> "Cyrillic").
+func FindScript(name string) (string, bool) {
+	key := looseName(name)
+	for _, s := range scriptNames {
+		if looseName(s) == key {
+			return s, true
+		}
+	}
+	return "", false
+}
+
+// looseName normalizes a property value name for loose matching (UAX #44 LM3).
+func looseName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if r == ' ' || r == '-' || r == '_' {
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -87,6 +87,12 @@ func classifyRune(r rune) CharType {
 	}
 }
 
+// needsPlaceholder reports whether r is shown as a placeholder rather
+// than as itself.
+func needsPlaceholder(r rune) bool {
+	return r == ' ' || unicode.IsControl(r) || !unicode.IsPrint(r)
+}
+
 // displayChar returns a display string for a character.
 // Non-printable characters get special representations.
 func displayChar(r rune) string {
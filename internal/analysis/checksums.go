@@ -0,0 +1,30 @@
+package analysis
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash/crc32"
+)
+
+// Checksum is a named digest of some bytes, in lowercase hex.
+type Checksum struct {
+	Name  string
+	Value string
+}
+
+// Checksums computes CRC32, MD5, SHA-1, and SHA-256 of data. They are for
+// telling inputs apart, not for security.
+func Checksums(data []byte) []Checksum {
+	md := md5.Sum(data)
+	s1 := sha1.Sum(data)
+	s256 := sha256.Sum256(data)
+	return []Checksum{
+		{"CRC32", fmt.Sprintf("%08x", crc32.ChecksumIEEE(data))},
+		{"MD5", hex.EncodeToString(md[:])},
+		{"SHA-1", hex.EncodeToString(s1[:])},
+		{"SHA-256", hex.EncodeToString(s256[:])},
+	}
+}
@@ -0,0 +1,152 @@
+package analysis
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+// Codepage is a legacy single-byte character set: each of the 256 byte
+// values stands for one character, or U+FFFD where it stands for none.
+type Codepage struct {
+	Name    string
+	table   [256]rune
+	reverse map[rune]byte
+}
+
+// Rune returns the character byte b stands for in the codepage.
+func (c *Codepage) Rune(b byte) rune {
+	return c.table[b]
+}
+
+// Decode returns the text data reads as in the codepage.
+func (c *Codepage) Decode(data []byte) string {
+	var b strings.Builder
+	for _, v := range data {
+		b.WriteRune(c.table[v])
+	}
+	return b.String()
+}
+
+// Encode returns the bytes of s in the codepage, or false if it has a
+// character the codepage cannot represent.
+func (c *Codepage) Encode(s string) ([]byte, bool) {
+	out := make([]byte, 0, len(s))
+	for _, r := range s {
+		v, ok := c.reverse[r]
+		if !ok {
+			return nil, false
+		}
+		out = append(out, v)
+	}
+	return out, true
+}
+
+// Repair undoes mojibake: if s is UTF-8 text that was wrongly decoded in
+// the codepage, encoding it back gives valid UTF-8 that differs from s,
+// which is returned.
+func (c *Codepage) Repair(s string) (string, bool) {
+	data, ok := c.Encode(s)
+	if !ok || !utf8.Valid(data) || string(data) == s {
+		return "", false
+	}
+	return string(data), true
+}
+
+// newCodepage builds a codepage from a table of its characters.
+func newCodepage(name string, table [256]rune) *Codepage {
+	c := &Codepage{Name: name, table: table, reverse: make(map[rune]byte, 256)}
+	for i := 255; i >= 0; i-- {
+		if r := table[i]; r != utf8.RuneError {
+			c.reverse[r] = byte(i)
+		}
+	}
+	return c
+}
+
+// charmapTable lists the characters of a character map.
+func charmapTable(cm *charmap.Charmap) [256]rune {
+	var t [256]rune
+	for i := range t {
+		t[i] = cm.DecodeByte(byte(i))
+	}
+	return t
+}
+
+// cp437Controls are the glyphs the IBM PC's display adapter drew for the
+// control codes 0x00-0x1F, which DOS art used as ordinary characters.
+var cp437Controls = []rune(" ☺☻♥♦♣♠•◘○◙♂♀♪♫☼►◄↕‼¶§▬↨↑↓→←∟↔▲▼")
+
+// CP437 is the original IBM PC character set, with box drawing, block,
+// and symbol glyphs in place of the control codes as they appeared on
+// screen.
+var CP437 = func() *Codepage {
+	t := charmapTable(charmap.CodePage437)
+	copy(t[:0x20], cp437Controls)
+	t[0x7F] = '⌂'
+	c := newCodepage("CP437", t)
+	// The control codes themselves are still those bytes
+	for i := range 0x20 {
+		c.reverse[rune(i)] = byte(i)
+	}
+	c.reverse[0x7F] = 0x7F
+	return c
+}()
+
+// iso8859_11 builds ISO-8859-11 (Latin/Thai), which golang.org/x/text
+// lacks: Latin-1's lower half and TIS-620's Thai letters above it.
+func iso8859_11() [256]rune {
+	var t [256]rune
+	for i := range t {
+		switch {
+		case i <= 0xA0:
+			t[i] = rune(i)
+		case i <= 0xDA || i >= 0xDF && i <= 0xFB:
+			t[i] = 0x0E00 + rune(i) - 0xA0
+		default:
+			t[i] = utf8.RuneError
+		}
+	}
+	return t
+}
+
+// Codepages lists the codepages bytes can be interpreted in: DOS, the
+// ISO-8859 family (there is no ISO-8859-12), KOI8-R, and Windows.
+var Codepages = []*Codepage{
+	CP437,
+	newCodepage("ISO-8859-1", charmapTable(charmap.ISO8859_1)),
+	newCodepage("ISO-8859-2", charmapTable(charmap.ISO8859_2)),
+	newCodepage("ISO-8859-3", charmapTable(charmap.ISO8859_3)),
+	newCodepage("ISO-8859-4", charmapTable(charmap.ISO8859_4)),
+	newCodepage("ISO-8859-5", charmapTable(charmap.ISO8859_5)),
+	newCodepage("ISO-8859-6", charmapTable(charmap.ISO8859_6)),
+	newCodepage("ISO-8859-7", charmapTable(charmap.ISO8859_7)),
+	newCodepage("ISO-8859-8", charmapTable(charmap.ISO8859_8)),
+	newCodepage("ISO-8859-9", charmapTable(charmap.ISO8859_9)),
+	newCodepage("ISO-8859-10", charmapTable(charmap.ISO8859_10)),
+	newCodepage("ISO-8859-11", iso8859_11()),
+	newCodepage("ISO-8859-13", charmapTable(charmap.ISO8859_13)),
+	newCodepage("ISO-8859-14", charmapTable(charmap.ISO8859_14)),
+	newCodepage("ISO-8859-15", charmapTable(charmap.ISO8859_15)),
+	newCodepage("KOI8-R", charmapTable(charmap.KOI8R)),
+	newCodepage("Windows-1250", charmapTable(charmap.Windows1250)),
+	newCodepage("Windows-1251", charmapTable(charmap.Windows1251)),
+	newCodepage("Windows-1252", charmapTable(charmap.Windows1252)),
+	newCodepage("Windows-1253", charmapTable(charmap.Windows1253)),
+	newCodepage("Windows-1254", charmapTable(charmap.Windows1254)),
+	newCodepage("Windows-1255", charmapTable(charmap.Windows1255)),
+	newCodepage("Windows-1256", charmapTable(charmap.Windows1256)),
+	newCodepage("Windows-1257", charmapTable(charmap.Windows1257)),
+	newCodepage("Windows-1258", charmapTable(charmap.Windows1258)),
+}
+
+// FindCodepage returns the codepage with the given name, ignoring case.
+func FindCodepage(name string) (*Codepage, bool) {
+	for _, c := range Codepages {
+		if strings.EqualFold(c.Name, name) {
+			return c, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,89 @@
+package analysis
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// DiffKind classifies how two files differ.
+type DiffKind int
+
+const (
+	DiffNone        DiffKind = iota // Byte-for-byte identical
+	DiffEncoding                    // Same text in different encodings, or with and without a BOM
+	DiffLineEndings                 // Same text apart from line endings
+	DiffContent                     // Different text
+)
+
+// String returns a short description of the difference.
+func (k DiffKind) String() string {
+	switch k {
+	case DiffEncoding:
+		return "encoding"
+	case DiffLineEndings:
+		return "line endings"
+	case DiffContent:
+		return "content"
+	default:
+		return "none"
+	}
+}
+
+// FileDiff locates the first difference between two files.
+type FileDiff struct {
+	Kind DiffKind
+	Byte int // Offset of the first differing byte, -1 when identical
+	Char int // Index of the first differing character of the decoded texts, -1 when they match
+}
+
+// CompareFiles compares two files as bytes and as text decoded in the
+// given encodings, and classifies the difference by the mildest
+// explanation that accounts for it.
+func CompareFiles(a []byte, ea TextEncoding, b []byte, eb TextEncoding) FileDiff {
+	d := FileDiff{Byte: firstDifference(a, b), Char: -1}
+	if d.Byte < 0 {
+		return d
+	}
+
+	ta, tb := DecodeText(a, ea), DecodeText(b, eb)
+	if ta != tb {
+		// Back to the start of the rune the difference falls in
+		i := firstDifference([]byte(ta), []byte(tb))
+		for i > 0 && i < len(ta) && !utf8.RuneStart(ta[i]) {
+			i--
+		}
+		d.Char = utf8.RuneCountInString(ta[:i])
+	}
+
+	// DecodeText keeps a UTF-8 BOM, which is part of the encoding too
+	ta, tb = strings.TrimPrefix(ta, "\uFEFF"), strings.TrimPrefix(tb, "\uFEFF")
+	switch {
+	case ta == tb:
+		d.Kind = DiffEncoding
+	case unifyLineEndings(ta) == unifyLineEndings(tb):
+		d.Kind = DiffLineEndings
+	default:
+		d.Kind = DiffContent
+	}
+	return d
+}
+
+// firstDifference returns the offset of the first byte where a and b
+// differ, or -1 when they are equal. When one is a prefix of the other,
+// the difference is at the end of the shorter one.
+func firstDifference(a, b []byte) int {
+	n := min(len(a), len(b))
+	i := 0
+	for i < n && a[i] == b[i] {
+		i++
+	}
+	if i == n && len(a) == len(b) {
+		return -1
+	}
+	return i
+}
+
+// unifyLineEndings converts CRLF and CR line endings to LF.
+func unifyLineEndings(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "\r\n", "\n"), "\r", "\n")
+}
@@ -0,0 +1,169 @@
+package analysis
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+)
+
+// Charset is a character repertoire text may have to survive in, such as
+// a legacy encoding or the GSM alphabet of SMS messages.
+type Charset struct {
+	Name     string
+	Aliases  []string // Other names accepted by ParseCharset
+	Contains func(r rune) bool
+}
+
+// gsmBasic is the GSM 03.38 default alphabet, in septet order. The escape
+// to the extension table (0x1B) is left out.
+const gsmBasic = "@£$¥èéùìòÇ\nØø\rÅåΔ_ΦΓΛΩΠΨΣΘΞÆæßÉ !\"#¤%&'()*+,-./0123456789:;<=>?" +
+	"¡ABCDEFGHIJKLMNOPQRSTUVWXYZÄÖÑÜ§¿abcdefghijklmnopqrstuvwxyzäöñüà"
+
+// gsmExtension is the GSM 03.38 extension table, whose characters take
+// two septets: the escape and the character.
+const gsmExtension = "\f^{}\\[~]|€"
+
+// inCodepage returns a Contains function for the named codepage.
+func inCodepage(name string) func(r rune) bool {
+	c, _ := FindCodepage(name)
+	return func(r rune) bool {
+		_, ok := c.reverse[r]
+		return ok
+	}
+}
+
+// Charsets lists the repertoires the compatibility audit checks against.
+var Charsets = []Charset{
+	{"ASCII", []string{"us-ascii"}, func(r rune) bool { return r < 0x80 }},
+	{"Latin-1", []string{"latin1", "iso-8859-1"}, inCodepage("ISO-8859-1")},
+	{"Windows-1252", []string{"cp1252"}, inCodepage("Windows-1252")},
+	{"GSM 03.38", []string{"gsm", "gsm-03.38", "gsm7", "sms"}, func(r rune) bool {
+		return strings.ContainsRune(gsmBasic, r) || strings.ContainsRune(gsmExtension, r)
+	}},
+}
+
+// ParseCharset returns the charset with the given name or alias, ignoring
+// case.
+func ParseCharset(name string) (Charset, error) {
+	for _, c := range Charsets {
+		if strings.EqualFold(c.Name, name) {
+			return c, nil
+		}
+		for _, alias := range c.Aliases {
+			if strings.EqualFold(alias, name) {
+				return c, nil
+			}
+		}
+	}
+	return Charset{}, fmt.Errorf("unknown charset %q", name)
+}
+
+// Incompatible returns the indices of the characters the charset cannot
+// represent.
+func (c Charset) Incompatible(chars []Character) []int {
+	var out []int
+	for i, char := range chars {
+		if !c.Contains(char.Rune) {
+			out = append(out, i)
+		}
+	}
+	return out
+}
+
+// fits reports whether the charset can represent all of s.
+func (c Charset) fits(s string) bool {
+	for _, r := range s {
+		if !c.Contains(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// Suggest returns a stand-in for runes[i] that the charset can represent.
+// A letter next to an ASCII letter that looks like one, such as the
+// Cyrillic о in "Hellо", is taken for that letter; otherwise the first of
+// Fallbacks that fits is used.
+func (c Charset) Suggest(runes []rune, i int) (string, bool) {
+	asciiLetter := func(j int) bool {
+		return j >= 0 && j < len(runes) && runes[j] < 0x80 && unicode.IsLetter(runes[j])
+	}
+	if s, ok := confusables[runes[i]]; ok && (asciiLetter(i-1) || asciiLetter(i+1)) && c.fits(s) {
+		return s, true
+	}
+	for _, s := range Fallbacks(runes[i]) {
+		if c.fits(s) {
+			return s, true
+		}
+	}
+	return "", false
+}
+
+// Fix replaces every character of s the charset cannot represent with its
+// suggested stand-in, and returns the result and the number replaced.
+// Characters without a suggestion are kept.
+func (c Charset) Fix(s string) (string, int) {
+	runes := []rune(s)
+	var b strings.Builder
+	n := 0
+	for i, r := range runes {
+		if !c.Contains(r) {
+			if sub, ok := c.Suggest(runes, i); ok {
+				b.WriteString(sub)
+				n++
+				continue
+			}
+		}
+		b.WriteRune(r)
+	}
+	return b.String(), n
+}
+
+// SMSLength describes how a text would be sent as SMS.
+type SMSLength struct {
+	GSM        bool // Fits the GSM alphabet; otherwise sent as UCS-2
+	Units      int  // Septets for GSM, UTF-16 code units for UCS-2
+	Segments   int  // Messages needed
+	PerSegment int  // Units per message at that length
+}
+
+// String summarizes the length, e.g. "GSM-7: 45 septets, 1 segment".
+func (l SMSLength) String() string {
+	unit, enc := "septets", "GSM-7"
+	if !l.GSM {
+		unit, enc = "UTF-16 units", "UCS-2"
+	}
+	plural := "s"
+	if l.Segments == 1 {
+		plural = ""
+	}
+	return fmt.Sprintf("%s: %d %s, %d segment%s of up to %d", enc, l.Units, unit, l.Segments, plural, l.PerSegment)
+}
+
+// SMSLengthOf returns the SMS length of s. GSM messages hold 160 septets,
+// or 153 each when split; UCS-2 messages hold 70 units, or 67 each.
+func SMSLengthOf(s string) SMSLength {
+	l := SMSLength{GSM: true}
+	for _, r := range s {
+		switch {
+		case strings.ContainsRune(gsmBasic, r):
+			l.Units++
+		case strings.ContainsRune(gsmExtension, r):
+			l.Units += 2
+		default:
+			l.GSM = false
+		}
+	}
+
+	single, split := 160, 153
+	if !l.GSM {
+		l.Units = len(utf16.Encode([]rune(s)))
+		single, split = 70, 67
+	}
+	l.Segments, l.PerSegment = 1, single
+	if l.Units > single {
+		l.Segments, l.PerSegment = (l.Units+split-1)/split, split
+	}
+	return l
+}
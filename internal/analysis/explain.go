@@ -0,0 +1,131 @@
+package analysis
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/rivo/uniseg"
+)
+
+// categoryPhrases describes each general category in plain English.
+var categoryPhrases = map[string]string{
+	"Lu": "an uppercase letter",
+	"Ll": "a lowercase letter",
+	"Lt": "a titlecase letter",
+	"Lm": "a modifier letter",
+	"Lo": "a letter",
+	"Mn": "a combining mark",
+	"Mc": "a spacing combining mark",
+	"Me": "an enclosing mark",
+	"Nd": "a decimal digit",
+	"Nl": "a letter-like number",
+	"No": "a number",
+	"Pc": "a connector punctuation mark",
+	"Pd": "a dash",
+	"Ps": "an opening bracket",
+	"Pe": "a closing bracket",
+	"Pi": "an opening quotation mark",
+	"Pf": "a closing quotation mark",
+	"Po": "a punctuation mark",
+	"Sm": "a math symbol",
+	"Sc": "a currency symbol",
+	"Sk": "a modifier symbol",
+	"So": "a symbol",
+	"Zs": "a space character",
+	"Zl": "a line separator",
+	"Zp": "a paragraph separator",
+	"Cc": "a control character",
+	"Cf": "a formatting character",
+	"Cs": "a surrogate code point",
+	"Co": "a private use character",
+	"Cn": "an unassigned code point",
+}
+
+// runeNotes explains well-known characters beyond what their properties
+// say.
+var runeNotes = map[rune]string{
+	0x0000: "Many C-based programs treat it as the end of the string and silently drop everything after it.",
+	0x0009: "Its displayed width depends on tab stops, so columns may not line up the same way everywhere.",
+	0x000A: "It ends a line on Unix-like systems.",
+	0x000D: "On its own it is an old Mac line ending; before LF it forms a Windows CRLF line ending.",
+	0x001B: "It starts terminal escape sequences, which can change colors or move the cursor when printed.",
+	0x00A0: "It looks like a normal space but prevents line breaks, and does not match \" \" in searches or comparisons.",
+	0x00AD: "It only appears as a hyphen when a word is broken at the end of a line.",
+	0x200B: "It marks a possible line break without showing anything, and often comes along when copying from web pages.",
+	0x200C: "It keeps neighboring characters from joining, as in Persian or Indic text.",
+	0x200D: "It is typically used to join emoji into sequences such as family or profession emoji.",
+	0x2013: "It is often typed in place of a hyphen-minus (-) by word processors.",
+	0x2014: "It is often typed in place of a hyphen-minus (-) or double hyphen by word processors.",
+	0x2018: "Word processors often substitute it for an apostrophe ('), which breaks code and exact-match searches.",
+	0x2019: "Word processors often substitute it for an apostrophe ('), which breaks code and exact-match searches.",
+	0x201C: "Word processors often substitute it for a straight quote (\"), which breaks code and exact-match searches.",
+	0x201D: "Word processors often substitute it for a straight quote (\"), which breaks code and exact-match searches.",
+	0x2028: "Some JSON and JavaScript tools treat it as a line break, which can break string literals.",
+	0x2029: "Some JSON and JavaScript tools treat it as a line break, which can break string literals.",
+	0x202E: "It reverses the display order of the text after it, a trick used to disguise file extensions and source code.",
+	0xFE0E: "It asks for the plain text presentation of the character before it.",
+	0xFE0F: "It asks for the colorful emoji presentation of the character before it.",
+	0xFEFF: "At the start of a file it is a byte order mark; anywhere else it is an invisible no-break space. Editors often add it silently.",
+	0xFFFD: "It stands in for bytes that could not be decoded, so the original data was probably in another encoding or corrupted.",
+}
+
+// warningSentences explains the practical impact of each warning.
+var warningSentences = map[Warning]string{
+	WarningInvisible:         "It is invisible, which often causes string-length surprises and comparisons that fail for no apparent reason.",
+	WarningBidiControl:       "It changes the direction text is displayed in, so what you see may not be the order that is stored.",
+	WarningUnusualWhitespace: "It is whitespace other than a regular space, so trimming, splitting, and matching may not treat it as expected.",
+	WarningPrivateUse:        "Its meaning is defined by a particular font or application rather than by Unicode, so it may show as a box elsewhere.",
+	WarningControl:           "It has no visible form; terminals and parsers may act on it instead of displaying it.",
+}
+
+// Explain describes a character in plain English from its properties:
+// what it is, known pitfalls, and how it behaves in encodings and layout.
+func Explain(r rune) string {
+	var sentences []string
+
+	// What it is
+	name := Name(r)
+	if abbr := controlCharName(r); abbr != "" {
+		name = "the control character " + abbr
+	} else if name == "" {
+		name = "an unnamed character"
+	}
+	cat := Category(r)
+	what := fmt.Sprintf("This is %s (U+%04X), %s", name, r, categoryPhrases[cat])
+	if block := BlockName(r); block != "No_Block" {
+		what += " in the " + block + " block"
+	}
+	if script := Script(r); script != "Common" && script != "Inherited" && script != "Unknown" && script != "" {
+		what += ", used in " + script + " text"
+	}
+	sentences = append(sentences, what+".")
+
+	if note, ok := runeNotes[r]; ok {
+		sentences = append(sentences, note)
+	}
+	if unicode.Is(unicode.Mn, r) || unicode.Is(unicode.Me, r) {
+		sentences = append(sentences, "It attaches to the character before it instead of standing alone.")
+	}
+	for _, w := range RuneWarnings(r) {
+		if s, ok := warningSentences[w]; ok {
+			sentences = append(sentences, s)
+		}
+	}
+
+	// Encoding and layout
+	if n := utf8.RuneLen(r); n > 1 {
+		enc := fmt.Sprintf("It takes %d bytes in UTF-8", n)
+		if utf16.RuneLen(r) == 2 {
+			enc += " and two UTF-16 code units, so length checks in JavaScript, Java, or C# count it twice"
+		}
+		sentences = append(sentences, enc+".")
+	}
+	if unicode.IsPrint(r) && uniseg.StringWidth(string(r)) == 2 {
+		sentences = append(sentences, "Terminals display it two columns wide.")
+	}
+
+	return strings.Join(sentences, " ")
+}
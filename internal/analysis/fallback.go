@@ -0,0 +1,90 @@
+package analysis
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// asciiPunctuation maps typographic punctuation to the ASCII characters
+// it usually stands in for.
+var asciiPunctuation = map[rune]string{
+	'‘': "'",   // Left single quotation mark
+	'’': "'",   // Right single quotation mark
+	'‚': "'",   // Single low-9 quotation mark
+	'‛': "'",   // Single high-reversed-9 quotation mark
+	'′': "'",   // Prime
+	'‹': "'",   // Single left-pointing angle quotation mark
+	'›': "'",   // Single right-pointing angle quotation mark
+	'“': `"`,   // Left double quotation mark
+	'”': `"`,   // Right double quotation mark
+	'„': `"`,   // Double low-9 quotation mark
+	'‟': `"`,   // Double high-reversed-9 quotation mark
+	'″': `"`,   // Double prime
+	'«': `"`,   // Left-pointing double angle quotation mark
+	'»': `"`,   // Right-pointing double angle quotation mark
+	'‐': "-",   // Hyphen
+	'‑': "-",   // Non-breaking hyphen
+	'‒': "-",   // Figure dash
+	'–': "-",   // En dash
+	'—': "--",  // Em dash
+	'―': "--",  // Horizontal bar
+	'−': "-",   // Minus sign
+	'…': "...", // Horizontal ellipsis
+	'•': "*",   // Bullet
+}
+
+// asciiSymbols maps symbols without a compatibility decomposition to the
+// ASCII they are usually written as.
+var asciiSymbols = map[rune]string{
+	'€': "EUR",
+	'£': "GBP",
+	'©': "(C)",
+	'®': "(R)",
+	'→': "->",
+	'←': "<-",
+	'⇒': "=>",
+	'≤': "<=",
+	'≥': ">=",
+	'≠': "!=",
+	'✓': "v",
+}
+
+// Fallbacks returns stand-ins for r, closest first, for writing it in a
+// character set that lacks it: its compatibility form (ﬁ → fi), the
+// letter without diacritics (ő → o), ASCII punctuation and symbols
+// (“ → ", € → EUR), and its romanization (ж → zh, ά → a). Callers pick
+// the first one they can represent.
+func Fallbacks(r rune) []string {
+	var out []string
+	add := func(s string) {
+		if s != "" && s != string(r) && !slices.Contains(out, s) {
+			out = append(out, s)
+		}
+	}
+
+	base := strings.Map(func(r rune) rune {
+		if unicode.Is(unicode.Mn, r) {
+			return -1
+		}
+		return r
+	}, norm.NFKD.String(string(r)))
+
+	add(norm.NFKC.String(string(r)))
+	add(base)
+	add(asciiPunctuation[r])
+	add(asciiSymbols[r])
+	if b := []rune(base); len(b) == 1 {
+		add(translit[b[0]])
+	}
+	return out
+}
+
+// ASCIIPunctuation returns the ASCII a typographic punctuation mark, such
+// as a curly quote or dash, usually stands in for.
+func ASCIIPunctuation(r rune) (string, bool) {
+	s, ok := asciiPunctuation[r]
+	return s, ok
+}
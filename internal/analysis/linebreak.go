@@ -0,0 +1,37 @@
+package analysis
+
+import (
+	"unicode/utf8"
+
+	"github.com/rivo/uniseg"
+)
+
+// LineBreak is a place the Unicode line breaking algorithm (UAX #14) lets
+// text wrap.
+type LineBreak struct {
+	Offset    int  // Rune offset of the character the break comes before
+	Mandatory bool // Whether the line must end here, as after a newline
+}
+
+// LineBreaks returns where s may be wrapped, in order. Breaks fall between
+// grapheme clusters only, and the end of the text is not one.
+func LineBreaks(s string) []LineBreak {
+	var breaks []LineBreak
+	offset, state := 0, -1
+	for s != "" {
+		var cluster string
+		var boundaries int
+		cluster, s, boundaries, state = uniseg.StepString(s, state)
+		offset += utf8.RuneCountInString(cluster)
+		if s == "" {
+			break
+		}
+		switch boundaries & uniseg.MaskLine {
+		case uniseg.LineCanBreak:
+			breaks = append(breaks, LineBreak{Offset: offset})
+		case uniseg.LineMustBreak:
+			breaks = append(breaks, LineBreak{Offset: offset, Mandatory: true})
+		}
+	}
+	return breaks
+}
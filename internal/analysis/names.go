@@ -0,0 +1,158 @@
+package analysis
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/runenames"
+)
+
+// categoryNames holds the two-letter general category names (e.g. "Lu", "Cf")
+// in sorted order so that lookups are deterministic. The "LC" (cased letter)
+// grouping is skipped so letters report Lu/Ll/Lt.
+var categoryNames = func() []string {
+	names := make([]string, 0, len(unicode.Categories))
+	for name := range unicode.Categories {
+		if len(name) == 2 && name != "LC" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}()
+
+// Name returns the Unicode character name of a rune (e.g. "EM DASH").
+// Control characters return "<control>" and unassigned codepoints "".
+func Name(r rune) string {
+	if name, ok := loadedNames[r]; ok {
+		return name
+	}
+	return runenames.Name(r)
+}
+
+// Category returns the two-letter Unicode general category of a rune
+// (e.g. "Lu", "Cf"), or "Cn" for unassigned codepoints.
+func Category(r rune) string {
+	for _, name := range categoryNames {
+		if unicode.Is(unicode.Categories[name], r) {
+			return name
+		}
+	}
+	return "Cn"
+}
+
+// MatchesName reports whether query appears in the Unicode name of r,
+// ignoring case. Control characters also match their abbreviation
+// (e.g. "LF", "NUL").
+func MatchesName(r rune, query string) bool {
+	query = strings.ToUpper(strings.TrimSpace(query))
+	if query == "" {
+		return false
+	}
+	if abbr := controlCharName(r); abbr != "" && abbr == query {
+		return true
+	}
+	return strings.Contains(Name(r), query)
+}
+
+// MatchesCategory reports whether r belongs to the general category cat.
+// Both two-letter categories ("Lu") and major classes ("L") are accepted,
+// case-insensitively.
+func MatchesCategory(r rune, cat string) bool {
+	cat = strings.TrimSpace(cat)
+	if cat == "" {
+		return false
+	}
+	got := Category(r)
+	if len(cat) == 1 {
+		return strings.EqualFold(got[:1], cat)
+	}
+	return strings.EqualFold(got, cat)
+}
+
+// nameIndex lists every character with a real Unicode name, built on first
+// use by SearchNames.
+var (
+	nameIndex     []namedRune
+	nameIndexOnce sync.Once
+)
+
+type namedRune struct {
+	r    rune
+	name string
+}
+
+// buildNameIndex collects named characters, skipping "<control>"-style
+// labels for ranges without individual names.
+func buildNameIndex() {
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		if name := Name(r); name != "" && name[0] != '<' {
+			nameIndex = append(nameIndex, namedRune{r, name})
+		}
+	}
+}
+
+// SearchNames returns up to limit characters whose Unicode name contains
+// every word of query, ignoring case. An exact name match comes first;
+// the rest are in codepoint order.
+func SearchNames(query string, limit int) []rune {
+	words := strings.Fields(strings.ToUpper(query))
+	if len(words) == 0 || limit <= 0 {
+		return nil
+	}
+	nameIndexOnce.Do(buildNameIndex)
+
+	exact := strings.Join(words, " ")
+	var results []rune
+	for _, nr := range nameIndex {
+		if nr.name == exact {
+			results = append([]rune{nr.r}, results...)
+			if len(results) > limit {
+				results = results[:limit]
+			}
+			continue
+		}
+		if len(results) >= limit || !containsAll(nr.name, words) {
+			continue
+		}
+		results = append(results, nr.r)
+	}
+	return results
+}
+
+// Lookup finds the characters a query asks for: the codepoint of a U+
+// or 0x query, a lone character itself, or else up to limit characters
+// found by SearchNames.
+func Lookup(query string, limit int) ([]rune, error) {
+	upper := strings.ToUpper(query)
+	hex, ok := strings.CutPrefix(upper, "U+")
+	if !ok {
+		hex, ok = strings.CutPrefix(upper, "0X")
+	}
+	if ok {
+		n, err := strconv.ParseUint(hex, 16, 32)
+		if err != nil || n > unicode.MaxRune {
+			return nil, fmt.Errorf("invalid codepoint %q", query)
+		}
+		return []rune{rune(n)}, nil
+	}
+	if utf8.RuneCountInString(query) == 1 {
+		return []rune(query), nil
+	}
+	return SearchNames(query, limit), nil
+}
+
+// containsAll reports whether s contains every word.
+func containsAll(s string, words []string) bool {
+	for _, w := range words {
+		if !strings.Contains(s, w) {
+			return false
+		}
+	}
+	return true
+}
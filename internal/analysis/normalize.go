@@ -0,0 +1,74 @@
+package analysis
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// normalForms are the Unicode normalization forms by name.
+var normalForms = map[string]norm.Form{
+	"NFC":  norm.NFC,
+	"NFD":  norm.NFD,
+	"NFKC": norm.NFKC,
+	"NFKD": norm.NFKD,
+}
+
+// ParseNormalForm returns the normalization form with the given name
+// (NFC, NFD, NFKC, or NFKD), ignoring case.
+func ParseNormalForm(name string) (norm.Form, error) {
+	if f, ok := normalForms[strings.ToUpper(name)]; ok {
+		return f, nil
+	}
+	return 0, fmt.Errorf("unknown normalization form %q", name)
+}
+
+// NormDiff is a stretch of text that a normalization form changes.
+type NormDiff struct {
+	RuneOffset int // Offset of its first character in the text
+	ByteOffset int
+	Line       int // 1-based line and column (in characters) of its start
+	Column     int
+	Original   string
+	Normalized string
+}
+
+// NormDiffs returns the stretches of s that differ from their form f. The
+// text is split where the form starts anew, so each stretch is a character
+// with the marks that combine with it, and normalizing s is the same as
+// normalizing each stretch.
+func NormDiffs(s string, f norm.Form) []NormDiff {
+	var diffs []NormDiff
+	line, col, runes := 1, 1, 0
+	for start := 0; start < len(s); {
+		_, size := utf8.DecodeRuneInString(s[start:])
+		end := start + size
+		for end < len(s) && !f.PropertiesString(s[end:]).BoundaryBefore() {
+			_, size := utf8.DecodeRuneInString(s[end:])
+			end += size
+		}
+
+		seg := s[start:end]
+		if normalized := f.String(seg); normalized != seg {
+			diffs = append(diffs, NormDiff{
+				RuneOffset: runes,
+				ByteOffset: start,
+				Line:       line,
+				Column:     col,
+				Original:   seg,
+				Normalized: normalized,
+			})
+		}
+		for _, r := range seg {
+			runes++
+			col++
+			if r == '\n' {
+				line, col = line+1, 1
+			}
+		}
+		start = end
+	}
+	return diffs
+}
@@ -0,0 +1,82 @@
+package analysis
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Placeholders selects how characters without a visible glyph of their
+// own are shown in Character.Char.
+type Placeholders int
+
+const (
+	PlaceholderGlyphs   Placeholders = iota // ␣ ⇥ ↵ ↩ ∅, <1B> for other controls
+	PlaceholderPictures                     // Control Pictures block: ␀ ␁ … ␟ ␡
+	PlaceholderEscapes                      // Backslash escapes: \n \t \x1b \u200b
+	PlaceholderNames                        // Mnemonics: LF TAB ESC SP
+)
+
+// AllPlaceholders lists every placeholder style in cycling order.
+var AllPlaceholders = []Placeholders{PlaceholderGlyphs, PlaceholderPictures, PlaceholderEscapes, PlaceholderNames}
+
+// String returns the config name of the placeholder style.
+func (p Placeholders) String() string {
+	switch p {
+	case PlaceholderPictures:
+		return "pictures"
+	case PlaceholderEscapes:
+		return "escapes"
+	case PlaceholderNames:
+		return "names"
+	default:
+		return "glyphs"
+	}
+}
+
+// Next returns the following placeholder style, wrapping around.
+func (p Placeholders) Next() Placeholders {
+	return AllPlaceholders[(int(p)+1)%len(AllPlaceholders)]
+}
+
+// ParsePlaceholders looks up a placeholder style by its config name.
+func ParsePlaceholders(name string) (Placeholders, error) {
+	for _, p := range AllPlaceholders {
+		if strings.EqualFold(p.String(), name) {
+			return p, nil
+		}
+	}
+	return PlaceholderGlyphs, fmt.Errorf("unknown placeholder style %q", name)
+}
+
+// Display returns the display string of r in this style. Characters
+// with a visible glyph are returned unchanged in every style.
+func (p Placeholders) Display(r rune) string {
+	if !needsPlaceholder(r) {
+		return string(r)
+	}
+
+	switch p {
+	case PlaceholderPictures:
+		switch {
+		case r < 0x20:
+			return string(0x2400 + r) // ␀ through ␟
+		case r == 0x7F:
+			return "␡"
+		}
+	case PlaceholderEscapes:
+		if r == ' ' {
+			return `\x20`
+		}
+		quoted := strconv.QuoteRune(r) // e.g. '\n', '\x1b', '\u200b'
+		return quoted[1 : len(quoted)-1]
+	case PlaceholderNames:
+		if r == ' ' {
+			return "SP"
+		}
+		if name := controlCharName(r); name != "" {
+			return name
+		}
+	}
+	return displayChar(r)
+}
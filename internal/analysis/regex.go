@@ -0,0 +1,56 @@
+package analysis
+
+import (
+	"regexp"
+)
+
+// RegexClass is a character class of Go's regexp syntax and whether a
+// character matches it.
+type RegexClass struct {
+	Pattern string
+	Matches bool
+}
+
+// regexClass is a class compiled to match one whole character.
+type regexClass struct {
+	pattern string
+	re      *regexp.Regexp
+}
+
+// newRegexClass compiles a class of the regexp syntax.
+func newRegexClass(pattern string) regexClass {
+	return regexClass{pattern, regexp.MustCompile(`\A` + pattern + `\z`)}
+}
+
+// regexClasses are the classes RegexClasses checks, Perl and POSIX ones
+// first.
+var regexClasses = []regexClass{
+	newRegexClass(`\w`),
+	newRegexClass(`\d`),
+	newRegexClass(`\s`),
+	newRegexClass(`[[:alpha:]]`),
+	newRegexClass(`[[:alnum:]]`),
+	newRegexClass(`[[:punct:]]`),
+	newRegexClass(`[[:space:]]`),
+	newRegexClass(`.`),
+	newRegexClass(`\p{L}`),
+	newRegexClass(`\p{N}`),
+	newRegexClass(`\p{P}`),
+	newRegexClass(`\p{S}`),
+	newRegexClass(`\p{Z}`),
+	newRegexClass(`\p{M}`),
+	newRegexClass(`\p{C}`),
+}
+
+// RegexClasses reports which common regexp classes r matches under Go's
+// semantics, where \w, \d, \s, and the POSIX classes are ASCII only while
+// the \p{...} Unicode categories are not, and . matches anything but a
+// newline.
+func RegexClasses(r rune) []RegexClass {
+	s := string(r)
+	classes := make([]RegexClass, len(regexClasses))
+	for i, c := range regexClasses {
+		classes[i] = RegexClass{Pattern: c.pattern, Matches: c.re.MatchString(s)}
+	}
+	return classes
+}
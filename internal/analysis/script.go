@@ -0,0 +1,37 @@
+package analysis
+
+import (
+	"sort"
+	"unicode"
+)
+
+// scriptNames holds the Unicode script table names in sorted order so that
+// lookups are deterministic.
+var scriptNames = func() []string {
+	names := make([]string, 0, len(unicode.Scripts))
+	for name := range unicode.Scripts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}()
+
+// Script returns the Unicode script name of a rune (e.g. "Latin", "Cyrillic"),
+// "Common" for shared characters such as digits and punctuation, or
+// "Unknown" for unassigned codepoints.
+func Script(r rune) string {
+	// Fast path for ASCII
+	if r < 0x80 {
+		if (r >= 'A' && r <= 'Z') || (r >= 'a' && r <= 'z') {
+			return "Latin"
+		}
+		return "Common"
+	}
+
+	for _, name := range scriptNames {
+		if unicode.Is(unicode.Scripts[name], r) {
+			return name
+		}
+	}
+	return "Unknown"
+}
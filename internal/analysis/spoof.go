@@ -0,0 +1,163 @@
+package analysis
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// SpoofFinding is a reason a string, such as a display name, may pass for
+// another.
+type SpoofFinding struct {
+	Kind   string // "mixed-script", "confusable", "bidi-control", or "invisible"
+	Offset int    // Rune offset of the character, or -1 for the whole string
+	Rune   rune
+	Detail string
+}
+
+// SpoofReport is the result of SpoofCheck.
+type SpoofReport struct {
+	Scripts  []string // Scripts of the letters, sorted
+	Findings []SpoofFinding
+}
+
+// Suspicious reports whether there are any findings.
+func (r SpoofReport) Suspicious() bool {
+	return len(r.Findings) > 0
+}
+
+// allowedScripts are the script combinations that are normal in one
+// string, as in the UTS #39 highly restrictive level: Latin with Japanese,
+// Chinese, or Korean.
+var allowedScripts = [][]string{
+	{"Han", "Hiragana", "Katakana", "Latin"},
+	{"Bopomofo", "Han", "Latin"},
+	{"Han", "Hangul", "Latin"},
+}
+
+// SpoofCheck looks for ways s could impersonate another string: letters
+// from scripts not normally mixed, characters that look like ASCII
+// letters (a Cyrillic о, a fullwidth Ａ), bidi controls that reorder what
+// is shown, and invisible characters. Lookalike letters of another script
+// are only findings next to Latin letters, or when every letter is one,
+// as in a Cyrillic "рау" passing for "pay"; a Russian name alone is fine.
+func SpoofCheck(s string) SpoofReport {
+	var report SpoofReport
+	var lookalikes []SpoofFinding
+	seen := make(map[string]bool)
+	letters := 0
+	for i, r := range []rune(s) {
+		if script := Script(r); script != "Common" && script != "Inherited" && script != "Unknown" {
+			letters++
+			if !seen[script] {
+				seen[script] = true
+				report.Scripts = append(report.Scripts, script)
+			}
+		}
+
+		finding := SpoofFinding{Offset: i, Rune: r}
+		if lookalike, ok := Confusable(r); ok {
+			finding.Kind, finding.Detail = "confusable", fmt.Sprintf("looks like %q", lookalike)
+			lookalikes = append(lookalikes, finding)
+			continue
+		}
+		if compat := norm.NFKC.String(string(r)); compat != string(r) && isASCIILetters(compat) {
+			finding.Kind, finding.Detail = "confusable", fmt.Sprintf("compatibility form of %q", compat)
+		} else if isBidiControl(r) {
+			finding.Kind, finding.Detail = "bidi-control", Name(r)
+		} else if isInvisible(r) || r == 0xFEFF {
+			finding.Kind, finding.Detail = "invisible", Name(r)
+		} else {
+			continue
+		}
+		report.Findings = append(report.Findings, finding)
+	}
+	sort.Strings(report.Scripts)
+
+	if len(lookalikes) > 0 && (seen["Latin"] || len(lookalikes) == letters) {
+		report.Findings = append(report.Findings, lookalikes...)
+		sort.SliceStable(report.Findings, func(i, j int) bool {
+			return report.Findings[i].Offset < report.Findings[j].Offset
+		})
+	}
+	if len(report.Scripts) > 1 && !slices.ContainsFunc(allowedScripts, func(allowed []string) bool {
+		for _, script := range report.Scripts {
+			if !slices.Contains(allowed, script) {
+				return false
+			}
+		}
+		return true
+	}) {
+		mixed := SpoofFinding{Kind: "mixed-script", Offset: -1, Detail: strings.Join(report.Scripts, ", ")}
+		report.Findings = append([]SpoofFinding{mixed}, report.Findings...)
+	}
+	return report
+}
+
+// ConfusableOffsets returns the offsets of the runes SpoofCheck reports as
+// lookalikes, checking each whitespace-separated word on its own so that a
+// Latin word elsewhere in a text does not implicate a Russian one.
+func ConfusableOffsets(runes []rune) []int {
+	var offsets []int
+	for start := 0; start < len(runes); {
+		if unicode.IsSpace(runes[start]) {
+			start++
+			continue
+		}
+		end := start
+		for end < len(runes) && !unicode.IsSpace(runes[end]) {
+			end++
+		}
+		for _, f := range SpoofCheck(string(runes[start:end])).Findings {
+			if f.Kind == "confusable" {
+				offsets = append(offsets, start+f.Offset)
+			}
+		}
+		start = end
+	}
+	return offsets
+}
+
+// isASCIILetters reports whether s is made of ASCII letters and digits.
+func isASCIILetters(s string) bool {
+	for _, r := range s {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9') {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// asciiPrototypes are the ASCII characters confusables.txt maps to other
+// ASCII, so "paypa1" and "paypal" share a skeleton.
+var asciiPrototypes = map[rune]string{
+	'0': "O", '1': "l", 'I': "l", '|': "l", 'm': "rn",
+}
+
+// Skeleton returns the UTS #39 skeleton of s: two strings that look alike
+// have the same skeleton, so it can key a lookup of names already taken.
+// Each character is replaced by its prototype after NFD, and default
+// ignorable characters are dropped. Unless LoadData has read the full
+// confusables.txt, the prototypes are the lookalikes SpoofCheck knows, so
+// the skeleton catches the common substitutions, not every one.
+func Skeleton(s string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(s) {
+		if proto, ok := loadedConfusables[r]; ok {
+			b.WriteString(proto)
+		} else if lookalike, ok := Confusable(r); ok {
+			b.WriteString(lookalike)
+		} else if proto, ok := asciiPrototypes[r]; ok {
+			b.WriteString(proto)
+		} else if compat := norm.NFKC.String(string(r)); compat != string(r) && isASCIILetters(compat) {
+			b.WriteString(Skeleton(compat))
+		} else if !isBidiControl(r) && !isInvisible(r) && r != 0xFEFF {
+			b.WriteRune(r)
+		}
+	}
+	return norm.NFD.String(b.String())
+}
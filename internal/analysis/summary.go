@@ -0,0 +1,136 @@
+package analysis
+
+import "github.com/rivo/uniseg"
+
+// LineEndings counts line terminators by style.
+type LineEndings struct {
+	LF   int // Unix "\n"
+	CRLF int // Windows "\r\n"
+	CR   int // Classic Mac "\r"
+}
+
+// Summary holds aggregate statistics for a set of analyzed characters.
+type Summary struct {
+	Characters  int              // Number of characters (runes)
+	Graphemes   int              // Number of user-perceived characters
+	Bytes       int              // Total UTF-8 byte length
+	Types       map[CharType]int // Character counts by type
+	Scripts     map[string]int   // Character counts by Unicode script
+	ByteLengths map[int]int      // Character counts by UTF-8 sequence length
+	LineEndings LineEndings      // Line terminator counts
+	Warnings    map[Warning]int  // Character counts by warning kind
+
+	unterminated bool // The text ends without a line terminator
+}
+
+// Summarize computes aggregate statistics for the given characters.
+func Summarize(chars []Character) Summary {
+	s := Summary{
+		Characters:  len(chars),
+		Types:       make(map[CharType]int),
+		Scripts:     make(map[string]int),
+		ByteLengths: make(map[int]int),
+		Warnings:    make(map[Warning]int),
+	}
+
+	runes := make([]rune, len(chars))
+	for i, c := range chars {
+		runes[i] = c.Rune
+		s.Bytes += len(c.UTF8Bytes)
+		s.Types[c.Type]++
+		s.Scripts[Script(c.Rune)]++
+		s.ByteLengths[len(c.UTF8Bytes)]++
+
+		for _, w := range c.Warnings() {
+			s.Warnings[w]++
+		}
+
+		switch c.Rune {
+		case '\n':
+			if i > 0 && chars[i-1].Rune == '\r' {
+				s.LineEndings.CRLF++
+			} else {
+				s.LineEndings.LF++
+			}
+		case '\r':
+			if i+1 >= len(chars) || chars[i+1].Rune != '\n' {
+				s.LineEndings.CR++
+			}
+		}
+	}
+	if n := len(runes); n > 0 {
+		s.unterminated = runes[n-1] != '\n' && runes[n-1] != '\r'
+	}
+	s.Graphemes = uniseg.GraphemeClusterCount(string(runes))
+
+	return s
+}
+
+// Add adds the statistics of t, summarizing the characters that follow
+// those of s, to s. The text must be split between grapheme clusters for
+// the grapheme and line ending counts to add up.
+func (s *Summary) Add(t Summary) {
+	s.Characters += t.Characters
+	s.Graphemes += t.Graphemes
+	s.Bytes += t.Bytes
+	for k, n := range t.Types {
+		s.Types[k] += n
+	}
+	for k, n := range t.Scripts {
+		s.Scripts[k] += n
+	}
+	for k, n := range t.ByteLengths {
+		s.ByteLengths[k] += n
+	}
+	for k, n := range t.Warnings {
+		s.Warnings[k] += n
+	}
+	s.LineEndings.LF += t.LineEndings.LF
+	s.LineEndings.CRLF += t.LineEndings.CRLF
+	s.LineEndings.CR += t.LineEndings.CR
+	if t.Characters > 0 {
+		s.unterminated = t.unterminated
+	}
+}
+
+// Lines returns the number of lines, counting a final line without a
+// terminator.
+func (s Summary) Lines() int {
+	n := s.LineEndings.LF + s.LineEndings.CRLF + s.LineEndings.CR
+	if s.unterminated {
+		n++
+	}
+	return n
+}
+
+// WarningCount returns the total number of warnings across all kinds.
+func (s Summary) WarningCount() int {
+	total := 0
+	for _, n := range s.Warnings {
+		total += n
+	}
+	return total
+}
+
+// Distinct is one distinct character with its occurrences.
+type Distinct struct {
+	Character     // First occurrence
+	Count     int // Number of occurrences
+	First     int // Index of the first occurrence in the analyzed slice
+}
+
+// Unique lists each distinct character once, in order of first
+// occurrence.
+func Unique(chars []Character) []Distinct {
+	var out []Distinct
+	index := make(map[rune]int)
+	for i, c := range chars {
+		if j, ok := index[c.Rune]; ok {
+			out[j].Count++
+			continue
+		}
+		index[c.Rune] = len(out)
+		out = append(out, Distinct{Character: c, Count: 1, First: i})
+	}
+	return out
+}
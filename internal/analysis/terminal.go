@@ -0,0 +1,104 @@
+package analysis
+
+import (
+	"fmt"
+	"slices"
+	"unicode"
+
+	"github.com/mattn/go-runewidth"
+	"github.com/rivo/uniseg"
+)
+
+// RenderRisk is a character likely to be drawn wrong by some terminals,
+// misaligning whatever follows it on the line.
+type RenderRisk struct {
+	Index  int // Index into the characters
+	Reason string
+}
+
+// TerminalRisks finds the characters terminals disagree about. Clusters
+// whose width by grapheme (as modern terminals draw them) differs from the
+// sum of their code point widths (as wcwidth-based ones do) are reported
+// at their first character, as are ambiguous-width characters, which take
+// two columns in CJK locales, and invisible format characters.
+func TerminalRisks(chars []Character) []RenderRisk {
+	runes := make([]rune, len(chars))
+	for i, c := range chars {
+		runes[i] = c.Rune
+	}
+
+	var risks []RenderRisk
+	i := 0
+	g := uniseg.NewGraphemes(string(runes))
+	for g.Next() {
+		cluster := g.Runes()
+		if reason := clusterRisk(cluster); reason != "" {
+			risks = append(risks, RenderRisk{i, reason})
+		} else {
+			for j, r := range cluster {
+				if reason := runeRisk(r, j); reason != "" {
+					risks = append(risks, RenderRisk{i + j, reason})
+				}
+			}
+		}
+		i += len(cluster)
+	}
+	return risks
+}
+
+// clusterRisk describes why terminals may disagree on the width of a
+// grapheme cluster, or returns "".
+func clusterRisk(cluster []rune) string {
+	whole := uniseg.StringWidth(string(cluster))
+	sum := 0
+	for _, r := range cluster {
+		sum += codepointWidth(r)
+	}
+	if whole == sum {
+		return ""
+	}
+
+	var kind string
+	switch {
+	case slices.Contains(cluster, '\u200D'):
+		kind = "emoji ZWJ sequence"
+	case slices.ContainsFunc(cluster, func(r rune) bool { return r >= 0x1F1E6 && r <= 0x1F1FF }):
+		kind = "flag"
+	case slices.ContainsFunc(cluster, func(r rune) bool { return r >= 0x1F3FB && r <= 0x1F3FF }):
+		kind = "emoji with skin tone"
+	case slices.Contains(cluster, '\uFE0F'):
+		kind = "emoji presentation (VS16)"
+	case slices.ContainsFunc(cluster, func(r rune) bool { return unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc) }):
+		kind = "combining sequence"
+	default:
+		kind = "grapheme cluster"
+	}
+	return fmt.Sprintf("%s: %d or %d columns, by terminal", kind, whole, sum)
+}
+
+// codepointWidth is the width wcwidth-style terminals give r on its own:
+// marks and format characters take none, and regional indicators, being
+// emoji, take two.
+func codepointWidth(r rune) int {
+	switch {
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		return 0
+	case r >= 0x1F1E6 && r <= 0x1F1FF:
+		return 2
+	}
+	return runewidth.RuneWidth(r)
+}
+
+// runeRisk describes why r, at position j of its cluster, may render
+// wrong, or returns "".
+func runeRisk(r rune, j int) string {
+	switch {
+	case j == 0 && unicode.In(r, unicode.Mn, unicode.Me):
+		return "combining mark with no base: drawn alone or over the previous cell"
+	case runewidth.IsAmbiguousWidth(r) && !unicode.In(r, unicode.Mn, unicode.Me):
+		return "ambiguous width: 1 column, or 2 in CJK locales"
+	case unicode.Is(unicode.Cf, r) && r != '\u200D' && r != '\uFE0F':
+		return "invisible format character: no column, may shift the cursor"
+	}
+	return ""
+}
@@ -0,0 +1,275 @@
+package analysis
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// TextEncoding is a Unicode encoding form a file can be stored in.
+type TextEncoding int
+
+const (
+	EncodingUTF8 TextEncoding = iota
+	EncodingUTF16LE
+	EncodingUTF16BE
+	EncodingUTF32LE
+	EncodingUTF32BE
+)
+
+// AllTextEncodings lists the encodings in the order they are cycled
+// through.
+var AllTextEncodings = []TextEncoding{EncodingUTF8, EncodingUTF16LE, EncodingUTF16BE, EncodingUTF32LE, EncodingUTF32BE}
+
+// String returns the encoding name, e.g. "UTF-16LE".
+func (e TextEncoding) String() string {
+	switch e {
+	case EncodingUTF16LE:
+		return "UTF-16LE"
+	case EncodingUTF16BE:
+		return "UTF-16BE"
+	case EncodingUTF32LE:
+		return "UTF-32LE"
+	case EncodingUTF32BE:
+		return "UTF-32BE"
+	default:
+		return "UTF-8"
+	}
+}
+
+// Next returns the following encoding in AllTextEncodings, wrapping around.
+func (e TextEncoding) Next() TextEncoding {
+	return AllTextEncodings[(int(e)+1)%len(AllTextEncodings)]
+}
+
+// ParseTextEncoding parses an encoding name case-insensitively, with or
+// without the hyphen ("utf-16le", "UTF16LE").
+func ParseTextEncoding(name string) (TextEncoding, error) {
+	normalized := strings.ReplaceAll(strings.ToUpper(name), "-", "")
+	for _, e := range AllTextEncodings {
+		if strings.ReplaceAll(e.String(), "-", "") == normalized {
+			return e, nil
+		}
+	}
+	return EncodingUTF8, fmt.Errorf("unknown encoding %q (want utf-8, utf-16le, utf-16be, utf-32le, or utf-32be)", name)
+}
+
+// boms lists the byte order marks, longest first so UTF-32LE is not
+// mistaken for UTF-16LE.
+var boms = []struct {
+	mark     []byte
+	encoding TextEncoding
+}{
+	{[]byte{0xFF, 0xFE, 0x00, 0x00}, EncodingUTF32LE},
+	{[]byte{0x00, 0x00, 0xFE, 0xFF}, EncodingUTF32BE},
+	{[]byte{0xEF, 0xBB, 0xBF}, EncodingUTF8},
+	{[]byte{0xFF, 0xFE}, EncodingUTF16LE},
+	{[]byte{0xFE, 0xFF}, EncodingUTF16BE},
+}
+
+// Detection is the encoding guessed for some bytes and why.
+type Detection struct {
+	Encoding TextEncoding
+	BOM      bool   // The data starts with a byte order mark
+	Reason   string // e.g. "byte order mark"
+
+	// Binary explains why the data looks like a binary file rather than
+	// text, e.g. "8% NUL bytes"; empty for text
+	Binary string
+}
+
+// DetectEncoding guesses the encoding of data from its byte order mark or,
+// failing that, from where NUL bytes fall: ASCII-range text in UTF-16 has
+// a zero in every other byte, and in UTF-32 three zeros in every four.
+func DetectEncoding(data []byte) Detection {
+	for _, b := range boms {
+		if bytes.HasPrefix(data, b.mark) {
+			return Detection{Encoding: b.encoding, BOM: true, Reason: "byte order mark"}
+		}
+	}
+	if bytes.IndexByte(data, 0) < 0 {
+		return Detection{Encoding: EncodingUTF8, Reason: "default", Binary: binaryReason(data)}
+	}
+
+	sample := data[:min(len(data), 4096)]
+	var zeros [4]int // Zero bytes by position within a 4-byte unit
+	for i, b := range sample {
+		if b == 0 {
+			zeros[i%4]++
+		}
+	}
+	units := len(sample) / 4
+	mostly := func(n, of int) bool { return of > 0 && n*10 >= of*9 }
+	rarely := func(n, of int) bool { return n*10 <= of }
+
+	const reason = "NUL byte pattern"
+	switch {
+	case len(data)%4 == 0 && mostly(zeros[2], units) && mostly(zeros[3], units) && rarely(zeros[0], units):
+		return Detection{Encoding: EncodingUTF32LE, Reason: reason}
+	case len(data)%4 == 0 && mostly(zeros[0], units) && mostly(zeros[1], units) && rarely(zeros[3], units):
+		return Detection{Encoding: EncodingUTF32BE, Reason: reason}
+	}
+	half := len(sample) / 2
+	even, odd := zeros[0]+zeros[2], zeros[1]+zeros[3]
+	switch {
+	case odd*2 >= half && rarely(even, half):
+		return Detection{Encoding: EncodingUTF16LE, Reason: reason}
+	case even*2 >= half && rarely(odd, half):
+		return Detection{Encoding: EncodingUTF16BE, Reason: reason}
+	}
+	return Detection{Encoding: EncodingUTF8, Reason: "default", Binary: binaryReason(data)}
+}
+
+// binaryReason explains why data that is neither UTF-16 nor UTF-32 looks
+// binary: more than 1% NUL bytes, which text never contains, or more than
+// 10% of bytes that are not valid UTF-8. It returns "" for text.
+func binaryReason(data []byte) string {
+	sample := data[:min(len(data), 8192)]
+	if len(sample) == 0 {
+		return ""
+	}
+	if len(sample) < len(data) {
+		// Drop a sequence cut off by the end of the sample
+		for i := len(sample) - 1; i >= max(len(sample)-utf8.UTFMax, 0); i-- {
+			if utf8.RuneStart(sample[i]) {
+				sample = sample[:i]
+				break
+			}
+		}
+	}
+
+	nuls, invalid := 0, 0
+	for i := 0; i < len(sample); {
+		r, size := utf8.DecodeRune(sample[i:])
+		switch {
+		case r == 0:
+			nuls++
+		case r == utf8.RuneError && size == 1:
+			invalid++
+		}
+		i += size
+	}
+	percent := func(n int) int { return (n*100 + len(sample) - 1) / len(sample) }
+	switch {
+	case nuls*100 > len(sample):
+		return fmt.Sprintf("%d%% NUL bytes", percent(nuls))
+	case invalid*10 > len(sample):
+		return fmt.Sprintf("%d%% invalid UTF-8", percent(invalid))
+	}
+	return ""
+}
+
+// DecodeText decodes data in the given encoding. A UTF-16 or UTF-32 byte
+// order mark is dropped, since it is part of the encoding rather than the
+// text; a UTF-8 one is kept so it can be inspected. Invalid code units and
+// trailing partial units become U+FFFD.
+func DecodeText(data []byte, e TextEncoding) string {
+	return decodeText(data, e, true)
+}
+
+// decodeText decodes data as DecodeText does, dropping a leading byte
+// order mark only when dropBOM is set.
+func decodeText(data []byte, e TextEncoding, dropBOM bool) string {
+	var order binary.ByteOrder = binary.LittleEndian
+	if e == EncodingUTF16BE || e == EncodingUTF32BE {
+		order = binary.BigEndian
+	}
+
+	switch e {
+	case EncodingUTF16LE, EncodingUTF16BE:
+		units := make([]uint16, 0, len(data)/2)
+		for i := 0; i+1 < len(data); i += 2 {
+			units = append(units, order.Uint16(data[i:]))
+		}
+		if dropBOM && len(units) > 0 && units[0] == 0xFEFF {
+			units = units[1:]
+		}
+		s := string(utf16.Decode(units))
+		if len(data)%2 != 0 {
+			s += string(utf8.RuneError)
+		}
+		return s
+
+	case EncodingUTF32LE, EncodingUTF32BE:
+		var b strings.Builder
+		for i := 0; i+3 < len(data); i += 4 {
+			r := rune(order.Uint32(data[i:]))
+			if dropBOM && i == 0 && r == 0xFEFF {
+				continue
+			}
+			if !utf8.ValidRune(r) {
+				r = utf8.RuneError
+			}
+			b.WriteRune(r)
+		}
+		if len(data)%4 != 0 {
+			b.WriteRune(utf8.RuneError)
+		}
+		return b.String()
+
+	default:
+		return string(data)
+	}
+}
+
+// TextDecoder decodes text that arrives in pieces split anywhere, giving
+// the same text as DecodeText on the whole.
+type TextDecoder struct {
+	Encoding TextEncoding
+
+	pending []byte // A code unit or UTF-8 sequence cut off by the last piece
+	started bool   // Text has been decoded, so a byte order mark is text
+}
+
+// Decode decodes data following the pieces before it. A code unit or UTF-8
+// sequence cut off at the end is kept for the next call, unless final is
+// set, when it becomes U+FFFD as DecodeText would make it.
+func (d *TextDecoder) Decode(data []byte, final bool) string {
+	data = append(d.pending, data...)
+	n := len(data)
+	if !final {
+		n = d.complete(data)
+	}
+	d.pending = bytes.Clone(data[n:])
+	if n == 0 {
+		return ""
+	}
+	s := decodeText(data[:n], d.Encoding, !d.started)
+	d.started = true
+	return s
+}
+
+// complete returns the length of the prefix of data that decodes without
+// the bytes that follow it.
+func (d *TextDecoder) complete(data []byte) int {
+	switch d.Encoding {
+	case EncodingUTF16LE, EncodingUTF16BE:
+		n := len(data) &^ 1
+		if n >= 2 {
+			// A high surrogate waits for the low one after it
+			u := binary.LittleEndian.Uint16(data[n-2:])
+			if d.Encoding == EncodingUTF16BE {
+				u = binary.BigEndian.Uint16(data[n-2:])
+			}
+			if utf16.IsSurrogate(rune(u)) && u < 0xDC00 {
+				n -= 2
+			}
+		}
+		return n
+	case EncodingUTF32LE, EncodingUTF32BE:
+		return len(data) &^ 3
+	default:
+		for i := len(data) - 1; i >= max(len(data)-utf8.UTFMax, 0); i-- {
+			if utf8.RuneStart(data[i]) {
+				if !utf8.FullRune(data[i:]) {
+					return i
+				}
+				break
+			}
+		}
+		return len(data)
+	}
+}
@@ -0,0 +1,78 @@
+package analysis
+
+import (
+	"strings"
+	"unicode"
+)
+
+// translitLower romanizes letters that have no decomposition to ASCII:
+// Latin ligatures and special letters, Greek (ELOT 743), and Cyrillic
+// (Russian and Ukrainian, as in passports). Capitals are derived.
+var translitLower = map[rune]string{
+	'ß': "ss", 'æ': "ae", 'œ': "oe", 'ø': "o", 'ł': "l", 'đ': "d", 'ð': "d",
+	'þ': "th", 'ı': "i", 'ŋ': "ng", 'ħ': "h", 'ŧ': "t", 'ĳ': "ij",
+
+	'α': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "i",
+	'θ': "th", 'ι': "i", 'κ': "k", 'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x",
+	'ο': "o", 'π': "p", 'ρ': "r", 'σ': "s", 'ς': "s", 'τ': "t", 'υ': "y",
+	'φ': "f", 'χ': "ch", 'ψ': "ps", 'ω': "o",
+
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "yo",
+	'ж': "zh", 'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m",
+	'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u",
+	'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch",
+	'ъ': `"`, 'ы': "y", 'ь': "'", 'э': "e", 'ю': "yu", 'я': "ya",
+	'і': "i", 'ї': "yi", 'є': "ye", 'ґ': "g",
+}
+
+// translit holds translitLower and the capitals, e.g. Ж → Zh.
+var translit = func() map[rune]string {
+	m := make(map[rune]string, 2*len(translitLower))
+	for r, s := range translitLower {
+		m[r] = s
+		if up := unicode.ToUpper(r); up != r {
+			m[up] = strings.ToUpper(s[:1]) + s[1:]
+		}
+	}
+	return m
+}()
+
+// Transliterate returns the ASCII romanization of r, if it has one.
+func Transliterate(r rune) (string, bool) {
+	s, ok := translit[r]
+	return s, ok
+}
+
+// confusables maps letters of other scripts that look like ASCII letters
+// to the letter they are mistaken for, as in homograph attacks. Symbols
+// whose compatibility form is ASCII (fullwidth, mathematical letters) are
+// covered by NFKC instead.
+var confusables = map[rune]string{
+	// Cyrillic
+	'а': "a", 'е': "e", 'о': "o", 'р': "p", 'с': "c", 'у': "y", 'х': "x",
+	'ѕ': "s", 'і': "i", 'ј': "j", 'ԁ': "d", 'һ': "h", 'ԛ': "q", 'ԝ': "w",
+	'А': "A", 'В': "B", 'Е': "E", 'К': "K", 'М': "M", 'Н': "H", 'О': "O",
+	'Р': "P", 'С': "C", 'Т': "T", 'Х': "X", 'У': "Y", 'Ѕ': "S", 'І': "I",
+	'Ј': "J",
+
+	// Greek
+	'Α': "A", 'Β': "B", 'Ε': "E", 'Ζ': "Z", 'Η': "H", 'Ι': "I", 'Κ': "K",
+	'Μ': "M", 'Ν': "N", 'Ο': "O", 'Ρ': "P", 'Τ': "T", 'Υ': "Y", 'Χ': "X",
+	'ο': "o", 'ν': "v", 'ι': "i",
+
+	// Latin
+	'ı': "i", 'ɡ': "g", 'ɑ': "a",
+}
+
+// Confusable returns the ASCII letter r is easily mistaken for, if any.
+// Once LoadData has read confusables.txt, non-ASCII characters whose
+// prototype there is ASCII letters count as well.
+func Confusable(r rune) (string, bool) {
+	if s, ok := confusables[r]; ok {
+		return s, true
+	}
+	if s, ok := loadedConfusables[r]; ok && r > unicode.MaxASCII && isASCIILetters(s) {
+		return s, true
+	}
+	return "", false
+}
@@ -0,0 +1,218 @@
+package analysis
+
+import (
+	"bufio"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/bidi"
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/unicode/runenames"
+)
+
+// Data downloaded by update-data, which takes the place of the built-in
+// tables once LoadData has read it.
+var (
+	loadedNames       map[rune]string
+	loadedConfusables map[rune]string
+	dataVersion       = blocksVersion(blocksData)
+
+	// loadedFiles maps the names of the files read to their paths, and
+	// confusablesVersion is the version confusables.txt gives
+	loadedFiles        = make(map[string]string)
+	confusablesVersion string
+)
+
+// DataVersion returns the Unicode version of the block data in use, as
+// given in the header of Blocks.txt (e.g. "14.0.0").
+func DataVersion() string {
+	return dataVersion
+}
+
+// blocksVersion reads the version from the "# Blocks-X.Y.Z.txt" header.
+func blocksVersion(data string) string {
+	line, _, _ := strings.Cut(data, "\n")
+	v, ok := strings.CutPrefix(strings.TrimSpace(line), "# Blocks-")
+	if !ok {
+		return ""
+	}
+	return strings.TrimSuffix(v, ".txt")
+}
+
+// LoadData replaces the built-in block, name, and confusable data with the
+// Blocks.txt, UnicodeData.txt, and confusables.txt files in dir, as
+// downloaded by update-data, and returns the names of the files it read.
+// Files missing from dir leave the built-in data in place. It must be
+// called before the data is first used.
+func LoadData(dir string) ([]string, error) {
+	var loaded []string
+	read := func(name string, parse func(string) error) error {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := parse(string(data)); err != nil {
+			return err
+		}
+		loaded = append(loaded, name)
+		loadedFiles[name] = filepath.Join(dir, name)
+		return nil
+	}
+
+	err := read("Blocks.txt", func(data string) error {
+		parsed := parseBlocks(data)
+		if len(parsed) == 0 {
+			return errors.New("Blocks.txt: no blocks found")
+		}
+		blocks, dataVersion = parsed, blocksVersion(data)
+		return nil
+	})
+	if err == nil {
+		err = read("UnicodeData.txt", func(data string) error {
+			loadedNames = parseUnicodeDataNames(data)
+			return nil
+		})
+	}
+	if err == nil {
+		err = read("confusables.txt", func(data string) error {
+			loadedConfusables = parseConfusables(data)
+			confusablesVersion = headerVersion(data)
+			return nil
+		})
+	}
+	return loaded, err
+}
+
+// parseUnicodeDataNames reads the names of UnicodeData.txt, whose lines
+// are "code;name;category;...". Labels such as "<control>" and the
+// "<CJK Ideograph, First>" range markers are left to the built-in names.
+func parseUnicodeDataNames(data string) map[rune]string {
+	names := make(map[rune]string)
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.SplitN(scanner.Text(), ";", 3)
+		if len(fields) < 3 || strings.HasPrefix(fields[1], "<") {
+			continue
+		}
+		r, err := strconv.ParseUint(fields[0], 16, 32)
+		if err != nil {
+			continue
+		}
+		names[rune(r)] = fields[1]
+	}
+	return names
+}
+
+// parseConfusables reads the "source ; prototype ; type # comment" lines of
+// confusables.txt, whose codepoints are space-separated hex.
+func parseConfusables(data string) map[rune]string {
+	prototypes := make(map[rune]string)
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Split(line, ";")
+		if len(fields) < 2 {
+			continue
+		}
+		source, err := strconv.ParseUint(strings.TrimSpace(strings.TrimPrefix(fields[0], "\uFEFF")), 16, 32)
+		if err != nil {
+			continue
+		}
+		var proto strings.Builder
+		for _, hex := range strings.Fields(fields[1]) {
+			r, err := strconv.ParseUint(hex, 16, 32)
+			if err != nil {
+				proto.Reset()
+				break
+			}
+			proto.WriteRune(rune(r))
+		}
+		if proto.Len() > 0 {
+			prototypes[rune(source)] = proto.String()
+		}
+	}
+	return prototypes
+}
+
+// headerVersion returns the version a "# Version: X.Y.Z" line in the
+// header of a data file gives, or "".
+func headerVersion(data string) string {
+	for line := range strings.Lines(strings.TrimPrefix(data, "\uFEFF")) {
+		if !strings.HasPrefix(line, "#") {
+			break
+		}
+		if v, ok := strings.CutPrefix(line, "# Version:"); ok {
+			return strings.TrimSpace(v)
+		}
+	}
+	return ""
+}
+
+// uniseg has no version constant; its tables are generated from Unicode
+// 15.0.0 as of v0.4.7.
+const unisegUnicodeVersion = "15.0.0"
+
+// DataSource is where a kind of character data comes from.
+type DataSource struct {
+	Data    string // What the data is, e.g. "Block names"
+	Unicode string // Unicode version of the data, "" if not known
+	Source  string // File read by LoadData, or the built-in table or package
+}
+
+// DataSources lists where each kind of character data in use comes from,
+// so a report can state what it was produced with.
+func DataSources() []DataSource {
+	blocksSource := "built-in Blocks.txt"
+	if path, ok := loadedFiles["Blocks.txt"]; ok {
+		blocksSource = path
+	}
+	namesVersion, namesSource := runenames.UnicodeVersion, module("golang.org/x/text")+" runenames"
+	if path, ok := loadedFiles["UnicodeData.txt"]; ok {
+		// UnicodeData.txt has no header; it shares the version of a
+		// Blocks.txt downloaded alongside it
+		namesVersion, namesSource = "", path
+		if blocks, ok := loadedFiles["Blocks.txt"]; ok && filepath.Dir(blocks) == filepath.Dir(path) {
+			namesVersion = dataVersion
+		}
+	}
+	lookalikesVersion, lookalikesSource := "", "built-in list"
+	if path, ok := loadedFiles["confusables.txt"]; ok {
+		lookalikesVersion, lookalikesSource = confusablesVersion, path
+	}
+
+	return []DataSource{
+		{"Block names", dataVersion, blocksSource},
+		{"Character names", namesVersion, namesSource},
+		{"Lookalikes", lookalikesVersion, lookalikesSource},
+		{"Categories and scripts", unicode.Version, "Go " + runtime.Version() + " unicode"},
+		{"Normalization", norm.Version, module("golang.org/x/text") + " norm"},
+		{"Bidi classes", bidi.UnicodeVersion, module("golang.org/x/text") + " bidi"},
+		{"Segmentation", unisegUnicodeVersion, module("github.com/rivo/uniseg")},
+	}
+}
+
+// module returns the path of a dependency with the version the binary
+// was built with, when the build recorded it.
+func module(path string) string {
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, dep := range info.Deps {
+			if dep.Path == path {
+				return path + " " + dep.Version
+			}
+		}
+	}
+	return path
+}
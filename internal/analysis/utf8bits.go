@@ -0,0 +1,50 @@
+package analysis
+
+import (
+	"fmt"
+	"strings"
+)
+
+// UTF8Bits is the bit structure of one byte in a UTF-8 sequence: the
+// marker bits (0, 110, 1110, 11110, or 10 for continuation bytes) and the
+// payload bits that carry part of the codepoint.
+type UTF8Bits struct {
+	Value   byte
+	Marker  string // e.g. "110"
+	Payload string // e.g. "00011"
+}
+
+// UTF8Structure splits each byte of a UTF-8 sequence into marker and
+// payload bits. Bytes that are not valid lead or continuation bytes get
+// an empty marker and all eight bits as payload.
+func UTF8Structure(seq []byte) []UTF8Bits {
+	result := make([]UTF8Bits, 0, len(seq))
+	for _, b := range seq {
+		bits := fmt.Sprintf("%08b", b)
+		marker := ""
+		switch {
+		case b&0x80 == 0x00:
+			marker = "0"
+		case b&0xC0 == 0x80:
+			marker = "10"
+		case b&0xE0 == 0xC0:
+			marker = "110"
+		case b&0xF0 == 0xE0:
+			marker = "1110"
+		case b&0xF8 == 0xF0:
+			marker = "11110"
+		}
+		result = append(result, UTF8Bits{Value: b, Marker: marker, Payload: bits[len(marker):]})
+	}
+	return result
+}
+
+// UTF8Payload concatenates the payload bits of a sequence, which spell
+// out the codepoint in binary.
+func UTF8Payload(bits []UTF8Bits) string {
+	var b strings.Builder
+	for _, bb := range bits {
+		b.WriteString(bb.Payload)
+	}
+	return b.String()
+}
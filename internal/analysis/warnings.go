@@ -0,0 +1,134 @@
+package analysis
+
+import (
+	"unicode"
+)
+
+// Warning identifies a potentially problematic property of a character.
+type Warning int
+
+const (
+	WarningInvisible Warning = iota
+	WarningBidiControl
+	WarningUnusualWhitespace
+	WarningBOM
+	WarningReplacement
+	WarningPrivateUse
+	WarningControl
+)
+
+// AllWarnings lists every warning kind in reporting order.
+var AllWarnings = []Warning{
+	WarningInvisible,
+	WarningBidiControl,
+	WarningUnusualWhitespace,
+	WarningBOM,
+	WarningReplacement,
+	WarningPrivateUse,
+	WarningControl,
+}
+
+// String returns the string representation of a Warning.
+func (w Warning) String() string {
+	switch w {
+	case WarningInvisible:
+		return "invisible"
+	case WarningBidiControl:
+		return "bidi-control"
+	case WarningUnusualWhitespace:
+		return "unusual-whitespace"
+	case WarningBOM:
+		return "bom"
+	case WarningReplacement:
+		return "replacement-char"
+	case WarningPrivateUse:
+		return "private-use"
+	case WarningControl:
+		return "control"
+	default:
+		return "unknown"
+	}
+}
+
+// Description returns a short explanation of the warning.
+func (w Warning) Description() string {
+	switch w {
+	case WarningInvisible:
+		return "zero-width or invisible character"
+	case WarningBidiControl:
+		return "bidirectional text control"
+	case WarningUnusualWhitespace:
+		return "whitespace other than space, tab, LF, or CR"
+	case WarningBOM:
+		return "byte order mark"
+	case WarningReplacement:
+		return "replacement character (likely decoding error)"
+	case WarningPrivateUse:
+		return "private use codepoint"
+	case WarningControl:
+		return "control character"
+	default:
+		return ""
+	}
+}
+
+// isBidiControl reports whether r is a bidirectional formatting character.
+func isBidiControl(r rune) bool {
+	switch {
+	case r == 0x061C, r == 0x200E, r == 0x200F:
+		return true
+	case r >= 0x202A && r <= 0x202E:
+		return true
+	case r >= 0x2066 && r <= 0x2069:
+		return true
+	}
+	return false
+}
+
+// isInvisible reports whether r renders with no visible glyph.
+func isInvisible(r rune) bool {
+	switch r {
+	case 0x00AD, 0x034F, 0x115F, 0x1160, 0x180E, 0x200B, 0x200C, 0x200D,
+		0x2060, 0x2061, 0x2062, 0x2063, 0x2064, 0x3164, 0xFFA0:
+		return true
+	}
+	// Variation selectors and tag characters
+	return (r >= 0xFE00 && r <= 0xFE0F) || (r >= 0xE0000 && r <= 0xE007F) ||
+		(r >= 0xE0100 && r <= 0xE01EF)
+}
+
+// RuneWarnings returns all warnings that apply to a rune.
+func RuneWarnings(r rune) []Warning {
+	var warnings []Warning
+
+	switch {
+	case r == 0xFEFF:
+		warnings = append(warnings, WarningBOM, WarningInvisible)
+	case isBidiControl(r):
+		warnings = append(warnings, WarningBidiControl, WarningInvisible)
+	case isInvisible(r):
+		warnings = append(warnings, WarningInvisible)
+	case r == 0xFFFD:
+		warnings = append(warnings, WarningReplacement)
+	case unicode.Is(unicode.Co, r):
+		warnings = append(warnings, WarningPrivateUse)
+	case classifyRune(r) == CharTypeControl:
+		warnings = append(warnings, WarningControl)
+	case unicode.IsSpace(r) || unicode.Is(unicode.Zs, r):
+		if classifyRune(r) != CharTypeWhitespace {
+			warnings = append(warnings, WarningUnusualWhitespace)
+		}
+	}
+
+	return warnings
+}
+
+// Warnings returns all warnings that apply to the character.
+func (c Character) Warnings() []Warning {
+	return RuneWarnings(c.Rune)
+}
+
+// IsFlagged returns true if the character has any warnings.
+func (c Character) IsFlagged() bool {
+	return len(c.Warnings()) > 0
+}
@@ -0,0 +1,98 @@
+package analysis
+
+import (
+	"unicode"
+)
+
+// Characters named in the Word_Break property definitions of UAX #29
+// rather than derived from other properties.
+var (
+	wordBreakNewline      = []rune{0x000B, 0x000C, 0x0085, 0x2028, 0x2029}
+	wordBreakKatakana     = []rune{0x3031, 0x3032, 0x3033, 0x3034, 0x3035, 0x309B, 0x309C, 0x30A0, 0x30FC, 0xFF70}
+	wordBreakMidNumLet    = []rune{0x002E, 0x2018, 0x2019, 0x2024, 0xFE52, 0xFF07, 0xFF0E}
+	wordBreakMidLetter    = []rune{0x003A, 0x00B7, 0x0387, 0x055F, 0x05F4, 0x2027, 0xFE13, 0xFE55, 0xFF1A}
+	wordBreakMidNum       = []rune{0x002C, 0x003B, 0x037E, 0x0589, 0x060C, 0x060D, 0x066C, 0x07F8, 0x2044, 0xFE10, 0xFE14, 0xFE50, 0xFE54, 0xFF0C, 0xFF1B}
+	wordBreakNoBreakSpace = []rune{0x00A0, 0x2007, 0x202F}
+)
+
+// wordBreakALetter are the modifier letters and punctuation that join
+// words although they are not alphabetic.
+var wordBreakALetter = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x02C2, 0x02C5, 1}, {0x02D2, 0x02D7, 1}, {0x02DE, 0x02DF, 1},
+		{0x02E5, 0x02EB, 1}, {0x02ED, 0x02EF, 2}, {0x02F0, 0x02FF, 1},
+		{0x055A, 0x055C, 1}, {0x055E, 0x058A, 0x2C}, {0x05F3, 0x05F3, 1},
+		{0xA708, 0xA716, 1}, {0xA720, 0xA721, 1}, {0xA789, 0xA78A, 1},
+		{0xAB5B, 0xAB5B, 1},
+	},
+}
+
+// complexContext are the scripts written without spaces between words,
+// whose letters UAX #14 classes as SA and word segmentation leaves to a
+// dictionary rather than to the Word_Break property.
+var complexContext = []*unicode.RangeTable{
+	unicode.Thai, unicode.Lao, unicode.Myanmar, unicode.Khmer, unicode.Tai_Le,
+	unicode.New_Tai_Lue, unicode.Tai_Tham, unicode.Tai_Viet, unicode.Ahom,
+}
+
+// WordBreak returns the Word_Break property of r (UAX #29), such as
+// "ALetter", "MidNum", or "Extend", which decides where word segmentation
+// splits text. It is derived from the property's definition in terms of
+// general category, script, and other properties.
+func WordBreak(r rune) string {
+	in := func(set []rune) bool {
+		for _, c := range set {
+			if c == r {
+				return true
+			}
+		}
+		return false
+	}
+
+	switch {
+	case r == '\r':
+		return "CR"
+	case r == '\n':
+		return "LF"
+	case in(wordBreakNewline):
+		return "Newline"
+	case r == 0x200D:
+		return "ZWJ"
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc, unicode.Other_Grapheme_Extend) ||
+		r >= 0x1F3FB && r <= 0x1F3FF: // Emoji modifiers
+		return "Extend"
+	case r >= 0x1F1E6 && r <= 0x1F1FF:
+		return "Regional_Indicator"
+	case unicode.Is(unicode.Cf, r) && r != 0x200B && r != 0x200C:
+		return "Format"
+	case unicode.Is(unicode.Katakana, r) || in(wordBreakKatakana):
+		return "Katakana"
+	case unicode.Is(unicode.Hebrew, r) && unicode.Is(unicode.Lo, r):
+		return "Hebrew_Letter"
+	case unicode.Is(wordBreakALetter, r), isAlphabetic(r) && !unicode.Is(unicode.Ideographic, r) &&
+		!unicode.In(r, unicode.Hiragana) && !unicode.In(r, complexContext...):
+		return "ALetter"
+	case r == '\'':
+		return "Single_Quote"
+	case r == '"':
+		return "Double_Quote"
+	case in(wordBreakMidNumLet):
+		return "MidNumLet"
+	case in(wordBreakMidLetter):
+		return "MidLetter"
+	case in(wordBreakMidNum):
+		return "MidNum"
+	case unicode.Is(unicode.Nd, r) || r == 0x066B:
+		return "Numeric"
+	case unicode.Is(unicode.Pc, r) || r == 0x202F:
+		return "ExtendNumLet"
+	case unicode.Is(unicode.Zs, r) && !in(wordBreakNoBreakSpace):
+		return "WSegSpace"
+	}
+	return "Other"
+}
+
+// isAlphabetic reports whether r has the Alphabetic property.
+func isAlphabetic(r rune) bool {
+	return unicode.In(r, unicode.L, unicode.Nl, unicode.Other_Alphabetic)
+}
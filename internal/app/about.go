@@ -0,0 +1,90 @@
+package app
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"stringinspect/internal/analysis"
+	"stringinspect/internal/i18n"
+)
+
+// SetVersion sets the version of the binary shown on the about screen.
+func (a *App) SetVersion(version string) {
+	a.version = version
+}
+
+// openAbout shows the version and where the character data comes from.
+func (a *App) openAbout() {
+	a.showAbout = true
+}
+
+// aboutText returns the version and data sources as plain text, for
+// pasting into a report.
+func (a *App) aboutText() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "stringinspect %s (%s %s/%s)\n", a.versionOrDevel(), runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	for _, src := range analysis.DataSources() {
+		fmt.Fprintf(&b, "%s: Unicode %s, %s\n", src.Data, unicodeOrUnknown(src.Unicode), src.Source)
+	}
+	return b.String()
+}
+
+// versionOrDevel returns the version of the binary, "devel" if unset.
+func (a *App) versionOrDevel() string {
+	if a.version == "" {
+		return "devel"
+	}
+	return a.version
+}
+
+// unicodeOrUnknown returns a Unicode version, "?" if not known.
+func unicodeOrUnknown(v string) string {
+	if v == "" {
+		return "?"
+	}
+	return v
+}
+
+// handleAbout handles keyboard input for the about screen.
+func (a *App) handleAbout(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q", "enter", "U":
+		a.showAbout = false
+	case "c":
+		a.statusMsg = i18n.Tf("Copied version and data sources to %s", i18n.T(a.copyText(a.aboutText())))
+	}
+	return a, nil
+}
+
+// renderAbout renders the about screen: the version of the binary and,
+// for each kind of character data, its Unicode version and source.
+func (a *App) renderAbout() string {
+	var b strings.Builder
+
+	b.WriteString(a.styles.Title.Render(i18n.T("About StringInspect")))
+	b.WriteString("\n\n")
+	b.WriteString(a.styles.Muted.Width(24).Render(i18n.T("Version")) + a.versionOrDevel() + "\n")
+	b.WriteString(a.styles.Muted.Width(24).Render(i18n.T("Built with")) + fmt.Sprintf("%s %s/%s", runtime.Version(), runtime.GOOS, runtime.GOARCH) + "\n\n")
+
+	b.WriteString(a.styles.Subtitle.Render(i18n.T("Character Data")))
+	b.WriteString("\n")
+	for _, src := range analysis.DataSources() {
+		version := "Unicode " + unicodeOrUnknown(src.Unicode)
+		b.WriteString(a.styles.Muted.Width(24).Render(i18n.T(src.Data)) + lipgloss.NewStyle().Width(16).Render(version) + src.Source + "\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(a.styles.Muted.Render(i18n.T("update-data downloads newer block, name, and lookalike data")))
+	b.WriteString("\n")
+	b.WriteString(a.styles.Muted.Render(i18n.T("c copy • esc close")))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorPrimary).
+		Padding(1, 2).
+		Render(b.String())
+}
@@ -1,19 +1,29 @@
 package app
 
 import (
+	"errors"
 	"fmt"
+	"path/filepath"
+	"sort"
 	"strings"
+	"time"
+	"unicode/utf8"
 
-	"github.com/atotto/clipboard"
-	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/filepicker"
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
 	"stringinspect/internal/analysis"
+	"stringinspect/internal/config"
 	"stringinspect/internal/export"
 	"stringinspect/internal/history"
+	"stringinspect/internal/i18n"
+	"stringinspect/internal/recent"
+	"stringinspect/internal/session"
+	"stringinspect/internal/transform"
+	"stringinspect/internal/undo"
 )
 
 // ViewMode represents the current display mode.
@@ -23,6 +33,9 @@ const (
 	ViewModeTable ViewMode = iota
 	ViewModeDetail
 	ViewModeCompact
+	ViewModeBytes
+	ViewModeBits
+	ViewModeUnique
 )
 
 func (v ViewMode) String() string {
@@ -33,6 +46,12 @@ func (v ViewMode) String() string {
 		return "Detail"
 	case ViewModeCompact:
 		return "Compact"
+	case ViewModeBytes:
+		return "Bytes"
+	case ViewModeBits:
+		return "Bits"
+	case ViewModeUnique:
+		return "Unique"
 	default:
 		return "Unknown"
 	}
@@ -41,22 +60,195 @@ func (v ViewMode) String() string {
 // App is the main Bubble Tea model for StringInspect.
 type App struct {
 	// Input
-	input       textinput.Model
+	input       inputBox
 	searchInput textinput.Model
+	exportInput textinput.Model
+	importInput textinput.Model
+	gotoInput   textinput.Model
+	scopeInput  textinput.Model
+	replaceFind textinput.Model
+	replaceWith textinput.Model
+	editInput   textinput.Model
+	pickerInput textinput.Model
 	analyzer    *analysis.Analyzer
 	history     *history.History
+	undoStack   *undo.Stack
+	analyzed    string // Input text of the last analysis, for undo
+	undoGroup   string // Undo group of the pending change
 
 	// State
-	characters    []analysis.Character
+	all           []analysis.Character // Full analysis of the input
+	characters    []analysis.Character // Characters passing the filter
+	filter        filter
 	cursor        int
 	viewMode      ViewMode
 	showHelp      bool
-	showExport    bool  // Export menu visible
-	exportCursor  int   // Selected export format
-	showSearch    bool  // Search mode active
-	searchMatches []int // Indices of matching characters
-	searchCursor  int   // Current match index
+	helpOffset    int    // First visible line of the help screen
+	showExport    bool   // Export menu visible
+	exportCursor  int    // Selected export format
+	exportNaming  bool   // Export filename prompt active
+	exportConfirm bool   // Overwrite confirmation active
+	exportDiff    bool   // Export the differences between the split panes
+	showImport    bool   // Import prompt visible
+	showGoto      bool   // Goto-offset prompt visible
+	showScope     bool   // Script/block filter prompt visible
+	showReplace   bool   // Search & replace prompt visible
+	replaceRegex  bool   // Replace pattern is a regular expression
+	editOp        editOp // Pending in-place replace/insert
+	showPicker    bool   // Character picker visible
+	pickerResults []rune // Picker candidates
+	pickerCursor  int    // Selected picker candidate
+	showBrowser   bool   // Unicode block browser visible
+	browserBlock  int    // Index of the browsed block
+	browserCursor rune   // Selected codepoint in the browser
+	showSearch    bool   // Search mode active
+	searchQuery   string // Confirmed query whose matches stay highlighted
+	searchMatches []int  // Indices of matching characters
+	searchCursor  int    // Current match index
+	pendingKey    string // First key of a two-key sequence (e.g. "]")
+	byteFocus     bool   // Byte pane focused in the bytes view
+	byteCursor    int    // Selected byte in the bytes view
+	bitsPerByte   bool   // Bit grid shows one row per byte, not per rune
+	tableVertical bool   // Table view lists one character per row
+	tableWrap     bool   // Table view wraps characters into stacked bands
+	uniqueSort    uniqueSort
+	tableStart    int          // First character in the horizontal table window
+	tableCursor   int          // Cursor the window last followed
+	marks         map[rune]int // Rune offsets set with m, by mark letter
 	statusMsg     string
+	register      string // Last copy, pasted when there is no system clipboard
+
+	// Keyboard macros, recorded with Q and replayed with @
+	macros    map[rune][]tea.KeyMsg
+	macroReg  rune         // Register being recorded, 0 when not recording
+	macroKeys []tea.KeyMsg // Keys recorded so far
+	lastMacro rune         // Register @@ replays
+	replaying bool
+	count     int // Count typed before @, 0 when none
+
+	// Search & replace session, nil when idle
+	replacing *replaceState
+
+	// Split view; the inactive pane is stored in other
+	split      bool
+	syncScroll bool
+	activePane int
+	other      *pane
+
+	// File browser and the directory it starts in
+	showFiles  bool
+	filePicker filepicker.Model
+	fileDir    string
+
+	// Recently opened files and where to save them ("" to not save)
+	recentFiles  *recent.List
+	recentPath   string
+	showRecent   bool
+	recentCursor int
+
+	// Open buffers; the active one's state lives in the fields above
+	tabs      []*tab
+	activeTab int
+	gReturn   [2]int // Cursor and byte cursor before "g", restored by "gt"
+
+	// Table columns by config name; empty means the default layout
+	tableColumns    []string
+	verticalColumns []string
+	showColumns     bool // Column editor visible
+	showStats       bool // Statistics panel visible
+	statsCursor     int  // Selected checksum in the statistics panel
+	statsSummary    *statsSummary
+
+	// UTF-8 encoding walkthrough
+	showTutorial  bool
+	tutorialChar  analysis.Character
+	tutorialStep  int
+	columnChoices []columnChoice
+	columnCursor  int
+
+	// File the input was loaded from, nil for typed input
+	source *source
+
+	// Hex editing of a binary file and the first digit typed, or -1
+	hexEdit   bool
+	hexNibble int
+	quitArmed bool // Quit was pressed once with unsaved byte edits
+
+	// Hex dump byte group size (1, 2, 4, or 8) and byte order
+	dumpGroup  int
+	dumpLittle bool
+
+	// Codepage the hex dump shows bytes in, nil for ASCII
+	codepage       *analysis.Codepage
+	showCodepages  bool
+	codepageCursor int
+
+	// Characters the last raw export could not represent
+	losses     []export.Loss
+	showLosses bool
+	lossCursor int
+
+	// Compatibility audit and the index of its target in analysis.Charsets
+	showAudit   bool
+	auditTarget int
+	auditCursor int
+
+	// Key capture screen, the terminal input it reads raw bytes from, and
+	// whether it is recording rather than browsing what was recorded
+	showKeyCapture bool
+	keyRecording   bool
+	keyEvents      []keyEvent
+	keyCursor      int
+	inputTap       *InputTap
+
+	// Last paste and the report of what the input lost of it
+	paste       *pasteReport
+	showPaste   bool
+	pasteCursor int
+
+	// Bidirectional view: the resolved paragraphs of the visible text and
+	// the selected character
+	showBidi   bool
+	bidiParas  []analysis.BidiParagraph
+	bidiCursor int
+
+	// Line break preview: the break opportunities of the visible text, the
+	// width it is wrapped at, and the first line shown
+	showLineBreaks bool
+	lineBreaks     []analysis.LineBreak
+	breakWidth     int
+	breakTop       int
+
+	// About screen and the version of the binary it shows
+	showAbout bool
+	version   string
+
+	// Autosaved session: the file it is saved to and the state last saved,
+	// and a session left by a crash that may be restored, with its file
+	sessionPath  string
+	sessionState []byte
+	restoreOffer *session.Session
+	restorePath  string
+
+	// Last keys pressed and a panic caught in Update or View, for a crash
+	// report
+	recentKeys []string
+	crash      *crashInfo
+	debug      bool // Log to the standard logger
+
+	// Decoded tokens panel and the scan it shows
+	showTokens  bool
+	tokenCursor int
+	tokenScan   *tokenScan
+
+	// Transform menu
+	transforms      []transform.Transform
+	showTransforms  bool
+	transformCursor int
+
+	// Persistent preferences and where to save them ("" to not save)
+	config     *config.Config
+	configPath string
 
 	// Export
 	exporter *export.Exporter
@@ -66,7 +258,6 @@ type App struct {
 	height int
 	styles Styles
 	keys   KeyMap
-	help   help.Model
 
 	// Flags
 	ready bool
@@ -80,11 +271,11 @@ func New() *App {
 
 // NewWithContent creates a new App instance with initial content.
 func NewWithContent(content string) *App {
-	ti := textinput.New()
-	ti.Placeholder = "Type or paste text to analyze..."
+	ti := inputBox{Model: textinput.New()}
+	ti.Placeholder = i18n.T("Type or paste text to analyze...")
 	ti.Prompt = "> "
 	ti.Focus()
-	ti.CharLimit = 10000 // Increased for file content
+	ti.CharLimit = inputLimit
 	ti.Width = 60
 
 	// Set initial content if provided
@@ -94,51 +285,150 @@ func NewWithContent(content string) *App {
 
 	// Search input
 	si := textinput.New()
-	si.Placeholder = "hex, dec, or char..."
+	si.Placeholder = i18n.T("hex, dec, char, name:, cat:, script:")
 	si.Prompt = "/ "
-	si.CharLimit = 50
-	si.Width = 30
-
-	h := help.New()
-	h.ShowAll = false
+	si.CharLimit = 64
+	si.Width = 40
+
+	// Export filename input
+	ei := textinput.New()
+	ei.Prompt = i18n.T("File: ")
+	ei.CharLimit = 4096
+	ei.Width = 50
+
+	// Import path input
+	ii := textinput.New()
+	ii.Placeholder = "report.json"
+	ii.Prompt = i18n.T("File: ")
+	ii.CharLimit = 4096
+	ii.Width = 50
+
+	// Goto offset input
+	gi := textinput.New()
+	gi.Placeholder = i18n.T("123, b4096, or 12:5")
+	gi.Prompt = ": "
+	gi.CharLimit = 32
+	gi.Width = 30
+
+	// Script/block filter input
+	sci := textinput.New()
+	sci.Placeholder = "Cyrillic, !Latin, block:Arrows"
+	sci.Prompt = i18n.T("Filter: ")
+	sci.CharLimit = 64
+	sci.Width = 40
+
+	// Search & replace inputs
+	rfi := textinput.New()
+	rfi.Placeholder = i18n.T("text or \\u200B")
+	rfi.Prompt = i18n.T("Find:    ")
+	rfi.CharLimit = 256
+	rfi.Width = 40
+
+	rwi := textinput.New()
+	rwi.Prompt = i18n.T("Replace: ")
+	rwi.CharLimit = 256
+	rwi.Width = 40
+
+	// In-place edit input
+	edi := textinput.New()
+	edi.Placeholder = i18n.T("A, U+00A0, or \\u200B")
+	edi.Prompt = "> "
+	edi.CharLimit = 256
+	edi.Width = 40
+
+	// Character picker input
+	pki := textinput.New()
+	pki.Placeholder = i18n.T("U+00A0 or no-break space")
+	pki.Prompt = "> "
+	pki.CharLimit = 64
+	pki.Width = 40
 
 	app := &App{
 		input:       ti,
 		searchInput: si,
+		exportInput: ei,
+		importInput: ii,
+		gotoInput:   gi,
+		scopeInput:  sci,
+		replaceFind: rfi,
+		replaceWith: rwi,
+		editInput:   edi,
+		pickerInput: pki,
 		analyzer:    analysis.NewAnalyzer(),
+		transforms:  transform.List(transform.Options{}),
 		exporter:    export.NewExporter(),
 		history:     history.New(100),
+		undoStack:   undo.New(200),
+		config:      &config.Config{},
 		styles:      DefaultStyles(),
 		keys:        DefaultKeyMap(),
-		help:        h,
 		viewMode:    ViewModeTable,
+		dumpGroup:   1,
 	}
 
-	// Analyze initial content if provided
+	app.tabs = []*tab{{}}
+
+	// Analyze initial content if provided; it is the undo baseline
 	if content != "" {
+		app.analyzed = ti.Value()
 		app.analyzeInput()
 	}
 
 	return app
 }
 
+// SetConfig applies persistent preferences. Changes made in the UI are
+// written back to path unless it is empty.
+func (a *App) SetConfig(cfg *config.Config, path string) {
+	a.config = cfg
+	a.configPath = path
+	a.tableColumns = cfg.TableColumns
+	a.verticalColumns = cfg.VerticalColumns
+	a.transforms = transform.List(transform.Options{Invisible: cfg.Sanitize})
+	a.setReducedMotion(cfg.ReducedMotion)
+	if p, err := analysis.ParsePlaceholders(cfg.Placeholders); err == nil {
+		a.analyzer.Placeholders = p
+		a.analyzeInput()
+	}
+}
+
+// saveConfig writes the preferences back to the config file, if any.
+func (a *App) saveConfig() error {
+	if a.configPath == "" {
+		return nil
+	}
+	return a.config.Save(a.configPath)
+}
+
+// SetTemplatePath sets the template file used by the template export format.
+func (a *App) SetTemplatePath(path string) {
+	a.exporter.TemplatePath = path
+}
+
 // Init implements tea.Model.
 func (a *App) Init() tea.Cmd {
-	return textinput.Blink
+	return tea.Batch(textinput.Blink, a.autosaveTick())
 }
 
 // Update implements tea.Model.
 func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	defer a.catchPanic()
 	var cmds []tea.Cmd
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
-		return a.handleKeyPress(msg)
+		a.recordKey(msg)
+		model, cmd := a.handleKeyPress(msg)
+		a.syncPanes()
+		return model, cmd
+
+	case autosaveMsg:
+		a.autosave()
+		return a, a.autosaveTick()
 
 	case tea.WindowSizeMsg:
 		a.width = msg.Width
 		a.height = msg.Height
-		a.help.Width = msg.Width
 		// Update input width to fit terminal (with padding)
 		inputWidth := msg.Width - 8 // Account for prompt and padding
 		if inputWidth > 200 {
@@ -151,23 +441,52 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.ready = true
 	}
 
+	// The file browser reads directories asynchronously
+	if a.showFiles {
+		var cmd tea.Cmd
+		a.filePicker, cmd = a.filePicker.Update(msg)
+		cmds = append(cmds, cmd)
+	}
+
 	// Update text input
+	prev := a.input.Value()
 	var cmd tea.Cmd
-	a.input, cmd = a.input.Update(msg)
+	a.input.Model, cmd = a.input.Update(msg)
 	cmds = append(cmds, cmd)
 
-	// Analyze input on change
-	a.analyzeInput()
+	// Analyze input on change (blink ticks must not clear the status message)
+	if a.input.Value() != prev {
+		a.undoGroup = undoGroupTyping
+		a.analyzeInput()
+	}
 
 	return a, tea.Batch(cmds...)
 }
 
 // handleKeyPress processes keyboard input.
 func (a *App) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	// Always allow quit (but not in search mode)
-	if key.Matches(msg, a.keys.Quit) && !a.showSearch {
+	// Key capture records every key, even quit
+	if a.showKeyCapture {
+		return a.handleKeyCapture(msg)
+	}
+
+	// The offer to restore a crashed session comes before anything else
+	if a.restoreOffer != nil {
+		return a.handleRestore(msg)
+	}
+
+	// Always allow quit (but not while typing into a prompt)
+	if key.Matches(msg, a.keys.Quit) && !a.capturingText() {
+		if n := a.unsavedFiles(); n > 0 && !a.quitArmed {
+			a.quitArmed = true
+			a.statusMsg = i18n.Tf("%d file(s) with unsaved byte edits • ctrl+s saves • q again quits", n)
+			return a, nil
+		}
 		return a, tea.Quit
 	}
+	a.quitArmed = false
+
+	a.recordMacroKey(msg)
 
 	// Handle search mode
 	if a.showSearch {
@@ -179,19 +498,160 @@ func (a *App) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return a.handleExportMenu(msg)
 	}
 
-	// Toggle help
+	// Handle import prompt if visible
+	if a.showImport {
+		return a.handleImportPrompt(msg)
+	}
+
+	// Handle goto prompt if visible
+	if a.showGoto {
+		return a.handleGotoPrompt(msg)
+	}
+
+	// Handle script/block filter prompt if visible
+	if a.showScope {
+		return a.handleScopePrompt(msg)
+	}
+
+	// Handle search & replace prompt and confirmation
+	if a.showReplace {
+		return a.handleReplacePrompt(msg)
+	}
+	if a.replacing != nil {
+		return a.handleReplaceConfirm(msg)
+	}
+
+	// Handle in-place edit prompt if visible
+	if a.editOp != editNone {
+		return a.handleEditPrompt(msg)
+	}
+
+	// Handle character picker if visible
+	if a.showPicker {
+		return a.handlePicker(msg)
+	}
+
+	// Handle block browser if visible
+	if a.showBrowser {
+		return a.handleBrowser(msg)
+	}
+
+	// Handle column editor if visible
+	if a.showColumns {
+		return a.handleColumnEditor(msg)
+	}
+
+	// Handle status bar menu if visible
+	if a.showStats {
+		return a.handleStatsMenu(msg)
+	}
+
+	// Handle encoding tutorial if visible
+	if a.showTutorial {
+		return a.handleTutorial(msg)
+	}
+
+	// Handle transform menu if visible
+	if a.showTransforms {
+		return a.handleTransforms(msg)
+	}
+
+	// Handle decoded tokens panel if visible
+	if a.showTokens {
+		return a.handleTokens(msg)
+	}
+
+	// Handle file browser if visible
+	if a.showFiles {
+		return a.handleFilePicker(msg)
+	}
+
+	// Handle recent files if visible
+	if a.showRecent {
+		return a.handleRecent(msg)
+	}
+
+	// Handle codepage list if visible
+	if a.showCodepages {
+		return a.handleCodepages(msg)
+	}
+
+	// Handle transcoding loss report if visible
+	if a.showLosses {
+		return a.handleLosses(msg)
+	}
+
+	// Handle paste report if visible
+	if a.showPaste {
+		return a.handlePaste(msg)
+	}
+
+	// Handle compatibility audit if visible
+	if a.showAudit {
+		return a.handleAudit(msg)
+	}
+
+	// Handle bidirectional view if visible
+	if a.showBidi {
+		return a.handleBidi(msg)
+	}
+
+	// Handle line break preview if visible
+	if a.showLineBreaks {
+		return a.handleLineBreaks(msg)
+	}
+
+	// Handle about screen if visible
+	if a.showAbout {
+		return a.handleAbout(msg)
+	}
+
+	// Help screen
+	if a.showHelp {
+		return a.handleHelp(msg)
+	}
 	if key.Matches(msg, a.keys.Help) {
-		a.showHelp = !a.showHelp
-		a.help.ShowAll = a.showHelp
+		a.showHelp = true
+		a.helpOffset = 0
 		return a, nil
 	}
 
+	// Open the block browser from input or navigation mode
+	if key.Matches(msg, a.keys.Browser) {
+		a.openBrowser()
+		return a, nil
+	}
+
+	// Tabs and files can be managed from input or navigation mode
+	switch {
+	case key.Matches(msg, a.keys.OpenFile):
+		return a, a.openFilePicker()
+	case key.Matches(msg, a.keys.RecentFiles):
+		a.openRecent()
+		return a, nil
+	case key.Matches(msg, a.keys.NewTab):
+		a.newTab()
+		return a, nil
+	case key.Matches(msg, a.keys.CloseTab):
+		a.closeTab()
+		return a, nil
+	case key.Matches(msg, a.keys.NextTab), key.Matches(msg, a.keys.PrevTab):
+		a.cycleTab(key.Matches(msg, a.keys.NextTab))
+		return a, nil
+	}
+
+	// A binary file has no text to type into
+	if a.binary() {
+		a.input.Blur()
+	}
+
 	// If input is focused, let it handle most keys
 	if a.input.Focused() {
 		// Tab switches to navigation mode
 		if key.Matches(msg, a.keys.Tab) {
 			if len(a.characters) > 0 {
 				a.input.Blur()
+				a.undoStack.Break()
 			}
 			return a, nil
 		}
@@ -201,6 +661,7 @@ func (a *App) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			prev := a.history.Up(a.input.Value())
 			a.input.SetValue(prev)
 			a.input.CursorEnd()
+			a.undoGroup = undoGroupHistory
 			a.analyzeInput()
 			return a, nil
 		}
@@ -208,10 +669,24 @@ func (a *App) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			next := a.history.Down()
 			a.input.SetValue(next)
 			a.input.CursorEnd()
+			a.undoGroup = undoGroupHistory
 			a.analyzeInput()
 			return a, nil
 		}
 
+		// Pin or unpin the current input in history
+		if key.Matches(msg, a.keys.Pin) {
+			if a.input.Value() == "" {
+				return a, nil
+			}
+			if a.history.TogglePin(a.input.Value()) {
+				a.statusMsg = i18n.T("Pinned to history")
+			} else {
+				a.statusMsg = i18n.T("Unpinned from history")
+			}
+			return a, nil
+		}
+
 		// Enter commits current input to history
 		if key.Matches(msg, a.keys.Enter) {
 			a.history.Add(a.input.Value())
@@ -219,21 +694,161 @@ func (a *App) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			return a, nil
 		}
 
-		// Let input handle the key
+		// Undo/redo while typing
+		if msg.Type == tea.KeyCtrlZ || key.Matches(msg, a.keys.Redo) {
+			a.restoreUndo(key.Matches(msg, a.keys.Redo))
+			return a, nil
+		}
+
+		// The input shows held text with placeholders, which it must not edit
+		if a.input.holding {
+			a.statusMsg = i18n.Tf("The text %s • tab, then x/r/i edit in place", a.input.holdReason())
+			return a, nil
+		}
+
+		// Let input handle the key; a paste too long for it is held whole
+		before := a.input.Value()
 		var cmd tea.Cmd
-		a.input, cmd = a.input.Update(msg)
+		if msg.Paste && utf8.RuneCountInString(before)+len(msg.Runes) > a.input.CharLimit {
+			a.input.Insert(string(msg.Runes))
+		} else {
+			a.input.Model, cmd = a.input.Update(msg)
+		}
+		a.undoGroup = undoGroupTyping
 		a.analyzeInput()
+		if msg.Paste {
+			a.checkPaste(msg, before)
+		}
 		return a, cmd
 	}
 
 	// Navigation mode
+	// "gt"/"gT" switch tabs. The "g" has already moved to the start, so
+	// the cursor goes back before leaving the tab.
+	if a.pendingKey == "g" {
+		a.pendingKey = ""
+		if s := msg.String(); s == "t" || s == "T" {
+			a.cursor, a.byteCursor = a.gReturn[0], a.gReturn[1]
+			a.cycleTab(s == "t")
+			return a, nil
+		}
+	}
+
+	// Complete a mark: "ma" sets mark a, "`a" jumps to it
+	if a.pendingKey == "m" || a.pendingKey == "`" {
+		set := a.pendingKey == "m"
+		a.pendingKey = ""
+		switch {
+		case msg.Type == tea.KeyEsc:
+		case set:
+			a.setMark(msg.String())
+		default:
+			a.jumpToMark(msg.String())
+		}
+		return a, nil
+	}
+
+	// Complete a macro: "Qa" records into register a, "@a" replays it
+	// count times
+	if a.pendingKey == "Q" || a.pendingKey == "@" {
+		record := a.pendingKey == "Q"
+		a.pendingKey = ""
+		count := max(a.count, 1)
+		a.count = 0
+		switch {
+		case msg.Type == tea.KeyEsc:
+		case record:
+			a.startMacro(msg.String())
+		default:
+			return a, a.replayMacro(msg.String(), count)
+		}
+		return a, nil
+	}
+
+	// Complete a pending two-key jump sequence such as "]c"
+	if a.pendingKey == "]" || a.pendingKey == "[" {
+		forward := a.pendingKey == "]"
+		a.pendingKey = ""
+		if msg.Type != tea.KeyEsc {
+			a.jumpToClass(msg.String(), forward)
+		}
+		return a, nil
+	}
+
+	// Hex editing takes hex digits before anything else
+	if a.hexEdit {
+		if !a.binary() || a.viewMode != ViewModeCompact {
+			a.hexEdit = false
+		} else if a.handleHexEdit(msg) {
+			return a, nil
+		}
+	}
+
+	// Digits make a count for the next @; a count cannot start with 0
+	if s := msg.String(); len(s) == 1 && s[0] >= '0' && s[0] <= '9' && (s != "0" || a.count > 0) {
+		a.count = min(a.count*10+int(s[0]-'0'), 9999)
+		a.statusMsg = fmt.Sprintf("%d@", a.count)
+		return a, nil
+	}
+	if !key.Matches(msg, a.keys.ReplayMacro) {
+		a.count = 0
+	}
+
+	// "g" moves to the start right away but may also begin "gt"
+	if msg.String() == "g" {
+		a.pendingKey = "g"
+		a.gReturn = [2]int{a.cursor, a.byteCursor}
+	}
+
+	// Rune/byte view has its own movement keys
+	if a.viewMode == ViewModeBytes && a.handleBytesView(msg) {
+		a.statusMsg = ""
+		return a, nil
+	}
+	if a.viewMode == ViewModeTable && a.tableVertical && a.handleVerticalTable(msg) {
+		a.statusMsg = ""
+		return a, nil
+	}
+	if a.viewMode == ViewModeTable && a.tableWrap && !a.tableVertical && a.handleWrappedTable(msg) {
+		a.statusMsg = ""
+		return a, nil
+	}
+	if a.viewMode == ViewModeBits && a.handleBitsView(msg) {
+		a.statusMsg = ""
+		return a, nil
+	}
+	if a.viewMode == ViewModeUnique && a.handleUniqueView(msg) {
+		return a, nil
+	}
+	if a.viewMode == ViewModeCompact && a.handleCompactView(msg) {
+		return a, nil
+	}
+
+	// Binary files are edited byte by byte instead
+	if a.binary() {
+		switch {
+		case key.Matches(msg, a.keys.ReplaceChar):
+			a.startHexEdit()
+			return a, nil
+		case key.Matches(msg, a.keys.Undo):
+			a.undoByte()
+			return a, nil
+		}
+	}
+
+	// Editing keys need text, which a binary file shown as bytes lacks
+	if key.Matches(msg, a.keys.Paste, a.keys.Delete, a.keys.ReplaceChar, a.keys.Insert, a.keys.Picker,
+		a.keys.Replace, a.keys.Transforms, a.keys.Unescape, a.keys.Undo, a.keys.Redo) && a.binaryLocked() {
+		return a, nil
+	}
+
 	// Clear status message on navigation (but not on copy/paste)
 	clearStatus := true
 
 	switch {
 	case key.Matches(msg, a.keys.Tab):
 		// Cycle view mode or return to input
-		if a.viewMode == ViewModeCompact {
+		if a.viewMode == ViewModeUnique {
 			a.viewMode = ViewModeTable
 			a.input.Focus()
 		} else {
@@ -251,31 +866,23 @@ func (a *App) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 
 	case key.Matches(msg, a.keys.Home):
+		a.recordJump()
 		a.cursor = 0
 
 	case key.Matches(msg, a.keys.End):
+		a.recordJump()
 		if len(a.characters) > 0 {
 			a.cursor = len(a.characters) - 1
 		}
 
 	case key.Matches(msg, a.keys.PageUp):
-		// Move cursor up by page size (based on visible chars)
-		pageSize := (a.width - 20) / 10
-		if pageSize < 1 {
-			pageSize = 1
-		}
-		a.cursor -= pageSize
+		a.cursor -= a.pageSize(a.screenChars())
 		if a.cursor < 0 {
 			a.cursor = 0
 		}
 
 	case key.Matches(msg, a.keys.PageDown):
-		// Move cursor down by page size (based on visible chars)
-		pageSize := (a.width - 20) / 10
-		if pageSize < 1 {
-			pageSize = 1
-		}
-		a.cursor += pageSize
+		a.cursor += a.pageSize(a.screenChars())
 		if a.cursor >= len(a.characters) {
 			a.cursor = len(a.characters) - 1
 		}
@@ -289,22 +896,26 @@ func (a *App) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if a.cursor < len(a.characters) {
 			char := a.characters[a.cursor]
 			copyText := fmt.Sprintf("%s (U+%04X, 0x%s, %d)", char.Char, char.Dec, char.Hex, char.Dec)
-			if err := clipboard.WriteAll(copyText); err != nil {
-				a.statusMsg = "Copy failed"
-			} else {
-				a.statusMsg = fmt.Sprintf("Copied: %s", copyText)
-			}
+			backend := a.copyText(copyText)
+			a.statusMsg = i18n.Tf("Copied to %s: %s", i18n.T(backend), copyText)
 		}
 
+	case key.Matches(msg, a.keys.CopyEsc):
+		clearStatus = false
+		// Copy the whole input with non-ASCII characters escaped
+		escaped := export.EscapeUnicode(a.input.Value(), a.exporter.EscapeStyle)
+		backend := a.copyText(escaped)
+		a.statusMsg = i18n.Tf("Copied %s-escaped string (%d chars) to %s", a.exporter.EscapeStyle, len(escaped), i18n.T(backend))
+
 	case key.Matches(msg, a.keys.Paste):
 		clearStatus = false
 		// Paste from clipboard
-		if text, err := clipboard.ReadAll(); err == nil && text != "" {
+		if text, backend := a.pasteText(); text != "" {
 			a.input.SetValue(text)
 			a.analyzeInput()
-			a.statusMsg = fmt.Sprintf("Pasted %d chars", len([]rune(text)))
+			a.statusMsg = i18n.Tf("Pasted %d chars from %s", len([]rune(text)), i18n.T(backend))
 		} else {
-			a.statusMsg = "Paste failed"
+			a.statusMsg = i18n.Tf("Nothing to paste (%s is empty)", i18n.T(backend))
 		}
 
 	case key.Matches(msg, a.keys.Export):
@@ -313,10 +924,55 @@ func (a *App) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			a.showExport = true
 			a.exportCursor = 0
 		} else {
-			a.statusMsg = "Nothing to export"
+			a.statusMsg = i18n.T("Nothing to export")
 		}
 		clearStatus = false
 
+	case key.Matches(msg, a.keys.Import):
+		// Open the import prompt for a previous JSON export
+		a.showImport = true
+		a.importInput.SetValue("")
+		a.importInput.Focus()
+		clearStatus = false
+
+	case key.Matches(msg, a.keys.JumpNext), key.Matches(msg, a.keys.JumpPrev),
+		key.Matches(msg, a.keys.Mark), key.Matches(msg, a.keys.JumpMark),
+		key.Matches(msg, a.keys.ReplayMacro):
+		a.pendingKey = msg.String()
+		clearStatus = false
+
+	case key.Matches(msg, a.keys.Macro):
+		if a.macroReg != 0 {
+			a.stopMacro()
+		} else {
+			a.pendingKey = "Q"
+		}
+		clearStatus = false
+
+	case key.Matches(msg, a.keys.Filter):
+		a.setFilter(filter{mode: a.filter.mode.Next(), scope: a.filter.scope})
+		clearStatus = false
+
+	case key.Matches(msg, a.keys.ScopeFilter):
+		a.showScope = true
+		a.scopeInput.SetValue("")
+		if a.filter.scope != nil {
+			a.scopeInput.SetValue(a.filter.scope.String())
+			a.scopeInput.CursorEnd()
+		}
+		a.scopeInput.Focus()
+		clearStatus = false
+
+	case key.Matches(msg, a.keys.ClearFilter):
+		a.setFilter(filter{})
+		clearStatus = false
+
+	case key.Matches(msg, a.keys.Goto):
+		a.showGoto = true
+		a.gotoInput.SetValue("")
+		a.gotoInput.Focus()
+		clearStatus = false
+
 	case key.Matches(msg, a.keys.Search):
 		// Enter search mode
 		if len(a.characters) > 0 {
@@ -326,10 +982,156 @@ func (a *App) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			a.searchMatches = nil
 			a.searchCursor = 0
 		} else {
-			a.statusMsg = "Nothing to search"
+			a.statusMsg = i18n.T("Nothing to search")
+		}
+		clearStatus = false
+
+	case key.Matches(msg, a.keys.Delete):
+		a.deleteAtCursor()
+		clearStatus = false
+
+	case key.Matches(msg, a.keys.ReplaceChar):
+		a.startEdit(editReplace)
+		clearStatus = false
+
+	case key.Matches(msg, a.keys.Insert):
+		a.startEdit(editInsert)
+		clearStatus = false
+
+	case key.Matches(msg, a.keys.Picker):
+		a.openPicker()
+		clearStatus = false
+
+	case key.Matches(msg, a.keys.Orientation):
+		a.tableVertical = !a.tableVertical
+		a.tableWrap = false
+		a.viewMode = ViewModeTable
+		if a.tableVertical {
+			a.statusMsg = i18n.T("Table: one character per row")
+		} else {
+			a.statusMsg = i18n.T("Table: one character per column")
+		}
+		clearStatus = false
+
+	case key.Matches(msg, a.keys.Wrap):
+		a.toggleWrap()
+		clearStatus = false
+
+	case key.Matches(msg, a.keys.ScrollLeft):
+		a.scrollTable(-1)
+
+	case key.Matches(msg, a.keys.ScrollRight):
+		a.scrollTable(1)
+
+	case key.Matches(msg, a.keys.Placeholders):
+		a.cyclePlaceholders()
+		clearStatus = false
+
+	case key.Matches(msg, a.keys.Tutorial):
+		a.openTutorial()
+
+	case key.Matches(msg, a.keys.Transforms):
+		a.openTransforms()
+		clearStatus = false
+
+	case key.Matches(msg, a.keys.Tokens):
+		a.openTokens()
+		clearStatus = false
+
+	case key.Matches(msg, a.keys.Codepage):
+		a.openCodepages()
+
+	case key.Matches(msg, a.keys.Audit):
+		a.openAudit()
+		clearStatus = false
+
+	case key.Matches(msg, a.keys.Bidi):
+		a.openBidi()
+		clearStatus = false
+
+	case key.Matches(msg, a.keys.LineBreaks):
+		a.openLineBreaks()
+		clearStatus = false
+
+	case key.Matches(msg, a.keys.About):
+		a.openAbout()
+
+	case key.Matches(msg, a.keys.KeyCapture):
+		a.openKeyCapture()
+
+	case key.Matches(msg, a.keys.Encoding):
+		a.cycleEncoding()
+		clearStatus = false
+
+	case key.Matches(msg, a.keys.Save):
+		a.saveSource()
+		clearStatus = false
+
+	case key.Matches(msg, a.keys.Unescape):
+		if t, ok := transform.Find(a.transforms, "\\"); ok {
+			a.applyTransform(t)
+		}
+		clearStatus = false
+
+	case key.Matches(msg, a.keys.Stats):
+		a.showStats = true
+		a.statsCursor = 0
+		clearStatus = false
+
+	case key.Matches(msg, a.keys.Columns):
+		a.openColumnEditor()
+		clearStatus = false
+
+	case key.Matches(msg, a.keys.Split):
+		a.toggleSplit()
+		clearStatus = false
+
+	case key.Matches(msg, a.keys.SwitchPane):
+		a.switchPane()
+		clearStatus = false
+
+	case key.Matches(msg, a.keys.SyncScroll):
+		if a.split {
+			a.syncScroll = !a.syncScroll
+			a.syncPanes()
+			a.statusMsg = i18n.T("Synchronized scrolling off")
+			if a.syncScroll {
+				a.statusMsg = i18n.T("Synchronized scrolling on")
+			}
 		}
 		clearStatus = false
 
+	case key.Matches(msg, a.keys.Undo):
+		a.restoreUndo(false)
+		clearStatus = false
+
+	case key.Matches(msg, a.keys.Redo):
+		a.restoreUndo(true)
+		clearStatus = false
+
+	case key.Matches(msg, a.keys.Replace):
+		a.showReplace = true
+		a.replaceFind.SetValue("")
+		a.replaceWith.SetValue("")
+		a.replaceWith.Blur()
+		a.replaceFind.Focus()
+		clearStatus = false
+
+	case key.Matches(msg, a.keys.NextMatch):
+		a.jumpToMatch(true)
+		clearStatus = false
+
+	case key.Matches(msg, a.keys.PrevMatch):
+		a.jumpToMatch(false)
+		clearStatus = false
+
+	case key.Matches(msg, a.keys.Escape) && a.searchQuery != "":
+		// First escape clears search highlights
+		a.searchQuery = ""
+		a.searchMatches = nil
+		a.statusMsg = i18n.T("Search cleared")
+		clearStatus = false
+
 	case key.Matches(msg, a.keys.Enter), key.Matches(msg, a.keys.Escape):
 		a.input.Focus()
 	}
@@ -341,10 +1143,29 @@ func (a *App) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return a, nil
 }
 
+// capturingText reports whether a prompt is active that consumes
+// printable keys, so they must not trigger global bindings.
+func (a *App) capturingText() bool {
+	return a.showSearch || a.exportNaming || a.showImport || a.showGoto || a.showScope ||
+		a.showReplace || a.replacing != nil || a.editOp != editNone ||
+		a.showPicker || a.showBrowser || a.showColumns || a.showStats ||
+		a.showTutorial || a.showTransforms || a.showTokens || a.showFiles ||
+		a.showRecent || a.showCodepages || a.showLosses || a.showAudit || a.showKeyCapture ||
+		a.showPaste || a.showBidi || a.showLineBreaks || a.showAbout || a.restoreOffer != nil ||
+		a.awaitingMark() || a.awaitingMacro()
+}
+
 // analyzeInput processes the current input text.
 func (a *App) analyzeInput() {
 	input := a.input.Value()
-	a.characters = a.analyzer.AnalyzeString(input)
+	a.recordUndo(input)
+	start := time.Now()
+	a.all = a.analyze()
+	analyzed := time.Since(start)
+	a.characters = a.filter.apply(a.all)
+	a.refreshSearch()
+	a.debugf("analyzed %d bytes into %d characters in %s, %d shown after filter and search in %s",
+		len(input), len(a.all), analyzed, len(a.characters), time.Since(start)-analyzed)
 
 	// Clear status message on input change
 	a.statusMsg = ""
@@ -358,40 +1179,153 @@ func (a *App) analyzeInput() {
 	}
 }
 
+// exportShortcuts holds the key that selects each export format, in menu
+// order: the digits, then letters the menu does not already bind.
+const exportShortcuts = "123456789abcfghilmnoprtuvwxyz"
+
 // handleExportMenu handles keyboard input for the export menu.
 func (a *App) handleExportMenu(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if a.exportConfirm {
+		return a.handleExportConfirm(msg)
+	}
+	if a.exportNaming {
+		return a.handleExportFilename(msg)
+	}
+
 	switch msg.String() {
 	case "up", "k":
 		if a.exportCursor > 0 {
 			a.exportCursor--
 		}
 	case "down", "j":
-		if a.exportCursor < 2 { // 3 formats: 0, 1, 2
+		if a.exportCursor < len(export.Formats)-1 {
 			a.exportCursor++
 		}
 	case "enter":
-		// Perform export
-		format := export.Format(a.exportCursor)
-		filename, err := a.exporter.Export(a.characters, format)
+		// Ask for a filename, suggesting a unique timestamped one
+		format := export.Formats[a.exportCursor]
+		a.exportNaming = true
+		a.exportInput.SetValue(a.exporter.DefaultFilename(format))
+		a.exportInput.CursorEnd()
+		a.exportInput.Focus()
+		return a, textinput.Blink
+	case "s":
+		// Cycle the target language for escaped exports
+		a.exporter.EscapeStyle = a.exporter.EscapeStyle.Next()
+	case "e":
+		// Cycle the target encoding for raw exports
+		a.exporter.Transcoding = a.exporter.Transcoding.Next()
+	case "d":
+		// Export the comparison of the split panes instead
+		a.exportDiff = a.split && !a.exportDiff
+	case "esc", "q":
+		a.showExport = false
+	default:
+		// Shortcut keys select and confirm a format directly
+		s := msg.String()
+		if idx := strings.Index(exportShortcuts, s); len(s) == 1 && idx >= 0 && idx < len(export.Formats) {
+			a.exportCursor = idx
+			return a.handleExportMenu(tea.KeyMsg{Type: tea.KeyEnter})
+		}
+	}
+	return a, nil
+}
+
+// handleExportFilename handles keyboard input for the export filename prompt.
+func (a *App) handleExportFilename(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		// Back to format selection
+		a.exportNaming = false
+		a.exportInput.Blur()
+		return a, nil
+	case tea.KeyEnter:
+		a.performExport(false)
+		return a, nil
+	}
+
+	var cmd tea.Cmd
+	a.exportInput, cmd = a.exportInput.Update(msg)
+	return a, cmd
+}
+
+// handleExportConfirm handles the overwrite confirmation prompt.
+func (a *App) handleExportConfirm(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "y", "Y":
+		a.performExport(true)
+	case "n", "N", "esc":
+		// Back to the filename prompt
+		a.exportConfirm = false
+	}
+	return a, nil
+}
+
+// performExport writes the export to the filename in the prompt. If the
+// file exists and overwrite is false, an overwrite confirmation is shown.
+func (a *App) performExport(overwrite bool) {
+	format := export.Formats[a.exportCursor]
+	path := strings.TrimSpace(a.exportInput.Value())
+	if path == "" {
+		path = a.exporter.DefaultFilename(format)
+	}
+
+	var filename string
+	var err error
+	start := time.Now()
+	if a.exportDiff && a.split {
+		sideA, sideB := a.diffSides()
+		filename, err = a.exporter.ExportDiffTo(path, sideA, sideB, format, overwrite)
+		a.debugf("export diff as %s to %s in %s: %v", format, path, time.Since(start), err)
+	} else {
+		a.exporter.Dump = a.dumpOptions()
+		filename, err = a.exporter.ExportTo(path, a.characters, format, overwrite)
+		a.debugf("export %d characters as %s to %s in %s: %v", len(a.characters), format, path, time.Since(start), err)
+	}
+	if errors.Is(err, export.ErrFileExists) {
+		a.exportConfirm = true
+		return
+	}
+
+	if err != nil {
+		a.statusMsg = i18n.Tf("Export failed: %v", err)
+	} else {
+		a.statusMsg = i18n.Tf("Exported to %s", filename)
+		if format == export.FormatRaw && !a.exportDiff {
+			a.reportLosses()
+		}
+	}
+	a.showExport = false
+	a.exportNaming = false
+	a.exportConfirm = false
+	a.exportInput.Blur()
+}
+
+// handleImportPrompt handles keyboard input for the import prompt.
+func (a *App) handleImportPrompt(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		a.showImport = false
+		a.importInput.Blur()
+		return a, nil
+
+	case tea.KeyEnter:
+		path := strings.TrimSpace(a.importInput.Value())
+		content, err := export.ImportFile(path)
 		if err != nil {
-			a.statusMsg = fmt.Sprintf("Export failed: %v", err)
+			a.statusMsg = i18n.Tf("Import failed: %v", err)
 		} else {
-			a.statusMsg = fmt.Sprintf("Exported to %s", filename)
+			a.LoadImport(path, content)
+			a.statusMsg = i18n.Tf("Imported %d chars from %s", len(a.characters), path)
 		}
-		a.showExport = false
-	case "esc", "q":
-		a.showExport = false
-	case "1":
-		a.exportCursor = 0
-		return a.handleExportMenu(tea.KeyMsg{Type: tea.KeyEnter})
-	case "2":
-		a.exportCursor = 1
-		return a.handleExportMenu(tea.KeyMsg{Type: tea.KeyEnter})
-	case "3":
-		a.exportCursor = 2
-		return a.handleExportMenu(tea.KeyMsg{Type: tea.KeyEnter})
+		a.showImport = false
+		a.importInput.Blur()
+		return a, nil
 	}
-	return a, nil
+
+	var cmd tea.Cmd
+	a.importInput, cmd = a.importInput.Update(msg)
+	return a, cmd
 }
 
 // handleSearchMode handles keyboard input for search mode.
@@ -400,15 +1334,18 @@ func (a *App) handleSearchMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case tea.KeyEsc:
 		// Cancel search
 		a.showSearch = false
+		a.searchQuery = ""
 		a.searchMatches = nil
 		a.input.Focus()
 		return a, nil
 
 	case tea.KeyEnter:
-		// Confirm search and jump to first match
+		// Confirm search and jump to first match; matches stay
+		// highlighted until the search is cleared
+		a.searchQuery = strings.TrimSpace(a.searchInput.Value())
 		if len(a.searchMatches) > 0 {
 			a.cursor = a.searchMatches[a.searchCursor]
-			a.statusMsg = fmt.Sprintf("Match %d/%d", a.searchCursor+1, len(a.searchMatches))
+			a.statusMsg = i18n.Tf("Match %d/%d", a.searchCursor+1, len(a.searchMatches))
 		}
 		a.showSearch = false
 		a.searchInput.Blur()
@@ -435,77 +1372,177 @@ func (a *App) handleSearchMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 // performSearch searches for characters matching the search query.
 func (a *App) performSearch() {
-	query := strings.ToLower(strings.TrimSpace(a.searchInput.Value()))
-	if query == "" {
+	raw := strings.TrimSpace(a.searchInput.Value())
+	if raw == "" {
 		a.searchMatches = nil
 		a.searchCursor = 0
 		return
 	}
 
+	matches := a.findMatches(raw)
+	a.searchMatches = matches
+	a.searchCursor = 0
+
+	// Jump to first match
+	if len(matches) > 0 {
+		a.cursor = matches[0]
+	}
+}
+
+// refreshSearch recomputes matches for the confirmed query after the
+// input or filter changed, without moving the cursor.
+func (a *App) refreshSearch() {
+	a.searchMatches = a.findMatches(a.searchQuery)
+	a.searchCursor = 0
+}
+
+// findMatches returns the indices of characters matching query.
+func (a *App) findMatches(query string) []int {
+	if query == "" {
+		return nil
+	}
+
 	var matches []int
+	match := searchMatcher(query)
 	for i, char := range a.characters {
+		if match(char) {
+			matches = append(matches, i)
+		}
+	}
+	return matches
+}
+
+// isSearchMatch reports whether the character at idx matches the search.
+func (a *App) isSearchMatch(idx int) bool {
+	i := sort.SearchInts(a.searchMatches, idx)
+	return i < len(a.searchMatches) && a.searchMatches[i] == idx
+}
+
+// jumpToMatch moves the cursor to the next or previous search match
+// relative to the cursor, wrapping around at either end.
+func (a *App) jumpToMatch(forward bool) {
+	n := len(a.searchMatches)
+	if n == 0 {
+		a.statusMsg = i18n.T("No search matches")
+		return
+	}
+
+	i := sort.SearchInts(a.searchMatches, a.cursor)
+	if forward {
+		if i < n && a.searchMatches[i] == a.cursor {
+			i++
+		}
+		i %= n
+	} else {
+		i = (i - 1 + n) % n
+	}
+
+	a.searchCursor = i
+	a.cursor = a.searchMatches[i]
+	a.statusMsg = i18n.Tf("Match %d/%d", i+1, n)
+}
+
+// cellStyle returns the style for the character at idx: the cursor first,
+// then search matches, then the character type.
+func (a *App) cellStyle(idx int, char analysis.Character) lipgloss.Style {
+	var style lipgloss.Style
+	switch {
+	case idx == a.cursor && !a.input.Focused():
+		style = a.styles.TableSelected
+	case a.isSearchMatch(idx):
+		style = a.styles.SearchMatch
+	case a.binary() && a.source.modified(char.ByteOffset):
+		style = a.styles.Modified
+	default:
+		style = a.styles.CharStyle(int(char.Type))
+	}
+	// Detected tokens and encoded runs are underlined
+	return style.Underline(a.inToken(char))
+}
+
+// searchMatcher returns a predicate for a search query. Queries prefixed
+// with "name:", "cat:", "script:", or "block:" match Unicode metadata;
+// anything else matches the character, hex, decimal, or U+ codepoint.
+func searchMatcher(raw string) func(analysis.Character) bool {
+	if field, value, ok := strings.Cut(raw, ":"); ok && value != "" {
+		switch strings.ToLower(field) {
+		case "name":
+			return func(c analysis.Character) bool {
+				return analysis.MatchesName(c.Rune, value)
+			}
+		case "cat", "category":
+			return func(c analysis.Character) bool {
+				return analysis.MatchesCategory(c.Rune, value)
+			}
+		case "script":
+			script, found := analysis.FindScript(value)
+			return func(c analysis.Character) bool {
+				return found && analysis.Script(c.Rune) == script
+			}
+		case "block":
+			block, found := analysis.FindBlock(value)
+			return func(c analysis.Character) bool {
+				return found && c.Rune >= block.Start && c.Rune <= block.End
+			}
+		}
+	}
+
+	query := strings.ToLower(raw)
+	hexQuery := strings.TrimPrefix(query, "0x")
+	unicodeQuery := strings.TrimPrefix(strings.ToUpper(query), "U+")
+
+	return func(char analysis.Character) bool {
 		// Match by character
 		if strings.ToLower(char.Char) == query {
-			matches = append(matches, i)
-			continue
+			return true
 		}
 
 		// Match by hex (with or without 0x prefix)
-		hexQuery := strings.TrimPrefix(query, "0x")
 		if strings.ToLower(char.Hex) == hexQuery {
-			matches = append(matches, i)
-			continue
+			return true
 		}
 
 		// Match by decimal
 		if fmt.Sprintf("%d", char.Dec) == query {
-			matches = append(matches, i)
-			continue
+			return true
 		}
 
 		// Match by unicode (with or without U+ prefix)
-		unicodeQuery := strings.TrimPrefix(strings.ToUpper(query), "U+")
-		if strings.TrimPrefix(char.Unicode, "U+") == unicodeQuery {
-			matches = append(matches, i)
-			continue
-		}
-	}
-
-	a.searchMatches = matches
-	a.searchCursor = 0
-
-	// Jump to first match
-	if len(matches) > 0 {
-		a.cursor = matches[0]
+		return strings.TrimPrefix(char.Unicode, "U+") == unicodeQuery
 	}
 }
 
 // View implements tea.Model.
 func (a *App) View() string {
+	defer a.catchPanic()
 	if !a.ready {
 		return "Initializing..."
 	}
 
+	// The help screen replaces everything else
+	if a.showHelp {
+		return a.styles.App.Render(a.renderHelp())
+	}
+
 	var b strings.Builder
 
 	// Header
 	b.WriteString(a.renderHeader())
+	if bar := a.renderTabBar(); bar != "" {
+		b.WriteString("\n")
+		b.WriteString(bar)
+	}
 	b.WriteString("\n\n")
 
-	// Input
-	b.WriteString(a.renderInput())
-	b.WriteString("\n\n")
+	if a.split {
+		// Side-by-side panes, each with its own input and content
+		b.WriteString(a.renderSplit())
+	} else {
+		// Input
+		b.WriteString(a.renderInput())
+		b.WriteString("\n\n")
 
-	// Content based on view mode
-	if len(a.characters) > 0 {
-		switch a.viewMode {
-		case ViewModeTable:
-			b.WriteString(a.renderTableView())
-		case ViewModeDetail:
-			b.WriteString(a.renderDetailView())
-		case ViewModeCompact:
-			b.WriteString(a.renderCompactView())
-		}
+		b.WriteString(a.renderContent())
 	}
 
 	// Status bar
@@ -524,10 +1561,146 @@ func (a *App) View() string {
 		b.WriteString(a.renderExportMenu())
 	}
 
-	// Help
-	if a.showHelp {
+	// Import prompt overlay
+	if a.showImport {
+		b.WriteString("\n\n")
+		b.WriteString(a.renderImportPrompt())
+	}
+
+	// Goto prompt overlay
+	if a.showGoto {
+		b.WriteString("\n\n")
+		b.WriteString(a.renderGotoPrompt())
+	}
+
+	// Script/block filter prompt overlay
+	if a.showScope {
+		b.WriteString("\n\n")
+		b.WriteString(a.renderScopePrompt())
+	}
+
+	// Search & replace overlays
+	if a.showReplace {
+		b.WriteString("\n\n")
+		b.WriteString(a.renderReplacePrompt())
+	}
+	if a.replacing != nil {
+		b.WriteString("\n\n")
+		b.WriteString(a.renderReplaceConfirm())
+	}
+
+	// In-place edit overlay
+	if a.editOp != editNone {
+		b.WriteString("\n\n")
+		b.WriteString(a.renderEditPrompt())
+	}
+
+	// Character picker overlay
+	if a.showPicker {
+		b.WriteString("\n\n")
+		b.WriteString(a.renderPicker())
+	}
+
+	// Block browser overlay
+	if a.showBrowser {
+		b.WriteString("\n\n")
+		b.WriteString(a.renderBrowser())
+	}
+
+	// Column editor overlay
+	if a.showColumns {
+		b.WriteString("\n\n")
+		b.WriteString(a.renderColumnEditor())
+	}
+
+	// Status bar menu overlay
+	if a.showStats {
+		b.WriteString("\n\n")
+		b.WriteString(a.renderStatsMenu())
+	}
+
+	// Encoding tutorial overlay
+	if a.showTutorial {
+		b.WriteString("\n\n")
+		b.WriteString(a.renderTutorial())
+	}
+
+	// Transform menu overlay
+	if a.showTransforms {
+		b.WriteString("\n\n")
+		b.WriteString(a.renderTransforms())
+	}
+
+	// Decoded tokens overlay
+	if a.showTokens {
+		b.WriteString("\n\n")
+		b.WriteString(a.renderTokens())
+	}
+
+	// File browser overlay
+	if a.showFiles {
+		b.WriteString("\n\n")
+		b.WriteString(a.renderFilePicker())
+	}
+
+	// Recent files overlay
+	if a.showRecent {
+		b.WriteString("\n\n")
+		b.WriteString(a.renderRecent())
+	}
+
+	// Codepage list overlay
+	if a.showCodepages {
+		b.WriteString("\n\n")
+		b.WriteString(a.renderCodepages())
+	}
+
+	// Transcoding loss report overlay
+	if a.showLosses {
 		b.WriteString("\n\n")
-		b.WriteString(a.help.View(a.keys))
+		b.WriteString(a.renderLosses())
+	}
+
+	// Compatibility audit overlay
+	if a.showAudit {
+		b.WriteString("\n\n")
+		b.WriteString(a.renderAudit())
+	}
+
+	// Bidirectional view overlay
+	if a.showBidi {
+		b.WriteString("\n\n")
+		b.WriteString(a.renderBidi())
+	}
+
+	// Line break preview overlay
+	if a.showLineBreaks {
+		b.WriteString("\n\n")
+		b.WriteString(a.renderLineBreaks())
+	}
+
+	// About screen overlay
+	if a.showAbout {
+		b.WriteString("\n\n")
+		b.WriteString(a.renderAbout())
+	}
+
+	// Session restore prompt overlay
+	if a.restoreOffer != nil {
+		b.WriteString("\n\n")
+		b.WriteString(a.renderRestore())
+	}
+
+	// Paste report overlay
+	if a.showPaste {
+		b.WriteString("\n\n")
+		b.WriteString(a.renderPaste())
+	}
+
+	// Key capture overlay
+	if a.showKeyCapture {
+		b.WriteString("\n\n")
+		b.WriteString(a.renderKeyCapture())
 	}
 
 	return a.styles.App.Render(b.String())
@@ -536,77 +1709,142 @@ func (a *App) View() string {
 // renderHeader renders the application header.
 func (a *App) renderHeader() string {
 	title := a.styles.Header.Render("StringInspect")
-	subtitle := a.styles.Muted.Render(" - Character Encoding Analyzer")
+	subtitle := a.styles.Muted.Render(" - " + i18n.T("Character Encoding Analyzer"))
 	return title + subtitle
 }
 
 // renderInput renders the text input field.
 func (a *App) renderInput() string {
-	return a.input.View()
+	if a.binary() {
+		return a.styles.Muted.Render(i18n.Tf("Binary file, %d bytes • E to decode as text", len(a.source.data)))
+	}
+	view := a.input.View()
+	if a.input.overLimit() {
+		view += "\n" + a.styles.Muted.Render(i18n.Tf("Input line shows %d of %d characters, read-only • x/r/i edit in navigation mode", a.input.CharLimit, len(a.all)))
+	}
+	if hint := a.renderTokenHint(); hint != "" {
+		return view + "\n" + hint
+	}
+	return view
 }
 
-// renderTableView renders the table view of character encodings.
-func (a *App) renderTableView() string {
-	var b strings.Builder
-
-	// Calculate visible characters based on width
-	maxChars := (a.width - 20) / 10
-	if maxChars < 1 {
-		maxChars = 1
+// renderContent renders the characters in the current view mode.
+func (a *App) renderContent() string {
+	if len(a.characters) == 0 && a.filter.active() && len(a.all) > 0 {
+		return a.styles.Muted.Render(i18n.Tf("No characters match filter %q (F to clear)", a.filter.String()))
 	}
-	if maxChars > len(a.characters) {
-		maxChars = len(a.characters)
+	if len(a.characters) == 0 {
+		return ""
 	}
 
-	// Determine scroll offset to keep cursor visible
-	start := 0
-	if a.cursor >= maxChars {
-		start = a.cursor - maxChars + 1
-	}
-	end := start + maxChars
-	if end > len(a.characters) {
-		end = len(a.characters)
-		start = end - maxChars
-		if start < 0 {
-			start = 0
+	return a.renderMinimap() + "\n\n" + a.renderView()
+}
+
+// renderView renders the characters in the current view mode.
+func (a *App) renderView() string {
+	switch a.viewMode {
+	case ViewModeTable:
+		if a.tableVertical {
+			return a.renderVerticalTable()
+		}
+		if a.tableWrap {
+			return a.renderWrappedTable()
 		}
+		return a.renderTableView()
+	case ViewModeDetail:
+		return a.renderDetailView()
+	case ViewModeCompact:
+		return a.renderCompactView()
+	case ViewModeBytes:
+		return a.renderBytesView()
+	case ViewModeBits:
+		return a.renderBitGrid()
+	case ViewModeUnique:
+		return a.renderUniqueView()
 	}
+	return ""
+}
 
-	visibleChars := a.characters[start:end]
+// tableChars returns how many characters fit across the table.
+func (a *App) tableChars() int {
+	return max((a.width-20)/a.tableCellWidth(), 1)
+}
 
-	// Render rows
-	rows := []struct {
-		label string
-		fn    func(c analysis.Character) string
-	}{
-		{"Char", func(c analysis.Character) string { return c.Char }},
-		{"Hex", func(c analysis.Character) string { return c.Hex }},
-		{"Dec", func(c analysis.Character) string { return fmt.Sprintf("%d", c.Dec) }},
-		{"Bin", func(c analysis.Character) string { return c.Bin }},
-		{"Oct", func(c analysis.Character) string { return c.Oct }},
-		{"Unicode", func(c analysis.Character) string { return c.Unicode }},
+// tableWindow returns the range of characters visible in the horizontal
+// table. The window scrolls only as far as needed to follow the cursor,
+// and can also be scrolled on its own, leaving the cursor offscreen.
+func (a *App) tableWindow() (start, end int) {
+	maxChars := min(a.tableChars(), len(a.characters))
+
+	// Bring the cursor into view when it has moved since the last call,
+	// keeping the scroll margin on either side of it
+	if a.cursor != a.tableCursor {
+		a.tableCursor = a.cursor
+		margin := a.scrollMargin(maxChars)
+		switch {
+		case a.config.CenterCursor:
+			a.tableStart = a.cursor - maxChars/2
+		case a.cursor < a.tableStart+margin:
+			a.tableStart = a.cursor - margin
+		case a.cursor >= a.tableStart+maxChars-margin:
+			a.tableStart = a.cursor - maxChars + 1 + margin
+		}
 	}
+	a.tableStart = max(0, min(a.tableStart, len(a.characters)-maxChars))
+
+	return a.tableStart, a.tableStart + maxChars
+}
+
+// scrollTable moves the horizontal table window without moving the cursor.
+func (a *App) scrollTable(delta int) {
+	a.tableWindow() // Settle the window before scrolling it
+	a.tableStart += delta
+	a.tableWindow()
+}
 
-	for _, row := range rows {
-		label := a.styles.TableLabel.Render(row.label)
+// renderTableRows renders a row per column for the characters from start
+// to end.
+func (a *App) renderTableRows(start, end int) string {
+	var b strings.Builder
+	width := a.tableCellWidth()
+	for _, col := range a.activeColumns() {
+		label := a.styles.TableLabel.Render(i18n.T(col.label))
 		b.WriteString(label)
 
-		for i, char := range visibleChars {
+		for i, char := range a.characters[start:end] {
 			globalIdx := start + i
-			value := row.fn(char)
-
-			var style lipgloss.Style
-			if globalIdx == a.cursor && !a.input.Focused() {
-				style = a.styles.TableSelected
-			} else {
-				style = a.styles.CharStyle(int(char.Type))
-			}
+			value := truncateWidth(col.fn(char), width-2)
 
-			cell := style.Width(10).Align(lipgloss.Center).Render(value)
+			style := a.cellStyle(globalIdx, char)
+			cell := style.Width(width).Align(lipgloss.Center).Render(value)
 			b.WriteString(cell)
 		}
 		b.WriteString("\n")
 	}
+	return b.String()
+}
+
+// renderTableView renders the table view of character encodings.
+func (a *App) renderTableView() string {
+	var b strings.Builder
+
+	start, end := a.tableWindow()
+	b.WriteString(a.renderTableRows(start, end))
+
+	// Scroll indicators for characters offscreen on either side
+	if start > 0 || end < len(a.characters) {
+		left, right := "", ""
+		if start > 0 {
+			left = i18n.Tf("« %d more", start)
+		}
+		if end < len(a.characters) {
+			right = i18n.Tf("%d more »", len(a.characters)-end)
+		}
+		width := 8 + a.tableCellWidth()*(end-start) // Label plus cells
+		gap := max(width-lipgloss.Width(left)-lipgloss.Width(right), 1)
+		b.WriteString(a.styles.Muted.Render(left + strings.Repeat(" ", gap) + right))
+		b.WriteString("\n")
+	}
 
 	return b.String()
 }
@@ -620,15 +1858,19 @@ func (a *App) renderDetailView() string {
 	char := a.characters[a.cursor]
 	var b strings.Builder
 
-	title := a.styles.Title.Render("Character Details")
+	title := a.styles.Title.Render(i18n.T("Character Details"))
 	b.WriteString(title)
 	b.WriteString("\n\n")
 
-	// Character display
+	// Character display; search matches get a match-colored border
+	border := ColorPrimary
+	if a.isSearchMatch(a.cursor) {
+		border = ColorMatch
+	}
 	charStyle := a.styles.CharStyle(int(char.Type))
 	charDisplay := charStyle.Bold(true).Padding(1, 3).
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(ColorPrimary).
+		BorderForeground(border).
 		Render(char.Char)
 	b.WriteString(charDisplay)
 	b.WriteString("\n\n")
@@ -644,18 +1886,44 @@ func (a *App) renderDetailView() string {
 		{"Octal", "0o" + char.Oct},
 		{"Binary", char.Bin},
 		{"UTF-8 Bytes", char.UTF8Hex},
-		{"Position", fmt.Sprintf("%d (byte: %d)", char.RuneOffset, char.ByteOffset)},
+		{"Position", i18n.Tf("%d (byte: %d)", char.RuneOffset, char.ByteOffset)},
+	}
+	if a.codepage != nil {
+		details = append(details, struct{ label, value string }{
+			i18n.Tf("In %s", a.codepage.Name), a.codepage.Decode(char.UTF8Bytes),
+		})
+	}
+	if a.isSearchMatch(a.cursor) {
+		i := sort.SearchInts(a.searchMatches, a.cursor)
+		details = append(details, struct{ label, value string }{
+			"Search", i18n.Tf("match %d/%d", i+1, len(a.searchMatches)),
+		})
 	}
 
 	for _, d := range details {
-		label := a.styles.Muted.Width(14).Render(d.label + ":")
+		label := a.styles.Muted.Width(14).Render(i18n.T(d.label) + ":")
 		value := a.styles.Printable.Render(d.value)
 		b.WriteString(label + " " + value + "\n")
 	}
 
+	// Regexp classes the character is in
+	b.WriteString("\n")
+	b.WriteString(a.renderRegexClasses(char))
+
+	// Plain-English explanation
+	b.WriteString("\n")
+	b.WriteString(a.styles.Subtitle.Render(i18n.T("About")))
+	b.WriteString("\n")
+	b.WriteString(a.styles.Printable.Width(max(min(a.width-4, 100), 30)).Render(analysis.Explain(char.Rune)))
+	b.WriteString("\n")
+
+	// Bit-level breakdown of the UTF-8 encoding
+	b.WriteString("\n")
+	b.WriteString(a.renderUTF8Bits(char))
+
 	// Navigation hint
 	b.WriteString("\n")
-	hint := a.styles.Muted.Render(fmt.Sprintf("← → to navigate (%d/%d)", a.cursor+1, len(a.characters)))
+	hint := a.styles.Muted.Render(i18n.Tf("← → to navigate (%d/%d)", a.cursor+1, len(a.characters)))
 	b.WriteString(hint)
 
 	return b.String()
@@ -665,58 +1933,36 @@ func (a *App) renderDetailView() string {
 func (a *App) renderCompactView() string {
 	var b strings.Builder
 
-	title := a.styles.Title.Render("Compact View (Hex Dump)")
-	b.WriteString(title)
-	b.WriteString("\n\n")
-
-	// Show offset | hex values | ascii
-	charsPerLine := 16
-	for i := 0; i < len(a.characters); i += charsPerLine {
-		// Offset
-		offset := a.styles.Muted.Render(fmt.Sprintf("%04X  ", i))
-		b.WriteString(offset)
-
-		// Hex values
-		for j := 0; j < charsPerLine; j++ {
-			idx := i + j
-			if idx < len(a.characters) {
-				char := a.characters[idx]
-				style := a.styles.CharStyle(int(char.Type))
-				if idx == a.cursor && !a.input.Focused() {
-					style = a.styles.TableSelected
-				}
-				hex := style.Render(char.Hex)
-				b.WriteString(hex + " ")
-			} else {
-				b.WriteString("   ")
-			}
-
-			// Extra space in middle
-			if j == 7 {
-				b.WriteString(" ")
-			}
+	parts := []string{i18n.T("Hex Dump")}
+	if a.dumpGroup > 1 {
+		parts = append(parts, i18n.Tf("%d-byte groups", a.dumpGroup), i18n.T(a.byteOrderName()))
+	}
+	if a.codepage != nil {
+		parts = append(parts, a.codepage.Name)
+	}
+	b.WriteString(a.styles.Title.Render(i18n.Tf("Compact View (%s)", strings.Join(parts, ", "))))
+	if a.hexEdit {
+		digit := "__"
+		if a.hexNibble >= 0 {
+			digit = fmt.Sprintf("%X_", a.hexNibble)
 		}
+		b.WriteString(a.styles.Modified.Render("  " + i18n.Tf("editing byte %04X: %s", a.characters[a.cursor].ByteOffset, digit)))
+	}
+	b.WriteString("\n\n")
 
-		b.WriteString(" │ ")
-
-		// ASCII representation
-		for j := 0; j < charsPerLine; j++ {
-			idx := i + j
-			if idx < len(a.characters) {
-				char := a.characters[idx]
-				style := a.styles.CharStyle(int(char.Type))
-				if idx == a.cursor && !a.input.Focused() {
-					style = a.styles.TableSelected
-				}
-
-				display := char.Char
-				if len(display) > 1 {
-					display = "."
-				}
-				b.WriteString(style.Render(display))
-			}
-		}
+	if a.dumpGroup > 1 || a.codepage != nil {
+		b.WriteString(a.renderByteDump())
+		return b.String()
+	}
 
+	// Show offset | hex values | ascii, in a window of lines around the
+	// cursor
+	layout := a.compactLayout()
+	charsPerLine := layout.PerLine
+	lines := max(4, a.height-20)
+	top := a.viewTop(a.cursor/charsPerLine, lines, (len(a.characters)-1)/charsPerLine) * charsPerLine
+	for i := top; i < len(a.characters) && i < top+lines*charsPerLine; i += charsPerLine {
+		b.WriteString(export.DumpLine(a.characters, i, layout, a.dumpPainter))
 		b.WriteString("\n")
 	}
 
@@ -726,13 +1972,30 @@ func (a *App) renderCompactView() string {
 // renderStatusBar renders the status bar.
 func (a *App) renderStatusBar() string {
 	// Mode indicator
-	mode := a.viewMode.String()
+	mode := i18n.T(a.viewMode.String())
 	if a.input.Focused() {
-		mode = "Input"
+		mode = i18n.T("Input")
+	}
+	if a.split {
+		mode += " • " + i18n.Tf("Pane %s", a.paneLabel())
+	}
+	if a.filter.active() {
+		mode += " • " + i18n.Tf("Filter: %s", a.filter.String())
+	}
+	if a.searchQuery != "" {
+		mode += " • " + i18n.Tf("%d matches", len(a.searchMatches))
+	}
+	if a.macroReg != 0 {
+		mode += " • " + i18n.Tf("recording @%c", a.macroReg)
+	}
+	if a.binary() {
+		mode += " • " + i18n.T("bytes")
+	} else if a.source != nil && (a.source.encoding != analysis.EncodingUTF8 || a.source.detection.BOM) {
+		mode += " • " + a.source.encoding.String()
+		if a.source.detection.BOM && a.source.encoding == a.source.detection.Encoding {
+			mode += " BOM"
+		}
 	}
-
-	// Character count
-	charCount := fmt.Sprintf("%d chars", len(a.characters))
 
 	// Build status
 	left := a.styles.Muted.Render(fmt.Sprintf("[%s]", mode))
@@ -742,7 +2005,7 @@ func (a *App) renderStatusBar() string {
 	if a.statusMsg != "" {
 		right = a.styles.Success.Render(a.statusMsg)
 	} else {
-		right = a.styles.Muted.Render(charCount + " │ F1 help │ q quit")
+		right = a.renderStats() + a.styles.Muted.Render(" │ "+i18n.T("F1 help")+" │ "+i18n.T("q quit"))
 	}
 
 	gap := a.width - lipgloss.Width(left) - lipgloss.Width(right) - 4
@@ -757,23 +2020,41 @@ func (a *App) renderStatusBar() string {
 func (a *App) renderExportMenu() string {
 	var b strings.Builder
 
+	// Filename prompt replaces the format list once a format is chosen
+	if a.exportNaming {
+		format := export.Formats[a.exportCursor]
+		title := i18n.Tf("Export %s", format)
+		if a.exportDiff && a.split {
+			title = i18n.Tf("Export Diff as %s", format)
+		}
+		b.WriteString(a.styles.Title.Render(title))
+		b.WriteString("\n\n")
+		b.WriteString(a.exportInput.View())
+		b.WriteString("\n\n")
+
+		if a.exportConfirm {
+			b.WriteString(a.styles.Error.Render(i18n.T("File exists. Overwrite? (y/n)")))
+		} else {
+			b.WriteString(a.styles.Muted.Render(i18n.T("enter save • esc back")))
+		}
+
+		return lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(ColorPrimary).
+			Padding(1, 2).
+			Render(b.String())
+	}
+
 	// Menu box
-	title := a.styles.Title.Render("Export Format")
+	title := a.styles.Title.Render(i18n.T("Export Format"))
 	b.WriteString(title)
 	b.WriteString("\n\n")
-
-	formats := []struct {
-		key    string
-		name   string
-		desc   string
-		format export.Format
-	}{
-		{"1", "Text", "Plain text table", export.FormatText},
-		{"2", "JSON", "Structured JSON", export.FormatJSON},
-		{"3", "CSV", "Comma-separated values", export.FormatCSV},
+	if a.exportDiff && a.split {
+		b.WriteString(a.styles.Subtitle.Render(i18n.T("Differences between panes A and B, as Text or JSON")))
+		b.WriteString("\n\n")
 	}
 
-	for i, f := range formats {
+	for i, f := range export.Formats {
 		prefix := "  "
 		style := a.styles.Muted
 		if i == a.exportCursor {
@@ -781,13 +2062,33 @@ func (a *App) renderExportMenu() string {
 			style = a.styles.Highlighted
 		}
 
-		line := fmt.Sprintf("%s[%s] %s - %s", prefix, f.key, f.name, f.desc)
+		desc := i18n.T(f.Description())
+		if f == export.FormatEscaped {
+			desc += fmt.Sprintf(" (%s)", a.exporter.EscapeStyle)
+		}
+		if f == export.FormatRaw {
+			desc += fmt.Sprintf(" (%s)", a.exporter.Transcoding)
+		}
+		if f == export.FormatTemplate && a.exporter.TemplatePath != "" {
+			desc += fmt.Sprintf(" (%s)", filepath.Base(a.exporter.TemplatePath))
+		}
+
+		shortcut := " "
+		if i < len(exportShortcuts) {
+			shortcut = exportShortcuts[i : i+1]
+		}
+
+		line := fmt.Sprintf("%s[%s] %s - %s", prefix, shortcut, f, desc)
 		b.WriteString(style.Render(line))
 		b.WriteString("\n")
 	}
 
 	b.WriteString("\n")
-	hint := a.styles.Muted.Render("↑/↓ select • enter confirm • esc cancel")
+	hint := i18n.T("↑/↓ select • enter confirm • s escape style • e raw encoding • esc cancel")
+	if a.split {
+		hint = i18n.T("↑/↓ select • enter confirm • s escape style • e raw encoding • d diff of panes • esc cancel")
+	}
+	hint = a.styles.Muted.Render(hint)
 	b.WriteString(hint)
 
 	return lipgloss.NewStyle().
@@ -797,11 +2098,28 @@ func (a *App) renderExportMenu() string {
 		Render(b.String())
 }
 
+// renderImportPrompt renders the JSON import prompt.
+func (a *App) renderImportPrompt() string {
+	var b strings.Builder
+
+	b.WriteString(a.styles.Title.Render(i18n.T("Import JSON Export")))
+	b.WriteString("\n\n")
+	b.WriteString(a.importInput.View())
+	b.WriteString("\n\n")
+	b.WriteString(a.styles.Muted.Render(i18n.T("enter import • esc cancel")))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorPrimary).
+		Padding(1, 2).
+		Render(b.String())
+}
+
 // renderSearchBar renders the search input bar.
 func (a *App) renderSearchBar() string {
 	var b strings.Builder
 
-	title := a.styles.Title.Render("Search")
+	title := a.styles.Title.Render(i18n.T("Search"))
 	b.WriteString(title)
 	b.WriteString("\n\n")
 
@@ -811,16 +2129,18 @@ func (a *App) renderSearchBar() string {
 
 	// Match count
 	if len(a.searchMatches) > 0 {
-		matchInfo := fmt.Sprintf("Found %d match(es) - Tab to cycle, Enter to confirm", len(a.searchMatches))
+		matchInfo := i18n.Tf("Found %d match(es) - Tab to cycle, Enter to confirm", len(a.searchMatches))
 		b.WriteString(a.styles.Success.Render(matchInfo))
 	} else if a.searchInput.Value() != "" {
-		b.WriteString(a.styles.Error.Render("No matches"))
+		b.WriteString(a.styles.Error.Render(i18n.T("No matches")))
 	} else {
-		b.WriteString(a.styles.Muted.Render("Type hex (0x41), decimal (65), or character (A)"))
+		b.WriteString(a.styles.Muted.Render(i18n.T("Type hex (0x41), decimal (65), or character (A)")))
+		b.WriteString("\n")
+		b.WriteString(a.styles.Muted.Render(i18n.T("or name:EM DASH, cat:Cf, script:Arabic, block:Arrows")))
 	}
 
 	b.WriteString("\n\n")
-	hint := a.styles.Muted.Render("enter confirm • esc cancel • tab next match")
+	hint := a.styles.Muted.Render(i18n.T("enter confirm • esc cancel • tab next match"))
 	b.WriteString(hint)
 
 	return lipgloss.NewStyle().
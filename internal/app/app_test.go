@@ -0,0 +1,58 @@
+package app
+
+import (
+	"testing"
+
+	"stringinspect/internal/export"
+	"stringinspect/internal/i18n"
+	"stringinspect/internal/transform"
+)
+
+func TestExportShortcuts(t *testing.T) {
+	if len(exportShortcuts) < len(export.Formats) {
+		t.Fatalf("%d export shortcuts for %d formats", len(exportShortcuts), len(export.Formats))
+	}
+	for i, f := range export.Formats {
+		a := navigating("abc")
+		a.showExport = true
+		press(a, exportShortcuts[i:i+1])
+		if !a.exportNaming || a.exportCursor != i {
+			t.Errorf("shortcut %q chose format %d (naming %v), want %s", exportShortcuts[i], a.exportCursor, a.exportNaming, f)
+		}
+	}
+}
+
+func TestLabelsTranslated(t *testing.T) {
+	if err := i18n.SetLocale("de"); err != nil {
+		t.Fatal(err)
+	}
+	defer i18n.SetLocale("en")
+
+	var labels []string
+	for _, g := range DefaultKeyMap().HelpGroups() {
+		labels = append(labels, g.Title)
+		for _, k := range g.Keys {
+			labels = append(labels, k.Help().Desc)
+		}
+	}
+	for _, c := range columns {
+		labels = append(labels, c.label)
+	}
+	for m := FilterAll; m <= FilterFlagged; m++ {
+		labels = append(labels, m.String())
+	}
+	for _, tr := range transform.List(transform.Options{}) {
+		labels = append(labels, tr.Name)
+	}
+	for _, f := range statFields {
+		labels = append(labels, f)
+	}
+	for _, desc := range bidiClassDescriptions {
+		labels = append(labels, desc)
+	}
+	for _, l := range labels {
+		if !i18n.Translated(l) {
+			t.Errorf("%q has no German translation", l)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"stringinspect/internal/analysis"
+	"stringinspect/internal/export"
+	"stringinspect/internal/i18n"
+	"stringinspect/internal/transform"
+)
+
+// visibleText returns the text of the visible characters.
+func (a *App) visibleText() string {
+	var b strings.Builder
+	for _, c := range a.characters {
+		b.WriteRune(c.Rune)
+	}
+	return b.String()
+}
+
+// terminalTarget names the audit target that checks terminal rendering,
+// which follows the charsets.
+const terminalTarget = "Terminal"
+
+// openAudit shows the compatibility audit for the current target.
+func (a *App) openAudit() {
+	if len(a.characters) == 0 {
+		a.statusMsg = i18n.T("Nothing to check")
+		return
+	}
+	a.auditCursor = 0
+	a.showAudit = true
+}
+
+// auditTerminal reports whether the audit checks terminal rendering
+// rather than a charset.
+func (a *App) auditTerminal() bool {
+	return a.auditTarget == len(analysis.Charsets)
+}
+
+// auditFailures returns the indices of the characters that fail the
+// audit, with the reason for each when checking terminal rendering.
+func (a *App) auditFailures() ([]int, []string) {
+	if !a.auditTerminal() {
+		return analysis.Charsets[a.auditTarget].Incompatible(a.characters), nil
+	}
+	risks := analysis.TerminalRisks(a.characters)
+	failures, reasons := make([]int, len(risks)), make([]string, len(risks))
+	for i, r := range risks {
+		failures[i], reasons[i] = r.Index, r.Reason
+	}
+	return failures, reasons
+}
+
+// handleAudit handles keyboard input for the compatibility audit.
+func (a *App) handleAudit(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	failures, _ := a.auditFailures()
+	targets := len(analysis.Charsets) + 1
+	switch msg.String() {
+	case "esc", "q", "A":
+		a.showAudit = false
+	case "tab", "right", "l":
+		a.auditTarget = (a.auditTarget + 1) % targets
+		a.auditCursor = 0
+	case "shift+tab", "left", "h":
+		a.auditTarget = (a.auditTarget + targets - 1) % targets
+		a.auditCursor = 0
+	case "up", "k":
+		a.auditCursor = max(a.auditCursor-1, 0)
+	case "down", "j":
+		a.auditCursor = max(min(a.auditCursor+1, len(failures)-1), 0)
+	case "f":
+		if !a.auditTerminal() {
+			a.fixAudit()
+		}
+	case "enter":
+		if len(failures) > 0 {
+			a.cursor = failures[a.auditCursor]
+			a.input.Blur()
+			a.showAudit = false
+		}
+	}
+	return a, nil
+}
+
+// fixAudit replaces every character of the input the audited charset
+// cannot represent with its suggested stand-in, as one undoable edit.
+func (a *App) fixAudit() {
+	if a.binaryLocked() {
+		return
+	}
+	cs := analysis.Charsets[a.auditTarget]
+	a.applyTransform(transform.Transform{
+		Name: i18n.Tf("Fix for %s", cs.Name),
+		Apply: func(s string) transform.Result {
+			out, n := cs.Fix(s)
+			return transform.Result{Output: out, Report: []string{i18n.Tf("%d characters replaced", n)}}
+		},
+	})
+	a.auditCursor = 0
+}
+
+// renderAudit renders the compatibility audit: whether the text survives
+// in the target charset, and every character that does not with the
+// stand-in suggested for it. The terminal target lists the characters
+// terminals may draw wrong instead, and why.
+func (a *App) renderAudit() string {
+	var b strings.Builder
+
+	b.WriteString(a.styles.Title.Render(i18n.T("Compatibility Check")))
+	b.WriteString("\n\n")
+
+	// Target tabs
+	names := make([]string, 0, len(analysis.Charsets)+1)
+	for _, c := range analysis.Charsets {
+		names = append(names, c.Name)
+	}
+	names = append(names, terminalTarget)
+	tabs := make([]string, len(names))
+	for i, name := range names {
+		if i == a.auditTarget {
+			tabs[i] = a.styles.Highlighted.Padding(0).Render(" " + name + " ")
+		} else {
+			tabs[i] = a.styles.Muted.Render(" " + name + " ")
+		}
+	}
+	b.WriteString(strings.Join(tabs, a.styles.Muted.Render("│")))
+	b.WriteString("\n\n")
+
+	failures, reasons := a.auditFailures()
+	switch {
+	case a.auditTerminal() && len(failures) == 0:
+		b.WriteString(a.styles.Success.Render(i18n.Tf("✓ All %d characters render alike in any terminal", len(a.characters))))
+	case a.auditTerminal():
+		b.WriteString(a.styles.Error.Render(i18n.Tf("✗ %d of %d characters may render wrong in some terminals", len(failures), len(a.characters))))
+	case len(failures) == 0:
+		b.WriteString(a.styles.Success.Render(i18n.Tf("✓ All %d characters survive in %s", len(a.characters), names[a.auditTarget])))
+	default:
+		b.WriteString(a.styles.Error.Render(i18n.Tf("✗ %d of %d characters do not survive in %s", len(failures), len(a.characters), names[a.auditTarget])))
+	}
+	b.WriteString("\n")
+	if names[a.auditTarget] == "GSM 03.38" {
+		b.WriteString(a.styles.Muted.Render("SMS " + analysis.SMSLengthOf(a.visibleText()).String()))
+		b.WriteString("\n")
+	}
+
+	fixable := 0
+	if len(failures) > 0 {
+		b.WriteString("\n")
+		runes := []rune(a.visibleText())
+		rows := max(a.height-20, 5)
+		top := scrollTop(a.auditCursor, rows, len(failures)-1)
+		for i := top; i < min(top+rows, len(failures)); i++ {
+			c := a.characters[failures[i]]
+			detail := analysis.Name(c.Rune)
+			if reasons != nil {
+				detail = reasons[i]
+			}
+			detailWidth := max(a.width-44, 12)
+			line := fmt.Sprintf("%6d  %s  %-8s %s", c.RuneOffset, padCell(export.DumpGlyph(c), 2), c.Unicode,
+				padCell(truncateWidth(detail, detailWidth), detailWidth))
+			if i == a.auditCursor {
+				b.WriteString(a.styles.Highlighted.Padding(0).Render(line))
+			} else {
+				b.WriteString(a.styles.Printable.Render(line))
+			}
+			if reasons == nil {
+				if sub, ok := analysis.Charsets[a.auditTarget].Suggest(runes, failures[i]); ok {
+					b.WriteString("  → " + a.styles.Success.Render(printableText(sub)))
+				}
+			}
+			b.WriteString("\n")
+		}
+		if reasons == nil {
+			for _, i := range failures {
+				if _, ok := analysis.Charsets[a.auditTarget].Suggest(runes, i); ok {
+					fixable++
+				}
+			}
+		}
+		if len(failures) > rows {
+			b.WriteString(a.styles.Muted.Render(fmt.Sprintf("%d/%d", a.auditCursor+1, len(failures))) + "\n")
+		}
+	}
+
+	b.WriteString("\n")
+	keys := i18n.T("tab/←/→ target • ↑/↓ select • enter jump to character")
+	if fixable > 0 {
+		keys += i18n.Tf(" • f apply %d suggestions", fixable)
+	}
+	b.WriteString(a.styles.Muted.Render(keys + i18n.T(" • esc close")))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorPrimary).
+		Padding(1, 2).
+		Render(b.String())
+}
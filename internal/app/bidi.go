@@ -0,0 +1,172 @@
+package app
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"stringinspect/internal/analysis"
+	"stringinspect/internal/export"
+	"stringinspect/internal/i18n"
+)
+
+// bidiClassDescriptions explain the Bidi_Class values.
+var bidiClassDescriptions = map[string]string{
+	"L":   "left-to-right letter",
+	"R":   "right-to-left letter",
+	"AL":  "Arabic letter",
+	"EN":  "European digit",
+	"ES":  "European number separator",
+	"ET":  "European number terminator",
+	"AN":  "Arabic digit",
+	"CS":  "number separator",
+	"NSM": "nonspacing mark, takes the direction before it",
+	"BN":  "ignored by the algorithm",
+	"B":   "paragraph separator",
+	"S":   "segment separator",
+	"WS":  "whitespace",
+	"ON":  "neutral, takes the direction around it",
+	"LRE": "opens a left-to-right embedding",
+	"RLE": "opens a right-to-left embedding",
+	"LRO": "forces left to right",
+	"RLO": "forces right to left",
+	"PDF": "closes an embedding or override",
+	"LRI": "opens a left-to-right isolate",
+	"RLI": "opens a right-to-left isolate",
+	"FSI": "opens an isolate in the direction of its first letter",
+	"PDI": "closes an isolate",
+}
+
+// openBidi shows the bidirectional levels of the visible text, with the
+// character at the cursor selected.
+func (a *App) openBidi() {
+	if len(a.characters) == 0 {
+		a.statusMsg = i18n.T("Nothing to reorder")
+		return
+	}
+	a.bidiParas = analysis.ResolveBidi(a.visibleText())
+	a.bidiCursor = min(a.cursor, len(a.characters)-1)
+	a.showBidi = true
+}
+
+// bidiParagraph returns the paragraph holding the selected character.
+func (a *App) bidiParagraph() analysis.BidiParagraph {
+	return a.bidiParas[a.bidiParaIndex()]
+}
+
+// handleBidi handles keyboard input for the bidirectional view.
+func (a *App) handleBidi(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	p := a.bidiParagraph()
+	switch msg.String() {
+	case "esc", "q", "O":
+		a.showBidi = false
+	case "left", "h":
+		a.bidiCursor = max(a.bidiCursor-1, 0)
+	case "right", "l":
+		a.bidiCursor = min(a.bidiCursor+1, len(a.characters)-1)
+	case "up", "k":
+		// Start of the paragraph, then the previous one
+		if a.bidiCursor > p.Start {
+			a.bidiCursor = p.Start
+		} else if p.Start > 0 {
+			a.bidiCursor = p.Start - 1
+			a.bidiCursor = a.bidiParagraph().Start
+		}
+	case "down", "j":
+		a.bidiCursor = min(p.Start+len(p.Levels), len(a.characters)-1)
+	case "enter":
+		a.cursor = a.bidiCursor
+		a.input.Blur()
+		a.showBidi = false
+	}
+	return a, nil
+}
+
+// renderBidi renders the bidirectional view: a window of the selected
+// paragraph in logical order with each character's embedding level, the
+// same characters in the order they are displayed, and what decided the
+// level of the selected one.
+func (a *App) renderBidi() string {
+	var b strings.Builder
+
+	p := a.bidiParagraph()
+	direction := i18n.T("left to right")
+	if p.RTL {
+		direction = i18n.T("right to left")
+	}
+	b.WriteString(a.styles.Title.Render(i18n.T("Bidirectional Text")))
+	b.WriteString("\n")
+	b.WriteString(a.styles.Muted.Render(i18n.Tf("Paragraph %d of %d • base direction %s",
+		a.bidiParaIndex()+1, len(a.bidiParas), direction)))
+	b.WriteString("\n\n")
+
+	// Reordering is per line, so the window is reordered as one
+	cells := max((a.width-20)/3, 8)
+	selected := a.bidiCursor - p.Start
+	start := min(max(selected-cells/2, 0), max(len(p.Levels)-cells, 0))
+	end := min(start+cells, len(p.Levels))
+	levels := p.Levels[start:end]
+
+	cell := func(i int) string {
+		g := padCell(export.DumpGlyph(a.characters[p.Start+i]), 2)
+		switch {
+		case i == selected:
+			return a.styles.Highlighted.Padding(0).Render(g) + " "
+		case p.Levels[i]%2 == 1:
+			return a.styles.Extended.Render(g) + " "
+		}
+		return a.styles.Printable.Render(g) + " "
+	}
+
+	b.WriteString(a.styles.TableLabel.Render(padCell(i18n.T("Logical"), 9)))
+	for i := start; i < end; i++ {
+		b.WriteString(cell(i))
+	}
+	b.WriteString("\n")
+	b.WriteString(a.styles.TableLabel.Render(padCell(i18n.T("Level"), 9)))
+	for _, l := range levels {
+		b.WriteString(a.styles.Muted.Render(padCell(strconv.Itoa(l), 3)))
+	}
+	b.WriteString("\n")
+	b.WriteString(a.styles.TableLabel.Render(padCell(i18n.T("Visual"), 9)))
+	for _, i := range analysis.VisualOrder(levels) {
+		b.WriteString(cell(start + i))
+	}
+	b.WriteString("\n\n")
+
+	c := a.characters[a.bidiCursor]
+	class := p.Classes[selected]
+	level := p.Levels[selected]
+	runs := i18n.T("left to right")
+	if level%2 == 1 {
+		runs = i18n.T("right to left")
+	}
+	b.WriteString(fmt.Sprintf("%s %s\n", c.Unicode, analysis.Name(c.Rune)))
+	b.WriteString(i18n.Tf("Class %s, %s", class, i18n.T(bidiClassDescriptions[class])) + "\n")
+	b.WriteString(i18n.Tf("Level %d, displayed %s", level, runs) + "\n")
+
+	b.WriteString("\n")
+	b.WriteString(a.styles.Muted.Render(i18n.T("Odd levels run right to left; each level reverses the runs at or above it")))
+	b.WriteString("\n")
+	b.WriteString(a.styles.Muted.Render(i18n.T("←/→ select • ↑/↓ paragraph • enter jump to character • esc close")))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorPrimary).
+		Padding(1, 2).
+		Render(b.String())
+}
+
+// bidiParaIndex returns the index of the paragraph holding the selected
+// character.
+func (a *App) bidiParaIndex() int {
+	for i, p := range a.bidiParas {
+		if a.bidiCursor < p.Start+len(p.Levels) {
+			return i
+		}
+	}
+	return max(len(a.bidiParas)-1, 0)
+}
@@ -0,0 +1,116 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"stringinspect/internal/i18n"
+)
+
+// handleBitsView handles keys specific to the bit-grid view. It returns
+// false for keys that should fall through to the normal bindings.
+func (a *App) handleBitsView(msg tea.KeyMsg) bool {
+	if key.Matches(msg, a.keys.BytePane) {
+		a.bitsPerByte = !a.bitsPerByte
+		return true
+	}
+
+	if len(a.characters) == 0 {
+		return false
+	}
+
+	up := msg.Type == tea.KeyUp || msg.String() == "k"
+	down := msg.Type == tea.KeyDown || msg.String() == "j"
+	if !up && !down {
+		return false
+	}
+
+	if !a.bitsPerByte {
+		if up {
+			a.cursor = max(a.cursor-1, 0)
+		} else {
+			a.cursor = min(a.cursor+1, len(a.characters)-1)
+		}
+		return true
+	}
+
+	refs := a.flatBytes()
+	a.syncByteCursor(refs)
+	if up {
+		a.byteCursor = max(a.byteCursor-1, 0)
+	} else {
+		a.byteCursor = min(a.byteCursor+1, len(refs)-1)
+	}
+	a.cursor = refs[a.byteCursor].char
+	return true
+}
+
+// renderBitGrid renders the input as a binary matrix, one row per rune
+// (24-bit codepoint) or per UTF-8 byte, with nibble separators.
+func (a *App) renderBitGrid() string {
+	var b strings.Builder
+	rows := max(4, a.height-20)
+
+	title := "Bit Grid (one row per rune)"
+	if a.bitsPerByte {
+		title = "Bit Grid (one row per byte)"
+	}
+	b.WriteString(a.styles.Title.Render(i18n.T(title)))
+	b.WriteString("\n\n")
+
+	if a.bitsPerByte {
+		refs := a.flatBytes()
+		a.syncByteCursor(refs)
+		top := a.viewTop(a.byteCursor, rows, len(refs)-1)
+		for i := top; i < top+rows && i < len(refs); i++ {
+			ref := refs[i]
+			label := fmt.Sprintf("%06X  %02X  ", ref.offset, ref.value)
+			b.WriteString(a.bitRow(label, uint32(ref.value), 8, i == a.byteCursor, ref.char == a.cursor))
+		}
+	} else {
+		top := a.viewTop(a.cursor, rows, len(a.characters)-1)
+		for i := top; i < top+rows && i < len(a.characters); i++ {
+			char := a.characters[i]
+			label := fmt.Sprintf("%6d  %-8s", char.RuneOffset, char.Unicode)
+			b.WriteString(a.bitRow(label, uint32(char.Rune), 24, i == a.cursor, false))
+		}
+	}
+
+	b.WriteString("\n")
+	b.WriteString(a.styles.Muted.Render(i18n.T("↑/↓ move • b rune/byte rows")))
+	return b.String()
+}
+
+// bitRow renders one row of the bit grid: the label, then width bits of
+// value in nibbles, with set bits emphasized.
+func (a *App) bitRow(label string, value uint32, width int, selected, related bool) string {
+	var b strings.Builder
+
+	switch {
+	case selected:
+		b.WriteString(a.styles.TableSelected.Padding(0).Render(label))
+	case related:
+		b.WriteString(a.styles.SearchMatch.Render(label))
+	default:
+		b.WriteString(a.styles.Muted.Render(label))
+	}
+
+	for bit := width - 1; bit >= 0; bit-- {
+		if value&(1<<bit) != 0 {
+			b.WriteString(a.styles.Printable.Bold(true).Render("1"))
+		} else {
+			b.WriteString(a.styles.Muted.Render("0"))
+		}
+		if bit > 0 && bit%4 == 0 {
+			b.WriteString(" ")
+		}
+		if bit > 0 && bit%8 == 0 {
+			b.WriteString(" ") // Wider gap between bytes
+		}
+	}
+	b.WriteString("\n")
+	return b.String()
+}
@@ -0,0 +1,170 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"stringinspect/internal/analysis"
+	"stringinspect/internal/i18n"
+)
+
+// browserColumns is the number of codepoints per row in the block browser.
+const browserColumns = 16
+
+// openBrowser shows the block browser, starting at the block of the
+// selected character when there is one.
+func (a *App) openBrowser() {
+	start := 'A'
+	if a.cursor < len(a.characters) {
+		start = a.characters[a.cursor].Rune
+	}
+
+	a.browserBlock = 0
+	blocks := analysis.Blocks()
+	for i, b := range blocks {
+		if start >= b.Start && start <= b.End {
+			a.browserBlock = i
+			break
+		}
+	}
+	a.browserCursor = start
+	if start < blocks[a.browserBlock].Start || start > blocks[a.browserBlock].End {
+		a.browserCursor = blocks[a.browserBlock].Start
+	}
+	a.showBrowser = true
+}
+
+// setBrowserBlock selects block i and moves the cursor to its start.
+func (a *App) setBrowserBlock(i int) {
+	blocks := analysis.Blocks()
+	i = (i + len(blocks)) % len(blocks)
+	a.browserBlock = i
+	a.browserCursor = blocks[i].Start
+}
+
+// handleBrowser handles keyboard input for the block browser.
+func (a *App) handleBrowser(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	block := analysis.Blocks()[a.browserBlock]
+
+	move := func(delta rune) {
+		a.browserCursor = max(block.Start, min(block.End, a.browserCursor+delta))
+	}
+
+	switch msg.String() {
+	case "esc", "q", "ctrl+b":
+		a.showBrowser = false
+	case "left", "h":
+		move(-1)
+	case "right", "l":
+		move(1)
+	case "up", "k":
+		move(-browserColumns)
+	case "down", "j":
+		move(browserColumns)
+	case "home", "g":
+		a.browserCursor = block.Start
+	case "end", "G":
+		a.browserCursor = block.End
+	case "pgup", "<":
+		a.setBrowserBlock(a.browserBlock - 1)
+	case "pgdown", ">":
+		a.setBrowserBlock(a.browserBlock + 1)
+	case "enter":
+		if !utf8.ValidRune(a.browserCursor) {
+			a.statusMsg = i18n.T("Surrogate codepoints cannot be inserted")
+			break
+		}
+		a.insertRune(a.browserCursor)
+	}
+	return a, nil
+}
+
+// browserCell returns a display string for a codepoint in the grid.
+// Marks get a dotted circle base; unprintable codepoints show a dot.
+func browserCell(r rune) string {
+	switch {
+	case unicode.Is(unicode.Mn, r) || unicode.Is(unicode.Me, r):
+		return "◌" + string(r)
+	case !unicode.IsPrint(r) || unicode.IsSpace(r):
+		return "·"
+	default:
+		return string(r)
+	}
+}
+
+// renderBrowser renders the block browser grid and the selected codepoint.
+func (a *App) renderBrowser() string {
+	blocks := analysis.Blocks()
+	block := blocks[a.browserBlock]
+	var b strings.Builder
+
+	b.WriteString(a.styles.Title.Render(fmt.Sprintf("%s (U+%04X–U+%04X)", block.Name, block.Start, block.End)))
+	b.WriteString("  ")
+	b.WriteString(a.styles.Muted.Render(i18n.Tf("block %d/%d", a.browserBlock+1, len(blocks))))
+	b.WriteString("\n\n")
+
+	// Scroll so the cursor row stays visible
+	rows := rune(max(4, a.height-22))
+	firstRow := block.Start / browserColumns
+	lastRow := block.End / browserColumns
+	cursorRow := a.browserCursor / browserColumns
+	top := max(firstRow, cursorRow-rows+1)
+	if top+rows > lastRow+1 {
+		top = max(firstRow, lastRow+1-rows)
+	}
+
+	// Column header
+	b.WriteString(a.styles.Muted.Render("        "))
+	for col := 0; col < browserColumns; col++ {
+		b.WriteString(a.styles.Muted.Render(fmt.Sprintf(" %X ", col)))
+	}
+	b.WriteString("\n")
+
+	for row := top; row <= lastRow && row < top+rows; row++ {
+		b.WriteString(a.styles.Muted.Render(fmt.Sprintf("U+%04X  ", row*browserColumns)))
+		for col := rune(0); col < browserColumns; col++ {
+			r := row*browserColumns + col
+			cell := lipgloss.NewStyle().Width(3).Align(lipgloss.Center)
+			switch {
+			case r < block.Start || r > block.End:
+				b.WriteString(cell.Render(""))
+			case r == a.browserCursor:
+				b.WriteString(cell.Inherit(a.styles.SearchMatch).Render(browserCell(r)))
+			case analysis.Category(r) == "Cn":
+				b.WriteString(cell.Inherit(a.styles.Muted).Render("·"))
+			default:
+				b.WriteString(cell.Render(browserCell(r)))
+			}
+		}
+		b.WriteString("\n")
+	}
+
+	// Selected codepoint details
+	r := a.browserCursor
+	name := analysis.Name(r)
+	if name == "" {
+		name = i18n.T("<unassigned>")
+	}
+	b.WriteString("\n")
+	b.WriteString(a.styles.Printable.Render(fmt.Sprintf("U+%04X %s", r, name)))
+	b.WriteString("\n")
+	utf8Hex := i18n.T("invalid (surrogate)")
+	if utf8.ValidRune(r) {
+		utf8Hex = fmt.Sprintf("% X", []byte(string(r)))
+	}
+	b.WriteString(a.styles.Muted.Render(fmt.Sprintf("%s • %s • UTF-8 %s",
+		analysis.Category(r), analysis.Script(r), utf8Hex)))
+	b.WriteString("\n\n")
+	b.WriteString(a.styles.Muted.Render(i18n.T("arrows move • pgup/pgdn block • enter insert • esc close")))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorPrimary).
+		Padding(1, 2).
+		Render(b.String())
+}
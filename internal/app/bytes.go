@@ -0,0 +1,182 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"stringinspect/internal/i18n"
+)
+
+// Layout of the rune/byte dual view.
+const (
+	runesPerRow = 8
+	bytesPerRow = 16
+)
+
+// byteRef is one UTF-8 byte of a visible character.
+type byteRef struct {
+	value  byte
+	char   int // Index into a.characters
+	offset int // Original byte offset in the input
+}
+
+// flatBytes lists the UTF-8 bytes of the visible characters in order.
+func (a *App) flatBytes() []byteRef {
+	var refs []byteRef
+	for i, c := range a.characters {
+		for k, b := range c.UTF8Bytes {
+			refs = append(refs, byteRef{value: b, char: i, offset: c.ByteOffset + k})
+		}
+	}
+	return refs
+}
+
+// syncByteCursor keeps the byte cursor inside the selected character, so
+// moves made elsewhere (goto, search, jumps) carry over to the byte pane.
+func (a *App) syncByteCursor(refs []byteRef) {
+	if a.byteCursor < len(refs) && refs[a.byteCursor].char == a.cursor {
+		return
+	}
+	a.byteCursor = 0
+	for i, ref := range refs {
+		if ref.char == a.cursor {
+			a.byteCursor = i
+			return
+		}
+	}
+}
+
+// handleBytesView handles keys specific to the rune/byte view. It returns
+// false for keys that should fall through to the normal bindings.
+func (a *App) handleBytesView(msg tea.KeyMsg) bool {
+	refs := a.flatBytes()
+	if len(refs) == 0 {
+		return false
+	}
+	a.syncByteCursor(refs)
+
+	if key.Matches(msg, a.keys.BytePane) {
+		a.byteFocus = !a.byteFocus
+		return true
+	}
+
+	if !a.byteFocus {
+		// Rune pane: up/down move a row, everything else is shared
+		switch {
+		case msg.Type == tea.KeyUp || msg.String() == "k":
+			a.cursor = max(a.cursor-runesPerRow, 0)
+		case msg.Type == tea.KeyDown || msg.String() == "j":
+			a.cursor = min(a.cursor+runesPerRow, len(a.characters)-1)
+		default:
+			return false
+		}
+		return true
+	}
+
+	switch msg.String() {
+	case "left", "h":
+		a.byteCursor = max(a.byteCursor-1, 0)
+	case "right", "l":
+		a.byteCursor = min(a.byteCursor+1, len(refs)-1)
+	case "up", "k":
+		a.byteCursor = max(a.byteCursor-bytesPerRow, 0)
+	case "down", "j":
+		a.byteCursor = min(a.byteCursor+bytesPerRow, len(refs)-1)
+	case "home", "g":
+		a.byteCursor = 0
+	case "end", "G":
+		a.byteCursor = len(refs) - 1
+	default:
+		return false
+	}
+	a.cursor = refs[a.byteCursor].char
+	return true
+}
+
+// renderBytesView renders runes on the left and their UTF-8 bytes on the
+// right. The selection in either pane highlights its counterpart.
+func (a *App) renderBytesView() string {
+	refs := a.flatBytes()
+	a.syncByteCursor(refs)
+
+	selected := a.styles.TableSelected.Padding(0)
+	counterpart := a.styles.SearchMatch
+	rows := max(4, a.height-20)
+
+	// Rune pane
+	var left strings.Builder
+	left.WriteString(a.paneTitle(i18n.T("Runes"), !a.byteFocus))
+	left.WriteString("\n")
+	top := a.viewTop(a.cursor/runesPerRow, rows, (len(a.characters)-1)/runesPerRow)
+	for row := top; row < top+rows && row*runesPerRow < len(a.characters); row++ {
+		first := row * runesPerRow
+		left.WriteString(a.styles.Muted.Render(fmt.Sprintf("%6d  ", a.characters[first].RuneOffset)))
+		for i := first; i < first+runesPerRow && i < len(a.characters); i++ {
+			char := a.characters[i]
+			display := glyph(char)
+			if lipgloss.Width(display) > 2 {
+				display = "·"
+			}
+
+			style := a.styles.CharStyle(int(char.Type))
+			switch {
+			case i == a.cursor && !a.byteFocus:
+				style = selected
+			case i == a.cursor:
+				style = counterpart
+			}
+			left.WriteString(style.Width(3).Align(lipgloss.Center).Render(display))
+		}
+		left.WriteString("\n")
+	}
+
+	// Byte pane
+	var right strings.Builder
+	right.WriteString(a.paneTitle(i18n.T("UTF-8 bytes"), a.byteFocus))
+	right.WriteString("\n")
+	top = a.viewTop(a.byteCursor/bytesPerRow, rows, (len(refs)-1)/bytesPerRow)
+	for row := top; row < top+rows && row*bytesPerRow < len(refs); row++ {
+		first := row * bytesPerRow
+		right.WriteString(a.styles.Muted.Render(fmt.Sprintf("%06X  ", refs[first].offset)))
+		for i := first; i < first+bytesPerRow && i < len(refs); i++ {
+			ref := refs[i]
+
+			style := a.styles.CharStyle(int(a.characters[ref.char].Type))
+			switch {
+			case i == a.byteCursor && a.byteFocus:
+				style = selected
+			case ref.char == a.cursor:
+				style = counterpart
+			}
+			right.WriteString(style.Render(fmt.Sprintf("%02X", ref.value)))
+			right.WriteString(" ")
+		}
+		right.WriteString("\n")
+	}
+
+	char := a.characters[a.cursor]
+	info := a.styles.Muted.Render(i18n.Tf("%s at char %d • %d byte(s) at offset %d • b switch pane",
+		char.Unicode, char.RuneOffset, len(char.UTF8Bytes), char.ByteOffset))
+
+	height := max(lipgloss.Height(left.String()), lipgloss.Height(right.String()))
+	separator := a.styles.Muted.Render(strings.TrimSuffix(strings.Repeat(" │\n", height), "\n"))
+	return lipgloss.JoinHorizontal(lipgloss.Top, left.String(), separator, "  ", right.String()) + "\n" + info
+}
+
+// paneTitle renders a pane heading, highlighted when the pane has focus.
+func (a *App) paneTitle(title string, focused bool) string {
+	if focused {
+		return a.styles.Title.Render(title)
+	}
+	return a.styles.Muted.Render(title)
+}
+
+// scrollTop returns the first visible row that keeps cursorRow on screen.
+func scrollTop(cursorRow, rows, lastRow int) int {
+	top := max(cursorRow-rows+1, 0)
+	return min(top, max(lastRow-rows+1, 0))
+}
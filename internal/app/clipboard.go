@@ -0,0 +1,34 @@
+package app
+
+import "github.com/atotto/clipboard"
+
+// Where copies go. The system clipboard needs xclip, xsel, or
+// wl-clipboard on Linux, and a display for them to talk to; without one,
+// as over SSH or in a container, copies stay in an internal register so
+// copy and paste still work within the app.
+const (
+	clipboardSystem   = "system clipboard"
+	clipboardRegister = "internal register"
+)
+
+// copyText copies s to the system clipboard and returns where it went. The
+// register always keeps a copy, for pasting when the system clipboard
+// stops answering.
+func (a *App) copyText(s string) (backend string) {
+	a.register = s
+	if !clipboard.Unsupported && clipboard.WriteAll(s) == nil {
+		return clipboardSystem
+	}
+	return clipboardRegister
+}
+
+// pasteText returns the text of the system clipboard, or of the register
+// when the system clipboard cannot be read, and where it came from.
+func (a *App) pasteText() (text, backend string) {
+	if !clipboard.Unsupported {
+		if text, err := clipboard.ReadAll(); err == nil {
+			return text, clipboardSystem
+		}
+	}
+	return a.register, clipboardRegister
+}
@@ -0,0 +1,125 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"stringinspect/internal/analysis"
+	"stringinspect/internal/i18n"
+)
+
+// openCodepages shows the codepage list, with the current one selected.
+// Entry 0 is plain ASCII, the others are analysis.Codepages.
+func (a *App) openCodepages() {
+	a.codepageCursor = 0
+	for i, c := range analysis.Codepages {
+		if c == a.codepage {
+			a.codepageCursor = i + 1
+		}
+	}
+	a.showCodepages = true
+}
+
+// handleCodepages handles keyboard input for the codepage list.
+func (a *App) handleCodepages(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q", "K":
+		a.showCodepages = false
+	case "up", "k":
+		a.codepageCursor = max(a.codepageCursor-1, 0)
+	case "down", "j":
+		a.codepageCursor = min(a.codepageCursor+1, len(analysis.Codepages))
+	case "enter":
+		a.showCodepages = false
+		if a.codepageCursor == 0 {
+			a.codepage = nil
+			a.statusMsg = i18n.T("Bytes shown as ASCII")
+			return a, nil
+		}
+		a.codepage = analysis.Codepages[a.codepageCursor-1]
+		a.statusMsg = i18n.Tf("Bytes shown as %s", a.codepage.Name)
+	}
+	return a, nil
+}
+
+// codepageSample returns the bytes from the cursor on, as many as the
+// codepage list has room to show.
+func (a *App) codepageSample(n int) []byte {
+	var sample []byte
+	for i := a.cursor; i < len(a.characters) && len(sample) < n; i++ {
+		sample = append(sample, a.characters[i].UTF8Bytes...)
+	}
+	return sample[:min(len(sample), n)]
+}
+
+// printableText replaces control characters, which would garble the
+// terminal, with middle dots.
+func printableText(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return '·'
+		}
+		return r
+	}, s)
+}
+
+// renderCodepages renders the codepage list. Each codepage shows the bytes
+// at the cursor as it would read them and, when the input looks like
+// UTF-8 wrongly decoded in that codepage, the text it was meant to be.
+func (a *App) renderCodepages() string {
+	var b strings.Builder
+
+	b.WriteString(a.styles.Title.Render(i18n.T("Interpret Bytes As")))
+	b.WriteString("\n\n")
+
+	width := max((a.width-30)/2, 12)
+	sample := a.codepageSample(width)
+	input := a.input.Value()
+
+	rows := max(a.height-16, 5)
+	top := scrollTop(a.codepageCursor, rows, len(analysis.Codepages))
+	for i := top; i < min(top+rows, len(analysis.Codepages)+1); i++ {
+		name, text, repaired := "ASCII", "", ""
+		if i == 0 {
+			for _, v := range sample {
+				if v >= 0x20 && v < 0x7F {
+					text += string(rune(v))
+				} else {
+					text += "."
+				}
+			}
+		} else {
+			c := analysis.Codepages[i-1]
+			name = c.Name
+			text = printableText(c.Decode(sample))
+			if r, ok := c.Repair(input); ok {
+				repaired = "→ " + truncateWidth(printableText(r), width)
+			}
+		}
+
+		line := padCell(name, 14) + padCell(text, width)
+		if i == a.codepageCursor {
+			b.WriteString(a.styles.Highlighted.Padding(0).Render(line))
+		} else {
+			b.WriteString(a.styles.Printable.Render(line))
+		}
+		b.WriteString("  " + a.styles.Success.Render(repaired) + "\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(a.styles.Muted.Render(fmt.Sprintf("%d/%d", a.codepageCursor+1, len(analysis.Codepages)+1)))
+	b.WriteString("\n")
+	b.WriteString(a.styles.Muted.Render(i18n.T("→ is what the input meant if it is UTF-8 misread in that codepage")))
+	b.WriteString("\n")
+	b.WriteString(a.styles.Muted.Render(i18n.T("↑/↓ select • enter show hex dump and details in it • esc close")))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorPrimary).
+		Padding(1, 2).
+		Render(b.String())
+}
@@ -0,0 +1,319 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf16"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"stringinspect/internal/analysis"
+	"stringinspect/internal/i18n"
+)
+
+// column is one field the table views can show.
+type column struct {
+	id    string // Name used in the config file
+	label string
+	flex  bool // Takes the remaining space in the vertical table
+	fn    func(c analysis.Character) string
+}
+
+// columns lists every available table column in their default order.
+var columns = []column{
+	{"pos", "Pos", false, func(c analysis.Character) string { return fmt.Sprintf("%d", c.RuneOffset) }},
+	{"char", "Char", false, glyph},
+	{"hex", "Hex", false, func(c analysis.Character) string { return c.Hex }},
+	{"dec", "Dec", false, func(c analysis.Character) string { return fmt.Sprintf("%d", c.Dec) }},
+	{"bin", "Bin", false, func(c analysis.Character) string { return c.Bin }},
+	{"oct", "Oct", false, func(c analysis.Character) string { return c.Oct }},
+	{"unicode", "Unicode", false, func(c analysis.Character) string { return c.Unicode }},
+	{"utf8", "UTF-8", false, func(c analysis.Character) string { return c.UTF8Hex }},
+	{"utf16", "UTF-16", false, func(c analysis.Character) string { return utf16Hex(c.Rune) }},
+	{"type", "Type", false, func(c analysis.Character) string { return i18n.T(c.Type.String()) }},
+	{"category", "Cat", false, func(c analysis.Character) string { return analysis.Category(c.Rune) }},
+	{"script", "Script", false, func(c analysis.Character) string { return analysis.Script(c.Rune) }},
+	{"wordbreak", "Word", false, func(c analysis.Character) string { return analysis.WordBreak(c.Rune) }},
+	{"name", "Name", true, func(c analysis.Character) string { return analysis.Name(c.Rune) }},
+}
+
+// Default column layouts of the two table orientations.
+var (
+	defaultTableColumns    = []string{"char", "hex", "dec", "bin", "oct", "unicode"}
+	defaultVerticalColumns = []string{"pos", "char", "hex", "dec", "unicode", "type", "name"}
+)
+
+// columnByID returns the column with the given config name.
+func columnByID(id string) (column, bool) {
+	for _, c := range columns {
+		if c.id == id {
+			return c, true
+		}
+	}
+	return column{}, false
+}
+
+// resolveColumns maps config names to columns, skipping unknown and
+// repeated names. An empty result falls back to defaults.
+func resolveColumns(ids, defaults []string) []column {
+	var cols []column
+	seen := make(map[string]bool)
+	for _, id := range ids {
+		c, ok := columnByID(id)
+		if !ok || seen[id] {
+			continue
+		}
+		seen[id] = true
+		cols = append(cols, c)
+	}
+	if len(cols) == 0 && len(defaults) > 0 {
+		return resolveColumns(defaults, nil)
+	}
+	return cols
+}
+
+// Bounds of the horizontal table's cells when they fit their values: room
+// for a glyph at least, and a 21-bit binary string at most, so a long name
+// does not leave room for a single character.
+const (
+	minCellWidth = 4
+	maxCellWidth = 24
+)
+
+// fitWidth returns the width of a cell that holds the longest value of col
+// among chars, with the cells' padding on both sides.
+func fitWidth(col column, chars []analysis.Character) int {
+	w := 0
+	for _, c := range chars {
+		w = max(w, lipgloss.Width(col.fn(c)))
+	}
+	return w + 2
+}
+
+// tableCellWidth returns the width of the horizontal table's cells: the
+// configured cell_width, or the width of the longest value among the
+// characters that can come into view without the cells changing
+// width at every step.
+func (a *App) tableCellWidth() int {
+	if w := a.config.CellWidth; w > 0 {
+		return w
+	}
+	if len(a.characters) == 0 {
+		return minCellWidth
+	}
+
+	// The most characters the table can show is a screen of the narrowest
+	// cells, in every band when wrapped
+	n := max((a.width-20)/minCellWidth, 1)
+	if a.tableWrap {
+		n *= a.wrapBands()
+	}
+	lo := max(min(a.tableStart, a.cursor)-n, 0)
+	hi := min(max(a.tableStart, a.cursor)+n, len(a.characters))
+
+	w := minCellWidth
+	for _, col := range a.activeColumns() {
+		w = max(w, fitWidth(col, a.characters[lo:hi]))
+	}
+	return min(w, maxCellWidth)
+}
+
+// verticalWidths returns the width of each column of the vertical table:
+// the configured column_widths, or enough for the label and the longest
+// value among rows. Flexible columns (the name) get 0, to share what is
+// left, unless configured.
+func (a *App) verticalWidths(cols []column, rows []analysis.Character) []int {
+	widths := make([]int, len(cols))
+	for i, col := range cols {
+		switch w, ok := a.config.ColumnWidths[col.id]; {
+		case ok && w > 0:
+			widths[i] = w
+		case !col.flex:
+			widths[i] = max(fitWidth(col, rows), lipgloss.Width(i18n.T(col.label))+2)
+		}
+	}
+	return widths
+}
+
+// utf16Hex formats the UTF-16 code units of r, e.g. "D83D DE00".
+func utf16Hex(r rune) string {
+	units := utf16.Encode([]rune{r})
+	parts := make([]string, len(units))
+	for i, u := range units {
+		parts[i] = fmt.Sprintf("%04X", u)
+	}
+	return strings.Join(parts, " ")
+}
+
+// columnChoice is one line of the column editor.
+type columnChoice struct {
+	id string
+	on bool
+}
+
+// tableColumnIDs returns the config names of the current orientation's
+// columns and the defaults they fall back to.
+func (a *App) tableColumnIDs() (ids, defaults []string) {
+	if a.tableVertical {
+		return a.verticalColumns, defaultVerticalColumns
+	}
+	return a.tableColumns, defaultTableColumns
+}
+
+// openColumnEditor shows the column editor for the current orientation.
+func (a *App) openColumnEditor() {
+	a.columnChoices = choicesFor(resolveColumns(a.tableColumnIDs()))
+	a.columnCursor = 0
+	a.showColumns = true
+}
+
+// choicesFor lists the enabled columns in order, followed by the
+// disabled ones.
+func choicesFor(enabled []column) []columnChoice {
+	var choices []columnChoice
+	on := make(map[string]bool)
+	for _, c := range enabled {
+		choices = append(choices, columnChoice{id: c.id, on: true})
+		on[c.id] = true
+	}
+	for _, c := range columns {
+		if !on[c.id] {
+			choices = append(choices, columnChoice{id: c.id})
+		}
+	}
+	return choices
+}
+
+// handleColumnEditor handles keyboard input for the column editor.
+func (a *App) handleColumnEditor(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	choices := a.columnChoices
+	move := func(delta int) {
+		j := a.columnCursor + delta
+		if j < 0 || j >= len(choices) {
+			return
+		}
+		choices[a.columnCursor], choices[j] = choices[j], choices[a.columnCursor]
+		a.columnCursor = j
+	}
+
+	switch msg.String() {
+	case "esc", "q":
+		a.showColumns = false
+	case "up", "k":
+		a.columnCursor = max(a.columnCursor-1, 0)
+	case "down", "j":
+		a.columnCursor = min(a.columnCursor+1, len(choices)-1)
+	case "shift+up", "K":
+		move(-1)
+	case "shift+down", "J":
+		move(1)
+	case " ", "x":
+		c := &choices[a.columnCursor]
+		if c.on && a.enabledChoices() == 1 {
+			a.statusMsg = i18n.T("At least one column must stay visible")
+			return a, nil
+		}
+		c.on = !c.on
+	case "d":
+		// Back to the default layout
+		_, defaults := a.tableColumnIDs()
+		a.columnChoices = choicesFor(resolveColumns(defaults, nil))
+	case "enter":
+		var ids []string
+		for _, c := range choices {
+			if c.on {
+				ids = append(ids, c.id)
+			}
+		}
+		a.setTableColumns(ids)
+		a.showColumns = false
+		a.saveColumns()
+	}
+	return a, nil
+}
+
+// enabledChoices counts the enabled lines of the column editor.
+func (a *App) enabledChoices() int {
+	n := 0
+	for _, c := range a.columnChoices {
+		if c.on {
+			n++
+		}
+	}
+	return n
+}
+
+// setTableColumns sets the columns of the current orientation.
+func (a *App) setTableColumns(ids []string) {
+	if a.tableVertical {
+		a.verticalColumns = ids
+	} else {
+		a.tableColumns = ids
+	}
+}
+
+// saveColumns stores both column layouts in the config file.
+func (a *App) saveColumns() {
+	a.config.TableColumns = a.tableColumns
+	a.config.VerticalColumns = a.verticalColumns
+	switch err := a.saveConfig(); {
+	case err != nil:
+		a.statusMsg = i18n.Tf("Columns updated, save failed: %v", err)
+	case a.configPath == "":
+		a.statusMsg = i18n.T("Columns updated (no config file)")
+	default:
+		a.statusMsg = i18n.Tf("Columns saved to %s", a.configPath)
+	}
+}
+
+// renderColumnEditor renders the column editor.
+func (a *App) renderColumnEditor() string {
+	var b strings.Builder
+
+	layout := i18n.T("horizontal")
+	if a.tableVertical {
+		layout = i18n.T("vertical")
+	}
+	b.WriteString(a.styles.Title.Render(i18n.Tf("Table Columns (%s)", layout)))
+	b.WriteString("\n\n")
+
+	for i, choice := range a.columnChoices {
+		c, _ := columnByID(choice.id)
+		box := "[ ]"
+		if choice.on {
+			box = "[x]"
+		}
+		line := box + " " + padCell(i18n.T(c.label), 8) + " " + c.id
+		switch {
+		case i == a.columnCursor:
+			b.WriteString(a.styles.Highlighted.Padding(0).Render(line))
+		case choice.on:
+			b.WriteString(a.styles.Printable.Render(line))
+		default:
+			b.WriteString(a.styles.Muted.Render(line))
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(a.styles.Muted.Render(i18n.T("space toggle • K/J move • d defaults • enter save • esc cancel")))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorPrimary).
+		Padding(1, 2).
+		Render(b.String())
+}
+
+// activeColumns returns the horizontal table's rows. Positions are added
+// when a filter leaves gaps, unless already shown.
+func (a *App) activeColumns() []column {
+	cols := resolveColumns(a.tableColumns, defaultTableColumns)
+	if a.filter.active() && !slices.ContainsFunc(cols, func(c column) bool { return c.id == "pos" }) {
+		pos, _ := columnByID("pos")
+		cols = append([]column{pos}, cols...)
+	}
+	return cols
+}
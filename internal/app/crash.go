@@ -0,0 +1,137 @@
+package app
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"text/tabwriter"
+	"time"
+	"unicode/utf8"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"stringinspect/internal/analysis"
+)
+
+// maxRecentKeys bounds the keys kept for a crash report.
+const maxRecentKeys = 32
+
+// crashInfo is a panic caught in Update or View.
+type crashInfo struct {
+	value any
+	stack []byte
+}
+
+// recordKey keeps msg among the last keys pressed, and logs it when
+// debugging. Pastes are kept as their length only, so a report does not
+// carry pasted text.
+func (a *App) recordKey(msg tea.KeyMsg) {
+	k := msg.String()
+	if msg.Paste {
+		k = fmt.Sprintf("paste (%d runes)", len(msg.Runes))
+	}
+	a.debugf("key %s", k)
+	a.recentKeys = append(a.recentKeys, k)
+	if over := len(a.recentKeys) - maxRecentKeys; over > 0 {
+		a.recentKeys = a.recentKeys[over:]
+	}
+}
+
+// catchPanic keeps the value and stack of a panic in Update or View for
+// the crash report, then lets it go on to Bubble Tea, which restores the
+// terminal. It must be deferred.
+func (a *App) catchPanic() {
+	if r := recover(); r != nil {
+		if a.crash == nil {
+			a.crash = &crashInfo{value: r, stack: debug.Stack()}
+		}
+		panic(r)
+	}
+}
+
+// WriteCrashReport writes a diagnostic report of a crash to a new file in
+// dir and returns its path. The report has the panic, when it was caught
+// in Update or View, the state of the App, the length of the input, and
+// the last keys pressed; it leaves out the text itself.
+func (a *App) WriteCrashReport(dir string) (string, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", err
+	}
+	f, err := os.CreateTemp(dir, "crash-"+time.Now().Format("20060102-150405")+"-*.txt")
+	if err != nil {
+		return "", err
+	}
+
+	w := tabwriter.NewWriter(f, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "StringInspect crash report\n\n")
+	fmt.Fprintf(w, "Time:\t%s\n", time.Now().Format(time.RFC3339))
+	fmt.Fprintf(w, "Version:\t%s (%s %s/%s)\n", a.version, runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	if a.crash != nil {
+		fmt.Fprintf(w, "Panic:\t%v\n", a.crash.value)
+	} else {
+		fmt.Fprintf(w, "Panic:\tin a background command, see the trace printed on exit\n")
+	}
+
+	fmt.Fprintf(w, "\nState\n")
+	mode := a.viewMode.String()
+	if a.input.Focused() {
+		mode = "Input"
+	}
+	fmt.Fprintf(w, "Mode:\t%s\n", mode)
+	fmt.Fprintf(w, "Terminal:\t%dx%d\n", a.width, a.height)
+	fmt.Fprintf(w, "Tab:\t%d of %d\n", a.activeTab+1, len(a.tabs))
+	fmt.Fprintf(w, "Split:\t%t\n", a.split)
+	input := a.input.Value()
+	fmt.Fprintf(w, "Input:\t%d bytes, %d runes\n", len(input), utf8.RuneCountInString(input))
+	fmt.Fprintf(w, "Characters:\t%d, %d shown, cursor at %d\n", len(a.all), len(a.characters), a.cursor)
+	if a.source != nil {
+		fmt.Fprintf(w, "Source:\t%d bytes, %s, binary %t\n", len(a.source.data), a.source.encoding, a.source.binary)
+	}
+	if open := a.openOverlays(); len(open) > 0 {
+		fmt.Fprintf(w, "Open:\t%s\n", strings.Join(open, ", "))
+	}
+	fmt.Fprintf(w, "Last keys:\t%s\n", strings.Join(a.recentKeys, " "))
+
+	fmt.Fprintf(w, "\nUnicode data\n")
+	for _, s := range analysis.DataSources() {
+		fmt.Fprintf(w, "%s:\t%s\t%s\n", s.Data, s.Unicode, s.Source)
+	}
+	if err := w.Flush(); err == nil && a.crash != nil {
+		fmt.Fprintf(f, "\nStack\n%s", a.crash.stack)
+	}
+
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+	if abs, err := filepath.Abs(f.Name()); err == nil {
+		return abs, nil
+	}
+	return f.Name(), nil
+}
+
+// openOverlays names the menus, prompts, and panels that are open.
+func (a *App) openOverlays() []string {
+	var open []string
+	for _, o := range []struct {
+		name string
+		on   bool
+	}{
+		{"help", a.showHelp}, {"export", a.showExport || a.exportNaming}, {"search", a.showSearch},
+		{"import", a.showImport}, {"goto", a.showGoto}, {"scope", a.showScope},
+		{"replace", a.showReplace || a.replacing != nil}, {"edit", a.editOp != editNone},
+		{"picker", a.showPicker}, {"browser", a.showBrowser}, {"files", a.showFiles},
+		{"recent", a.showRecent}, {"columns", a.showColumns}, {"stats", a.showStats},
+		{"tutorial", a.showTutorial}, {"transforms", a.showTransforms}, {"tokens", a.showTokens},
+		{"codepages", a.showCodepages}, {"losses", a.showLosses}, {"audit", a.showAudit},
+		{"key capture", a.showKeyCapture}, {"paste", a.showPaste}, {"bidi", a.showBidi},
+		{"line breaks", a.showLineBreaks}, {"about", a.showAbout}, {"restore", a.restoreOffer != nil},
+	} {
+		if o.on {
+			open = append(open, o.name)
+		}
+	}
+	return open
+}
@@ -0,0 +1,28 @@
+package app
+
+import (
+	"log"
+
+	"stringinspect/internal/analysis"
+)
+
+// SetDebug turns on logging of key events, analysis timings, and exports
+// through the standard logger, which the caller points at a file (with
+// tea.LogToFile) since the terminal belongs to the TUI.
+func (a *App) SetDebug(on bool) {
+	a.debug = on
+	a.debugf("stringinspect %s", a.version)
+	for _, s := range analysis.DataSources() {
+		if s.Unicode == "" {
+			s.Unicode = "?"
+		}
+		a.debugf("data: %s, Unicode %s, %s", s.Data, s.Unicode, s.Source)
+	}
+}
+
+// debugf logs a line when debugging is on.
+func (a *App) debugf(format string, args ...any) {
+	if a.debug {
+		log.Printf(format, args...)
+	}
+}
@@ -0,0 +1,100 @@
+package app
+
+import (
+	"path/filepath"
+
+	"stringinspect/internal/analysis"
+	"stringinspect/internal/export"
+	"stringinspect/internal/i18n"
+)
+
+// DiffFile is one side of a file comparison.
+type DiffFile struct {
+	Path      string
+	Data      []byte
+	Detection analysis.Detection
+}
+
+// LoadDiff opens two files side by side in the split view, with both
+// cursors on the first difference and scrolling synchronized. The status
+// bar reports whether the files differ in content, encoding, or line
+// endings.
+func (a *App) LoadDiff(left, right DiffFile) {
+	a.LoadSource(left.Path, left.Data, left.Detection)
+	a.toggleSplit()
+	a.switchPane()
+	a.LoadSource(right.Path, right.Data, right.Detection)
+	a.switchPane()
+	a.tabs[a.activeTab].name = filepath.Base(left.Path) + " ↔ " + filepath.Base(right.Path)
+
+	d := analysis.CompareFiles(left.Data, left.Detection.Encoding, right.Data, right.Detection.Encoding)
+	if d.Char > 0 {
+		a.cursor = min(d.Char, max(len(a.characters)-1, 0))
+		a.other.cursor = min(d.Char, max(len(a.other.characters)-1, 0))
+	}
+	a.syncScroll = true
+	if len(a.characters) > 0 {
+		a.input.Blur()
+	}
+	a.statusMsg = diffSummary(d, left.Detection, right.Detection)
+}
+
+// diffSummary describes the first difference between two files.
+func diffSummary(d analysis.FileDiff, left, right analysis.Detection) string {
+	label := func(det analysis.Detection) string {
+		if det.BOM {
+			return i18n.Tf("%s with BOM", det.Encoding)
+		}
+		return det.Encoding.String()
+	}
+	switch d.Kind {
+	case analysis.DiffNone:
+		return i18n.T("Files are identical")
+	case analysis.DiffEncoding:
+		return i18n.Tf("Same text, different encoding: %s vs %s (first byte difference at %d)",
+			label(left), label(right), d.Byte)
+	case analysis.DiffLineEndings:
+		return i18n.Tf("Same text apart from line endings, first at character %d (byte %d)", d.Char, d.Byte)
+	default:
+		return i18n.Tf("Content differs at character %d (byte %d)", d.Char, d.Byte)
+	}
+}
+
+// encodingLabel names a detected encoding, noting a byte order mark.
+func encodingLabel(det analysis.Detection) string {
+	if det.BOM {
+		return i18n.Tf("%s with BOM", det.Encoding)
+	}
+	return det.Encoding.String()
+}
+
+// diffSides returns the texts of panes A and B for a diff export.
+func (a *App) diffSides() (export.DiffSide, export.DiffSide) {
+	otherLabel := "B"
+	if a.activePane == 1 {
+		otherLabel = "A"
+	}
+	active := paneSide(a.paneLabel(), a.input.Value(), a.source)
+	other := paneSide(otherLabel, a.other.input.Value(), a.other.source)
+	if a.activePane == 1 {
+		return other, active
+	}
+	return active, other
+}
+
+// paneSide describes the text of a pane, named after its file if it has
+// one.
+func paneSide(label, text string, src *source) export.DiffSide {
+	side := export.DiffSide{Name: "Pane " + label, Text: text}
+	if src != nil {
+		side.Name = src.path
+		if src.path == "" {
+			side.Name = "stdin"
+		}
+		side.Encoding = src.encoding.String()
+		if src.encoding == src.detection.Encoding {
+			side.Encoding = encodingLabel(src.detection)
+		}
+	}
+	return side
+}
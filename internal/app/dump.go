@@ -0,0 +1,156 @@
+package app
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"stringinspect/internal/export"
+	"stringinspect/internal/i18n"
+)
+
+// dumpGroups are the byte group sizes of the compact view, cycled in
+// order.
+var dumpGroups = []int{1, 2, 4, 8}
+
+// handleCompactView handles keys specific to the compact view. It
+// returns false for keys that should fall through.
+func (a *App) handleCompactView(msg tea.KeyMsg) bool {
+	switch {
+	case key.Matches(msg, a.keys.DumpGroup):
+		i := 0
+		for i < len(dumpGroups)-1 && dumpGroups[i] != a.dumpGroup {
+			i++
+		}
+		a.dumpGroup = dumpGroups[(i+1)%len(dumpGroups)]
+		a.statusMsg = i18n.Tf("Hex dump in %d-byte groups", a.dumpGroup)
+	case key.Matches(msg, a.keys.ByteOrder):
+		a.dumpLittle = !a.dumpLittle
+		a.statusMsg = i18n.Tf("Groups read as %s", i18n.T(a.byteOrderName()))
+		if a.dumpGroup < 2 {
+			a.statusMsg += i18n.T(" (W groups bytes to see the difference)")
+		}
+	case key.Matches(msg, a.keys.Up):
+		a.moveCompactLine(-1)
+	case key.Matches(msg, a.keys.Down):
+		a.moveCompactLine(1)
+	default:
+		return false
+	}
+	return true
+}
+
+// byteOrderName names the byte order groups are read in.
+func (a *App) byteOrderName() string {
+	if a.dumpLittle {
+		return "little-endian"
+	}
+	return "big-endian"
+}
+
+// dumpBytes lists the bytes of the visible characters, as the hex dump
+// shows them, and the index of the first byte of the selected character.
+func (a *App) dumpBytes() (bytes []export.DumpByte, cursor int) {
+	bytes = export.DumpBytes(a.characters)
+	cursor = slices.IndexFunc(bytes, func(d export.DumpByte) bool { return d.Char == a.cursor })
+	return bytes, max(cursor, 0)
+}
+
+// dumpOptions returns the layout of the compact view, which the hex dump
+// export follows.
+func (a *App) dumpOptions() export.DumpOptions {
+	return export.DumpOptions{Width: a.width, Group: a.dumpGroup, Little: a.dumpLittle, Codepage: a.codepage}
+}
+
+// renderByteDump renders the rows of the hex dump byte by byte, in groups,
+// followed by the value of the group under the cursor.
+func (a *App) renderByteDump() string {
+	var b strings.Builder
+
+	group := a.dumpGroup
+	bytes, cursor := a.dumpBytes()
+	const perLine = export.BytesPerDumpLine
+	lines := max(4, a.height-22)
+	top := scrollTop(cursor/perLine, lines, (len(bytes)-1)/perLine) * perLine
+
+	for i := top; i < len(bytes) && i < top+lines*perLine; i += perLine {
+		b.WriteString(export.ByteDumpLine(bytes[i:min(i+perLine, len(bytes))], a.dumpOptions(), a.dumpPainter))
+		b.WriteString("\n")
+	}
+
+	// Value of the group holding the cursor
+	start := cursor / group * group
+	if group > 1 && start+group <= len(bytes) {
+		raw := make([]byte, group)
+		for j := range raw {
+			raw[j] = bytes[start+j].Value
+		}
+		b.WriteString("\n")
+		b.WriteString(a.styles.Subtitle.Render(i18n.Tf("Group at %04X: ", bytes[start].Offset)))
+		b.WriteString(a.styles.Printable.Render(groupValue(raw, a.dumpLittle)))
+	}
+
+	b.WriteString("\n\n")
+	b.WriteString(a.styles.Muted.Render(i18n.T("W group size • B byte order • K codepage")))
+	return b.String()
+}
+
+// groupValue describes a group of 2, 4, or 8 bytes as unsigned and signed
+// integers in the given byte order, and as a float for 4 and 8 bytes.
+func groupValue(raw []byte, little bool) string {
+	var order binary.ByteOrder = binary.BigEndian
+	if little {
+		order = binary.LittleEndian
+	}
+
+	switch len(raw) {
+	case 2:
+		u := order.Uint16(raw)
+		return fmt.Sprintf("0x%04X = %d (int16 %d)", u, u, int16(u))
+	case 4:
+		u := order.Uint32(raw)
+		return fmt.Sprintf("0x%08X = %d (int32 %d, float32 %g)", u, u, int32(u), math.Float32frombits(u))
+	case 8:
+		u := order.Uint64(raw)
+		return fmt.Sprintf("0x%016X = %d (int64 %d, float64 %g)", u, u, int64(u), math.Float64frombits(u))
+	}
+	return ""
+}
+
+// compactLayout returns the layout of the compact view's lines, which
+// fit the terminal.
+func (a *App) compactLayout() export.DumpLayout {
+	return export.NewDumpLayout(a.characters, a.width)
+}
+
+// moveCompactLine moves the cursor dir lines down the compact view, to the
+// same column, or up for a negative dir.
+func (a *App) moveCompactLine(dir int) {
+	if len(a.characters) == 0 {
+		return
+	}
+	if a.dumpGroup > 1 || a.codepage != nil {
+		bytes, cursor := a.dumpBytes()
+		if i := cursor + dir*export.BytesPerDumpLine; i >= 0 && i < len(bytes) {
+			a.cursor = bytes[i].Char
+		}
+		return
+	}
+	if i := a.cursor + dir*a.compactLayout().PerLine; i >= 0 && i < len(a.characters) {
+		a.cursor = i
+	}
+}
+
+// dumpPainter styles the cells of the hex dump: each character's as in
+// the other views, and the offsets muted.
+func (a *App) dumpPainter(i int, s string) string {
+	if i < 0 {
+		return a.styles.Muted.Render(s)
+	}
+	return a.cellStyle(i, a.characters[i]).Padding(0).Render(s) // Keep cells at their width
+}
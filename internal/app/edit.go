@@ -0,0 +1,139 @@
+package app
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"stringinspect/internal/export"
+	"stringinspect/internal/i18n"
+)
+
+// editOp identifies the pending in-place edit.
+type editOp int
+
+const (
+	editNone editOp = iota
+	editReplace
+	editInsert
+)
+
+// startEdit opens the edit prompt for a replace or insert at the cursor.
+func (a *App) startEdit(op editOp) {
+	if a.cursor >= len(a.characters) {
+		a.statusMsg = i18n.T("Nothing to edit")
+		return
+	}
+	a.editOp = op
+	a.editInput.SetValue("")
+	a.editInput.Focus()
+}
+
+// handleEditPrompt handles keyboard input for the replace/insert prompt.
+func (a *App) handleEditPrompt(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		a.editOp = editNone
+		a.editInput.Blur()
+		return a, nil
+
+	case tea.KeyEnter:
+		text, err := parseCharInput(a.editInput.Value())
+		if err != nil {
+			a.statusMsg = i18n.Tf("Edit failed: %v", err)
+		} else if a.editOp == editReplace {
+			a.editAtCursor(1, text)
+			a.statusMsg = i18n.Tf("Replaced with %q", text)
+		} else {
+			a.editAtCursor(0, text)
+			a.statusMsg = i18n.Tf("Inserted %q", text)
+		}
+		a.editOp = editNone
+		a.editInput.Blur()
+		return a, nil
+	}
+
+	var cmd tea.Cmd
+	a.editInput, cmd = a.editInput.Update(msg)
+	return a, cmd
+}
+
+// deleteAtCursor removes the character under the cursor from the input.
+func (a *App) deleteAtCursor() {
+	if a.cursor >= len(a.characters) {
+		return
+	}
+	char := a.characters[a.cursor]
+	a.editAtCursor(1, "")
+	a.statusMsg = i18n.Tf("Deleted %s", char.Unicode)
+}
+
+// editAtCursor replaces n runes of the input at the cursor's original
+// position with text and re-analyzes, keeping the cursor at that position.
+func (a *App) editAtCursor(n int, text string) {
+	offset := a.characters[a.cursor].RuneOffset
+	runes := []rune(a.input.Value())
+
+	edited := string(runes[:offset]) + text + string(runes[offset+n:])
+	a.input.SetValue(edited)
+	a.analyzeInput()
+	a.cursor = a.indexForRuneOffset(offset)
+
+	if len(a.characters) == 0 {
+		a.input.Focus()
+	}
+}
+
+// parseCharInput interprets edit prompt text: one or more space-separated
+// codepoints (U+00A0, 0xA0) or literal text with backslash escapes.
+func parseCharInput(s string) (string, error) {
+	if s == "" {
+		return "", fmt.Errorf("nothing entered")
+	}
+
+	fields := strings.Fields(s)
+	var b strings.Builder
+	for _, f := range fields {
+		upper := strings.ToUpper(f)
+		hex, ok := strings.CutPrefix(upper, "U+")
+		if !ok {
+			hex, ok = strings.CutPrefix(upper, "0X")
+		}
+		if !ok {
+			// Not a codepoint list; treat the whole input as text
+			return export.Unescape(s)
+		}
+		v, err := strconv.ParseUint(hex, 16, 32)
+		if err != nil || v > 0x10FFFF {
+			return "", fmt.Errorf("invalid codepoint %q", f)
+		}
+		b.WriteRune(rune(v))
+	}
+	return b.String(), nil
+}
+
+// renderEditPrompt renders the replace/insert prompt.
+func (a *App) renderEditPrompt() string {
+	var b strings.Builder
+
+	title := i18n.T("Replace Character")
+	if a.editOp == editInsert {
+		title = i18n.T("Insert Before Cursor")
+	}
+	b.WriteString(a.styles.Title.Render(title))
+	b.WriteString("\n\n")
+	b.WriteString(a.editInput.View())
+	b.WriteString("\n\n")
+	b.WriteString(a.styles.Muted.Render("A • U+00A0 • 0x200B • \\u00E9 • U+0065 U+0301"))
+	b.WriteString("\n\n")
+	b.WriteString(a.styles.Muted.Render(i18n.T("enter apply • esc cancel")))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorPrimary).
+		Padding(1, 2).
+		Render(b.String())
+}
@@ -0,0 +1,110 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/filepicker"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"stringinspect/internal/analysis"
+	"stringinspect/internal/i18n"
+)
+
+// openFilePicker shows the file browser, starting in the directory of
+// the last opened file or the working directory. The returned command
+// reads the directory.
+func (a *App) openFilePicker() tea.Cmd {
+	if a.split {
+		a.statusMsg = i18n.T("Close the split view (|) to open files")
+		return nil
+	}
+
+	fp := filepicker.New()
+	fp.CurrentDirectory = a.fileDir
+	if fp.CurrentDirectory == "" {
+		if wd, err := os.Getwd(); err == nil {
+			fp.CurrentDirectory = wd
+		}
+	}
+	fp.AutoHeight = false
+	fp.SetHeight(max(a.height-16, 5))
+	fp.ShowPermissions = false
+	fp.KeyMap.Back = key.NewBinding(key.WithKeys("h", "backspace", "left"))
+	fp.Styles.Cursor = lipgloss.NewStyle().Foreground(ColorPrimary)
+	fp.Styles.Selected = lipgloss.NewStyle().Foreground(ColorPrimary).Bold(true)
+	fp.Styles.Directory = lipgloss.NewStyle().Foreground(ColorWhitespace)
+	fp.Styles.FileSize = fp.Styles.FileSize.Foreground(ColorMuted)
+	fp.Styles.EmptyDirectory = fp.Styles.EmptyDirectory.SetString(i18n.T("No files here."))
+
+	a.filePicker = fp
+	a.showFiles = true
+	return fp.Init()
+}
+
+// handleFilePicker handles keyboard input for the file browser.
+func (a *App) handleFilePicker(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q":
+		a.showFiles = false
+		return a, nil
+	case ".":
+		// Toggle hidden files, reading the directory again
+		a.filePicker.ShowHidden = !a.filePicker.ShowHidden
+		return a, a.filePicker.Init()
+	}
+
+	var cmd tea.Cmd
+	a.filePicker, cmd = a.filePicker.Update(msg)
+	if ok, path := a.filePicker.DidSelectFile(msg); ok {
+		a.showFiles = false
+		a.openFile(path)
+	}
+	return a, cmd
+}
+
+// openFile loads the file at path, into the current tab when it is
+// empty and into a new tab otherwise.
+func (a *App) openFile(path string) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		a.statusMsg = i18n.Tf("Open failed: %v", err)
+		return
+	}
+	if a.input.Value() != "" || a.source != nil {
+		a.newTab()
+	}
+	a.fileDir = filepath.Dir(path)
+
+	det := analysis.DetectEncoding(data)
+	a.LoadSource(path, data, det)
+	if a.statusMsg == "" {
+		a.statusMsg = i18n.Tf("Opened %s (%d bytes)", path, len(data))
+	}
+	a.rememberFile(path)
+	if len(a.characters) > 0 {
+		a.input.Blur()
+	}
+}
+
+// renderFilePicker renders the file browser.
+func (a *App) renderFilePicker() string {
+	var b strings.Builder
+
+	b.WriteString(a.styles.Title.Render(i18n.T("Open File")))
+	b.WriteString("\n")
+	b.WriteString(a.styles.Muted.Render(truncateWidth(a.filePicker.CurrentDirectory, max(a.width-12, 20))))
+	b.WriteString("\n\n")
+	b.WriteString(strings.TrimRight(a.filePicker.View(), "\n"))
+	b.WriteString("\n\n")
+	b.WriteString(a.styles.Muted.Render(i18n.T("↑/↓ select • → enter folder • ← parent • . hidden files • enter open • esc cancel")))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorPrimary).
+		Padding(1, 2).
+		Render(b.String())
+}
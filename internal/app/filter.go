@@ -0,0 +1,266 @@
+package app
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"stringinspect/internal/analysis"
+	"stringinspect/internal/i18n"
+)
+
+// FilterMode selects which character types are displayed.
+type FilterMode int
+
+const (
+	FilterAll FilterMode = iota
+	FilterHidePrintable
+	FilterControl
+	FilterExtended
+	FilterFlagged
+)
+
+func (f FilterMode) String() string {
+	switch f {
+	case FilterAll:
+		return "All"
+	case FilterHidePrintable:
+		return "Non-printable"
+	case FilterControl:
+		return "Control"
+	case FilterExtended:
+		return "Extended"
+	case FilterFlagged:
+		return "Flagged"
+	default:
+		return "Unknown"
+	}
+}
+
+// Next returns the mode following f, wrapping around at the end.
+func (f FilterMode) Next() FilterMode {
+	if f == FilterFlagged {
+		return FilterAll
+	}
+	return f + 1
+}
+
+// scope restricts the filter to a Unicode script or block.
+type scope struct {
+	block  bool   // Name is a block rather than a script
+	name   string // Canonical script or block name
+	negate bool   // Match characters outside the script or block
+}
+
+// parseScope parses a scope query: a script or block name, optionally
+// prefixed with "script:" or "block:" and negated with a leading "!".
+// Bare names are looked up as scripts first, then as blocks.
+func parseScope(query string) (scope, error) {
+	var sc scope
+
+	query = strings.TrimSpace(query)
+	if strings.HasPrefix(query, "!") {
+		sc.negate = true
+		query = strings.TrimSpace(query[1:])
+	}
+
+	kind, name, hasKind := strings.Cut(query, ":")
+	if !hasKind {
+		kind, name = "", query
+	}
+	kind = strings.ToLower(strings.TrimSpace(kind))
+
+	if kind == "" || kind == "script" {
+		if script, ok := analysis.FindScript(name); ok {
+			sc.name = script
+			return sc, nil
+		}
+	}
+	if kind == "" || kind == "block" {
+		if b, ok := analysis.FindBlock(name); ok {
+			sc.block = true
+			sc.name = b.Name
+			return sc, nil
+		}
+	}
+
+	switch kind {
+	case "", "script", "block":
+		return scope{}, errors.New(i18n.Tf("Unknown script or block %q", name))
+	default:
+		return scope{}, errors.New(i18n.Tf("Unknown filter kind %q (use script: or block:)", kind))
+	}
+}
+
+// match reports whether a character is inside the scope.
+func (sc scope) match(c analysis.Character) bool {
+	var in bool
+	if sc.block {
+		in = analysis.BlockName(c.Rune) == sc.name
+	} else {
+		in = analysis.Script(c.Rune) == sc.name
+	}
+	return in != sc.negate
+}
+
+// String describes the scope, e.g. "!script:Latin".
+func (sc scope) String() string {
+	kind := "script"
+	if sc.block {
+		kind = "block"
+	}
+	prefix := ""
+	if sc.negate {
+		prefix = "!"
+	}
+	return prefix + kind + ":" + sc.name
+}
+
+// filter holds the active display filter. Filtered characters keep their
+// original RuneOffset and ByteOffset.
+type filter struct {
+	mode  FilterMode
+	scope *scope // Optional script/block restriction
+}
+
+// active reports whether the filter hides anything.
+func (f filter) active() bool {
+	return f.mode != FilterAll || f.scope != nil
+}
+
+// match reports whether a character passes the filter.
+func (f filter) match(c analysis.Character) bool {
+	if f.scope != nil && !f.scope.match(c) {
+		return false
+	}
+
+	switch f.mode {
+	case FilterHidePrintable:
+		return !c.IsPrintable()
+	case FilterControl:
+		return c.IsControl()
+	case FilterExtended:
+		return c.IsExtended()
+	case FilterFlagged:
+		return c.IsFlagged()
+	default:
+		return true
+	}
+}
+
+// String describes the filter for the status bar.
+func (f filter) String() string {
+	switch {
+	case f.scope == nil:
+		return i18n.T(f.mode.String())
+	case f.mode == FilterAll:
+		return f.scope.String()
+	default:
+		return i18n.T(f.mode.String()) + " " + f.scope.String()
+	}
+}
+
+// apply returns the characters passing the filter.
+func (f filter) apply(chars []analysis.Character) []analysis.Character {
+	if !f.active() {
+		return chars
+	}
+
+	var filtered []analysis.Character
+	for _, c := range chars {
+		if f.match(c) {
+			filtered = append(filtered, c)
+		}
+	}
+	return filtered
+}
+
+// setFilter changes the display filter, keeping the cursor on the same
+// character where possible.
+func (a *App) setFilter(f filter) {
+	runeOffset := -1
+	if a.cursor < len(a.characters) {
+		runeOffset = a.characters[a.cursor].RuneOffset
+	}
+
+	a.filter = f
+	a.characters = f.apply(a.all)
+	a.refreshSearch()
+
+	a.cursor = 0
+	if runeOffset >= 0 {
+		a.cursor = a.indexForRuneOffset(runeOffset)
+	}
+
+	if f.active() {
+		a.statusMsg = i18n.Tf("Filter: %s (%d of %d chars)", f, len(a.characters), len(a.all))
+	} else {
+		a.statusMsg = i18n.T("Filter cleared")
+	}
+}
+
+// handleScopePrompt handles keyboard input for the script/block filter prompt.
+func (a *App) handleScopePrompt(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		a.showScope = false
+		a.scopeInput.Blur()
+		return a, nil
+
+	case tea.KeyEnter:
+		f := a.filter
+		if strings.TrimSpace(a.scopeInput.Value()) == "" {
+			f.scope = nil
+			a.setFilter(f)
+		} else if sc, err := parseScope(a.scopeInput.Value()); err != nil {
+			a.statusMsg = err.Error()
+		} else {
+			f.scope = &sc
+			a.setFilter(f)
+		}
+		a.showScope = false
+		a.scopeInput.Blur()
+		return a, nil
+	}
+
+	var cmd tea.Cmd
+	a.scopeInput, cmd = a.scopeInput.Update(msg)
+	return a, cmd
+}
+
+// renderScopePrompt renders the script/block filter prompt.
+func (a *App) renderScopePrompt() string {
+	var b strings.Builder
+
+	b.WriteString(a.styles.Title.Render(i18n.T("Script / Block Filter")))
+	b.WriteString("\n\n")
+	b.WriteString(a.scopeInput.View())
+	b.WriteString("\n\n")
+	b.WriteString(a.styles.Muted.Render(i18n.T("Cyrillic • !Latin • block:Arrows • empty to clear")))
+	b.WriteString("\n\n")
+	b.WriteString(a.styles.Muted.Render(i18n.T("enter apply • esc cancel")))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorPrimary).
+		Padding(1, 2).
+		Render(b.String())
+}
+
+// indexForRuneOffset returns the index of the first displayed character at
+// or after the original rune offset, or the last character if none follows.
+func (a *App) indexForRuneOffset(runeOffset int) int {
+	idx := sort.Search(len(a.characters), func(i int) bool {
+		return a.characters[i].RuneOffset >= runeOffset
+	})
+	if idx >= len(a.characters) {
+		idx = len(a.characters) - 1
+	}
+	if idx < 0 {
+		idx = 0
+	}
+	return idx
+}
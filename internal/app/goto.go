@@ -0,0 +1,172 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"stringinspect/internal/i18n"
+)
+
+// handleGotoPrompt handles keyboard input for the goto-offset prompt.
+func (a *App) handleGotoPrompt(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		a.showGoto = false
+		a.gotoInput.Blur()
+		return a, nil
+
+	case tea.KeyEnter:
+		idx, err := a.resolveGoto(a.gotoInput.Value())
+		if err != nil {
+			a.statusMsg = i18n.Tf("Goto failed: %v", err)
+		} else {
+			a.recordJump()
+			a.cursor = idx
+			char := a.characters[idx]
+			a.statusMsg = i18n.Tf("At char %d (byte %d)", char.RuneOffset, char.ByteOffset)
+		}
+		a.showGoto = false
+		a.gotoInput.Blur()
+		return a, nil
+	}
+
+	var cmd tea.Cmd
+	a.gotoInput, cmd = a.gotoInput.Update(msg)
+	return a, cmd
+}
+
+// resolveGoto converts a goto query into a character index. Accepted forms:
+//
+//	123     rune index
+//	b123    byte offset (the character containing that byte)
+//	12:5    line and column, both 1-based
+//
+// Numbers may be written in hex with a 0x prefix. Offsets refer to the
+// unfiltered input; if the target is hidden by the filter, the next visible
+// character is chosen.
+func (a *App) resolveGoto(query string) (int, error) {
+	runeOffset, err := a.resolveGotoOffset(query)
+	if err != nil {
+		return 0, err
+	}
+	return a.indexForRuneOffset(runeOffset), nil
+}
+
+// resolveGotoOffset converts a goto query into an original rune offset.
+func (a *App) resolveGotoOffset(query string) (int, error) {
+	query = strings.TrimSpace(strings.ToLower(query))
+	if query == "" {
+		return 0, fmt.Errorf("empty offset")
+	}
+	if len(a.characters) == 0 {
+		return 0, fmt.Errorf("nothing to navigate")
+	}
+
+	switch {
+	case strings.HasPrefix(query, "b") || strings.HasPrefix(query, "@"):
+		offset, err := parseOffset(query[1:])
+		if err != nil {
+			return 0, err
+		}
+		return a.indexAtByte(offset)
+
+	case strings.Contains(query, ":"):
+		parts := strings.SplitN(query, ":", 2)
+		line, err := parseOffset(parts[0])
+		if err != nil {
+			return 0, err
+		}
+		col, err := parseOffset(parts[1])
+		if err != nil {
+			return 0, err
+		}
+		return a.indexAtLineCol(line, col)
+
+	default:
+		idx, err := parseOffset(query)
+		if err != nil {
+			return 0, err
+		}
+		if idx >= len(a.all) {
+			return 0, fmt.Errorf("index %d out of range (0-%d)", idx, len(a.all)-1)
+		}
+		return idx, nil
+	}
+}
+
+// parseOffset parses a non-negative decimal or 0x-prefixed hex number.
+func parseOffset(s string) (int, error) {
+	s = strings.TrimSpace(s)
+	base := 10
+	if strings.HasPrefix(s, "0x") {
+		s = s[2:]
+		base = 16
+	}
+	n, err := strconv.ParseInt(s, base, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid number %q", s)
+	}
+	return int(n), nil
+}
+
+// indexAtByte returns the rune offset of the character containing the byte offset.
+func (a *App) indexAtByte(offset int) (int, error) {
+	last := a.all[len(a.all)-1]
+	if offset >= last.ByteOffset+len(last.UTF8Bytes) {
+		return 0, fmt.Errorf("byte %d out of range (0-%d)", offset, last.ByteOffset+len(last.UTF8Bytes)-1)
+	}
+
+	// First character starting after the offset, minus one
+	idx := sort.Search(len(a.all), func(i int) bool {
+		return a.all[i].ByteOffset > offset
+	})
+	return idx - 1, nil
+}
+
+// indexAtLineCol returns the rune offset of the character at a 1-based line and column.
+func (a *App) indexAtLineCol(line, col int) (int, error) {
+	if line < 1 || col < 1 {
+		return 0, fmt.Errorf("line and column start at 1")
+	}
+
+	curLine, curCol := 1, 1
+	for i, c := range a.all {
+		if curLine == line && curCol == col {
+			return i, nil
+		}
+		if c.Rune == '\n' {
+			if curLine == line {
+				break // Column is past the end of the line
+			}
+			curLine++
+			curCol = 1
+		} else {
+			curCol++
+		}
+	}
+	return 0, fmt.Errorf("no character at %d:%d", line, col)
+}
+
+// renderGotoPrompt renders the goto-offset prompt.
+func (a *App) renderGotoPrompt() string {
+	var b strings.Builder
+
+	b.WriteString(a.styles.Title.Render(i18n.T("Go To")))
+	b.WriteString("\n\n")
+	b.WriteString(a.gotoInput.View())
+	b.WriteString("\n\n")
+	b.WriteString(a.styles.Muted.Render(i18n.T("123 char index • b123 byte offset • 12:5 line:col • 0x hex")))
+	b.WriteString("\n\n")
+	b.WriteString(a.styles.Muted.Render(i18n.T("enter go • esc cancel")))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorPrimary).
+		Padding(1, 2).
+		Render(b.String())
+}
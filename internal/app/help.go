@@ -0,0 +1,96 @@
+package app
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"stringinspect/internal/i18n"
+)
+
+// helpLines renders the help screen content, one entry per line.
+func (a *App) helpLines() []string {
+	var lines []string
+
+	for _, group := range a.keys.HelpGroups() {
+		lines = append(lines, a.styles.Title.Render(i18n.T(group.Title)))
+		for _, k := range group.Keys {
+			h := k.Help()
+			lines = append(lines, "  "+a.styles.HelpKey.Width(16).Render(h.Key)+a.styles.HelpDesc.Render(i18n.T(h.Desc)))
+		}
+		lines = append(lines, "")
+	}
+
+	// Color legend
+	lines = append(lines, a.styles.Title.Render(i18n.T("Colors")))
+	legend := []struct {
+		style lipgloss.Style
+		glyph string
+		desc  string
+	}{
+		{a.styles.Printable, "A", "printable ASCII"},
+		{a.styles.Whitespace, "␣", "whitespace"},
+		{a.styles.Control, "<01>", "control character"},
+		{a.styles.Extended, "é", "extended (non-ASCII)"},
+		{a.styles.SearchMatch, " 41 ", "search match"},
+		{a.styles.TableSelected.Padding(0), " 41 ", "cursor"},
+		{a.styles.Error, "▲", "flagged character (minimap)"},
+	}
+	for _, l := range legend {
+		lines = append(lines, "  "+padCell(l.style.Render(l.glyph), 16)+a.styles.HelpDesc.Render(i18n.T(l.desc)))
+	}
+
+	return lines
+}
+
+// helpRows returns how many help lines fit on screen.
+func (a *App) helpRows() int {
+	return max(a.height-8, 5) // Header, footer, and padding
+}
+
+// handleHelp handles keyboard input on the help screen.
+func (a *App) handleHelp(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	last := max(len(a.helpLines())-a.helpRows(), 0)
+
+	switch {
+	case key.Matches(msg, a.keys.Help), msg.String() == "esc", msg.String() == "q":
+		a.showHelp = false
+	case key.Matches(msg, a.keys.Up):
+		a.helpOffset--
+	case key.Matches(msg, a.keys.Down):
+		a.helpOffset++
+	case key.Matches(msg, a.keys.PageUp):
+		a.helpOffset -= a.helpRows()
+	case key.Matches(msg, a.keys.PageDown), msg.String() == " ":
+		a.helpOffset += a.helpRows()
+	case key.Matches(msg, a.keys.Home):
+		a.helpOffset = 0
+	case key.Matches(msg, a.keys.End):
+		a.helpOffset = last
+	}
+	a.helpOffset = max(0, min(a.helpOffset, last))
+	return a, nil
+}
+
+// renderHelp renders the full-screen help.
+func (a *App) renderHelp() string {
+	lines := a.helpLines()
+	rows := a.helpRows()
+	end := min(a.helpOffset+rows, len(lines))
+
+	var b strings.Builder
+	b.WriteString(a.renderHeader())
+	b.WriteString(a.styles.Muted.Render(" • " + i18n.T("Help")))
+	b.WriteString("\n\n")
+	b.WriteString(strings.Join(lines[a.helpOffset:end], "\n"))
+	b.WriteString("\n\n")
+
+	footer := i18n.T("↑/↓ scroll • esc close")
+	if len(lines) > rows {
+		footer = i18n.Tf("%d-%d of %d • %s", a.helpOffset+1, end, len(lines), footer)
+	}
+	b.WriteString(a.styles.Muted.Render(footer))
+	return b.String()
+}
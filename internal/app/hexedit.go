@@ -0,0 +1,159 @@
+package app
+
+import (
+	"bytes"
+	"os"
+	"slices"
+	"strconv"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"stringinspect/internal/analysis"
+	"stringinspect/internal/i18n"
+)
+
+// byteEdit is one overwritten byte of a source, kept for undo.
+type byteEdit struct {
+	offset int
+	old    byte
+}
+
+// modified reports whether the byte at offset differs from the file as
+// loaded or last saved.
+func (s *source) modified(offset int) bool {
+	return s.original != nil && offset < len(s.original) && s.data[offset] != s.original[offset]
+}
+
+// unsaved reports whether the source has byte edits not written back.
+func (s *source) unsaved() bool {
+	return s.original != nil && !bytes.Equal(s.data, s.original)
+}
+
+// startHexEdit enters hex editing of a binary file in the compact view.
+func (a *App) startHexEdit() {
+	if len(a.characters) == 0 {
+		return
+	}
+	a.viewMode = ViewModeCompact
+	a.hexEdit = true
+	a.hexNibble = -1
+	a.statusMsg = i18n.T("Hex edit: type two hex digits per byte • u undo • ctrl+s save • esc done")
+}
+
+// handleHexEdit handles the keys of hex editing: hex digits overwrite the
+// byte under the cursor and move on. It returns false for keys that
+// should fall through to navigation.
+func (a *App) handleHexEdit(msg tea.KeyMsg) bool {
+	s := msg.String()
+	if v, err := strconv.ParseUint(s, 16, 8); err == nil && len(s) == 1 {
+		if a.hexNibble < 0 {
+			a.hexNibble = int(v)
+			return true
+		}
+		a.setByte(a.characters[a.cursor].ByteOffset, byte(a.hexNibble<<4)|byte(v))
+		a.hexNibble = -1
+		a.cursor = min(a.cursor+1, len(a.characters)-1)
+		return true
+	}
+
+	a.hexNibble = -1
+	switch s {
+	case "esc":
+		a.hexEdit = false
+		a.statusMsg = ""
+	case "u", "backspace":
+		a.undoByte()
+	default:
+		return false
+	}
+	return true
+}
+
+// setByte overwrites the byte at offset and updates its analysis.
+func (a *App) setByte(offset int, v byte) {
+	src := a.source
+	if src.original == nil {
+		src.original = slices.Clone(src.data)
+	}
+	src.edits = append(src.edits, byteEdit{offset, src.data[offset]})
+	src.data[offset] = v
+	a.refreshByte(offset)
+}
+
+// undoByte reverts the last byte edit.
+func (a *App) undoByte() {
+	src := a.source
+	if len(src.edits) == 0 {
+		a.statusMsg = i18n.T("No byte edits to undo")
+		return
+	}
+	e := src.edits[len(src.edits)-1]
+	src.edits = src.edits[:len(src.edits)-1]
+	src.data[e.offset] = e.old
+	a.refreshByte(e.offset)
+	if i := slices.IndexFunc(a.characters, func(c analysis.Character) bool { return c.ByteOffset == e.offset }); i >= 0 {
+		a.cursor = i
+	}
+	a.statusMsg = i18n.Tf("Restored byte %04X to %02X", e.offset, e.old)
+}
+
+// refreshByte analyzes the byte at offset again after an edit.
+func (a *App) refreshByte(offset int) {
+	c := a.analyzer.AnalyzeBytes(a.source.data[offset : offset+1])[0]
+	c.ByteOffset, c.RuneOffset = offset, offset
+	a.all[offset] = c
+	a.statsSummary = nil // Same analysis, changed in place
+	a.characters = a.filter.apply(a.all)
+	a.searchMatches = a.findMatches(a.searchQuery)
+}
+
+// saveSource writes an edited binary file back to where it was loaded
+// from.
+func (a *App) saveSource() {
+	src := a.source
+	switch {
+	case !a.binary():
+		a.statusMsg = i18n.T("Only byte edits of binary files can be saved")
+		return
+	case src.path == "":
+		a.statusMsg = i18n.T("Nothing to save to: the file was read from stdin")
+		return
+	case !src.unsaved():
+		a.statusMsg = i18n.T("No unsaved byte edits")
+		return
+	}
+
+	perm := os.FileMode(0o644)
+	if info, err := os.Stat(src.path); err == nil {
+		perm = info.Mode().Perm()
+	}
+	if err := os.WriteFile(src.path, src.data, perm); err != nil {
+		a.statusMsg = i18n.Tf("Save failed: %v", err)
+		return
+	}
+	src.original = slices.Clone(src.data)
+	src.edits = nil
+	a.statusMsg = i18n.Tf("Saved %d bytes to %s", len(src.data), src.path)
+}
+
+// unsavedFiles counts the sources in all tabs and panes with byte edits
+// not written back.
+func (a *App) unsavedFiles() int {
+	sources := []*source{a.source}
+	if a.split {
+		sources = append(sources, a.other.source)
+	}
+	for i, t := range a.tabs {
+		if i != a.activeTab {
+			sources = append(sources, t.source)
+		}
+	}
+
+	n := 0
+	for _, s := range sources {
+		if s != nil && s.unsaved() {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,97 @@
+package app
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/bubbles/textinput"
+
+	"stringinspect/internal/i18n"
+)
+
+// inputBox is the text input holding the text being analyzed. The text
+// input cannot hold everything a file can: it turns line breaks and tabs
+// into spaces, drops NUL, other control characters, and U+FFFD, and cuts
+// text off at its CharLimit. Text it would change is held here instead
+// and the input shows it with placeholders, read-only, until the text no
+// longer needs holding.
+type inputBox struct {
+	textinput.Model
+	held    string // The text, while the input cannot hold it
+	holding bool
+}
+
+// inputLimit is the length of text the input edits. Longer text, such as
+// a large file, is held, since analyzing it again on every key is slow.
+const inputLimit = 10000
+
+// fits reports whether the text input can hold s unchanged.
+func (b *inputBox) fits(s string) bool {
+	if b.CharLimit > 0 && utf8.RuneCountInString(s) > b.CharLimit {
+		return false
+	}
+	for _, r := range s {
+		if r == utf8.RuneError || unicode.IsControl(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// inputPlaceholder stands in for a character the text input cannot hold:
+// its Control Picture for C0 controls and DEL, a middle dot otherwise.
+// There is one per character, so positions in the input stay the same.
+func inputPlaceholder(r rune) rune {
+	switch {
+	case r < 0x20:
+		return 0x2400 + r
+	case r == 0x7F:
+		return '␡'
+	case r == utf8.RuneError || unicode.IsControl(r):
+		return '·'
+	}
+	return r
+}
+
+// Value returns the text, including what the text input cannot hold.
+func (b *inputBox) Value() string {
+	if b.holding {
+		return b.held
+	}
+	return b.Model.Value()
+}
+
+// SetValue sets the text, holding it when the text input would change it.
+func (b *inputBox) SetValue(s string) {
+	b.holding = !b.fits(s)
+	b.held = ""
+	if b.holding {
+		b.held = s
+		s = strings.Map(inputPlaceholder, s)
+	}
+	b.Model.SetValue(s)
+}
+
+// Insert inserts s at the cursor, holding the text if the input cannot.
+func (b *inputBox) Insert(s string) {
+	runes := []rune(b.Value())
+	pos := min(b.Position(), len(runes))
+	b.SetValue(string(runes[:pos]) + s + string(runes[pos:]))
+	b.SetCursor(pos + utf8.RuneCountInString(s))
+}
+
+// overLimit reports whether the text is held for being longer than the
+// input edits, so the input shows only its start.
+func (b *inputBox) overLimit() bool {
+	return b.holding && b.CharLimit > 0 && utf8.RuneCountInString(b.held) > b.CharLimit
+}
+
+// holdReason says why the text is held, for messages about the input
+// being read-only.
+func (b *inputBox) holdReason() string {
+	if b.overLimit() {
+		return i18n.Tf("is longer than the %d characters the input line edits", b.CharLimit)
+	}
+	return i18n.T("has control characters the input line cannot edit")
+}
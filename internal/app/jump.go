@@ -0,0 +1,71 @@
+package app
+
+import (
+	"stringinspect/internal/analysis"
+	"stringinspect/internal/i18n"
+)
+
+// jumpClass is a character class that can be jumped between with ]x / [x.
+type jumpClass struct {
+	name  string
+	match func(c analysis.Character) bool
+}
+
+// jumpClasses maps the key following "]" or "[" to a character class.
+var jumpClasses = map[string]jumpClass{
+	"c": {"control character", func(c analysis.Character) bool {
+		return c.IsControl()
+	}},
+	"w": {"unusual whitespace", func(c analysis.Character) bool {
+		return hasWarning(c, analysis.WarningUnusualWhitespace)
+	}},
+	"x": {"non-ASCII character", func(c analysis.Character) bool {
+		return c.Rune > 127
+	}},
+	"f": {"flagged character", func(c analysis.Character) bool {
+		return c.IsFlagged()
+	}},
+}
+
+// hasWarning reports whether the character has the given warning.
+func hasWarning(c analysis.Character, w analysis.Warning) bool {
+	for _, cw := range c.Warnings() {
+		if cw == w {
+			return true
+		}
+	}
+	return false
+}
+
+// jumpToClass moves the cursor to the next (forward) or previous character
+// matching the class selected by classKey.
+func (a *App) jumpToClass(classKey string, forward bool) {
+	class, ok := jumpClasses[classKey]
+	if !ok {
+		a.statusMsg = i18n.Tf("Unknown jump class %q (c, w, x, f)", classKey)
+		return
+	}
+
+	step, none := -1, "No previous %s"
+	if forward {
+		step, none = 1, "No next %s"
+	}
+
+	for i := a.cursor + step; i >= 0 && i < len(a.characters); i += step {
+		if class.match(a.characters[i]) {
+			a.cursor = i
+			a.statusMsg = i18n.Tf("%s at char %d", capitalize(i18n.T(class.name)), a.characters[i].RuneOffset)
+			return
+		}
+	}
+
+	a.statusMsg = i18n.Tf(none, i18n.T(class.name))
+}
+
+// capitalize upper-cases the first letter of an ASCII string.
+func capitalize(s string) string {
+	if s == "" || s[0] < 'a' || s[0] > 'z' {
+		return s
+	}
+	return string(s[0]-'a'+'A') + s[1:]
+}
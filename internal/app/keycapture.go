@@ -0,0 +1,313 @@
+package app
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"stringinspect/internal/analysis"
+	"stringinspect/internal/export"
+	"stringinspect/internal/i18n"
+)
+
+// InputTap is the terminal input with a copy kept of the bytes read from
+// it, so the key capture screen can show what the terminal sent. The file
+// is embedded so Bubble Tea still switches it to raw mode and waits on its
+// descriptor; only reads go through the tap.
+type InputTap struct {
+	*os.File
+	mu   sync.Mutex
+	data []byte
+}
+
+// maxTapped bounds the bytes kept between two keys, e.g. of a long paste.
+const maxTapped = 64 << 10
+
+// NewInputTap taps the terminal input f.
+func NewInputTap(f *os.File) *InputTap {
+	return &InputTap{File: f}
+}
+
+// Read reads from the terminal and keeps a copy of what was read.
+func (t *InputTap) Read(p []byte) (int, error) {
+	n, err := t.File.Read(p)
+	if n > 0 {
+		t.mu.Lock()
+		t.data = append(t.data, p[:n]...)
+		if over := len(t.data) - maxTapped; over > 0 {
+			t.data = t.data[over:]
+		}
+		t.mu.Unlock()
+	}
+	return n, err
+}
+
+// take returns the bytes read since the last call. Bytes are read before
+// Bubble Tea turns them into key messages, so when a key message arrives
+// its bytes are already here.
+func (t *InputTap) take() []byte {
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	data := t.data
+	t.data = nil
+	return data
+}
+
+// SetInputTap makes the key capture screen show the raw bytes read through
+// tap. Without one it can only show what Bubble Tea made of them.
+func (a *App) SetInputTap(tap *InputTap) {
+	a.inputTap = tap
+}
+
+// keyEvent is a key or paste seen by the key capture screen.
+type keyEvent struct {
+	name string // Bubble Tea's name for it, e.g. "ctrl+up"
+	data []byte // Bytes the terminal sent
+	raw  bool   // data was read from the terminal, not rebuilt from name
+}
+
+// maxKeyEvents is the number of events the key capture screen keeps.
+const maxKeyEvents = 100
+
+// openKeyCapture shows the key capture screen, recording.
+func (a *App) openKeyCapture() {
+	a.inputTap.take() // Drop the bytes of the key that opened it
+	a.keyEvents = nil
+	a.keyCursor = 0
+	a.keyRecording = true
+	a.showKeyCapture = true
+}
+
+// handleKeyCapture records every key while recording, which stops with
+// esc pressed twice in a row. Stopped, the events can be browsed and
+// opened in the byte analyzer.
+func (a *App) handleKeyCapture(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if a.keyRecording {
+		ev := keyEvent{name: msg.String(), data: a.inputTap.take(), raw: true}
+		if len(ev.data) == 0 && a.inputTap == nil {
+			ev.data, ev.raw = keyBytes(msg), false
+		}
+		n := len(a.keyEvents)
+		if msg.Type == tea.KeyEsc && !msg.Alt && n > 0 && a.keyEvents[n-1].name == "esc" {
+			a.keyEvents = a.keyEvents[:n-1]
+			a.keyRecording = false
+			a.keyCursor = max(len(a.keyEvents)-1, 0)
+			return a, nil
+		}
+		a.keyEvents = append(a.keyEvents, ev)
+		if len(a.keyEvents) > maxKeyEvents {
+			a.keyEvents = a.keyEvents[1:]
+		}
+		a.keyCursor = len(a.keyEvents) - 1
+		return a, nil
+	}
+
+	switch msg.String() {
+	case "esc", "q", "V":
+		a.showKeyCapture = false
+	case "r":
+		a.openKeyCapture()
+	case "up", "k":
+		a.keyCursor = max(a.keyCursor-1, 0)
+	case "down", "j":
+		a.keyCursor = max(min(a.keyCursor+1, len(a.keyEvents)-1), 0)
+	case "enter":
+		if a.keyCursor < len(a.keyEvents) && len(a.keyEvents[a.keyCursor].data) > 0 {
+			ev := a.keyEvents[a.keyCursor]
+			if a.analyzeBytesInTab(ev.name, ev.data) {
+				a.showKeyCapture = false
+				a.statusMsg = i18n.Tf("Bytes of %s • E to decode as text", ev.name)
+			}
+		}
+	}
+	return a, nil
+}
+
+// analyzeBytesInTab opens data in a new tab named name, analyzed byte by
+// byte. It returns false when tabs are locked.
+func (a *App) analyzeBytesInTab(name string, data []byte) bool {
+	n := len(a.tabs)
+	a.newTab()
+	if len(a.tabs) == n {
+		return false
+	}
+	a.LoadSource("-", data, analysis.Detection{Encoding: analysis.EncodingUTF8, Binary: name})
+	a.tabs[a.activeTab].name = name
+	return true
+}
+
+// keyBytes rebuilds the bytes of a key when they could not be read from
+// the terminal. Only text and control keys, whose key type is their byte,
+// can be rebuilt.
+func keyBytes(msg tea.KeyMsg) []byte {
+	var data []byte
+	switch {
+	case msg.Type == tea.KeyRunes:
+		data = []byte(string(msg.Runes))
+	case msg.Type >= 0 && msg.Type < 0x20, msg.Type == tea.KeyBackspace:
+		data = []byte{byte(msg.Type)}
+	default:
+		return nil
+	}
+	if msg.Alt {
+		data = append([]byte{0x1B}, data...)
+	}
+	return data
+}
+
+// escapeSequence spells out bytes the way they are written in terminal
+// documentation: ESC as \e, other control bytes in caret notation (^A),
+// printable text as itself, and bytes that are not UTF-8 as \xNN.
+func escapeSequence(data []byte) string {
+	var b strings.Builder
+	for len(data) > 0 {
+		r, size := utf8.DecodeRune(data)
+		switch {
+		case r == utf8.RuneError && size == 1:
+			fmt.Fprintf(&b, `\x%02X`, data[0])
+		case r == 0x1B:
+			b.WriteString(`\e`)
+		case r < 0x20:
+			b.WriteString("^" + string(r+0x40))
+		case r == 0x7F:
+			b.WriteString("^?")
+		case r >= 0x80 && r < 0xA0:
+			fmt.Fprintf(&b, `\u%04X`, r)
+		default:
+			b.WriteRune(r)
+		}
+		data = data[size:]
+	}
+	return b.String()
+}
+
+// keyModifiers names the modifiers encoded in the second parameter of a
+// CSI key sequence, which is 1 plus a bit each for shift, alt, ctrl, and
+// meta.
+func keyModifiers(param int) string {
+	var mods []string
+	for i, name := range []string{"shift", "alt", "ctrl", "meta"} {
+		if (param-1)&(1<<i) != 0 {
+			mods = append(mods, name)
+		}
+	}
+	return strings.Join(mods, "+")
+}
+
+// describeSequence says what kind of input the bytes are.
+func describeSequence(data []byte) string {
+	s := string(data)
+	switch {
+	case len(data) == 0:
+		return ""
+	case strings.HasPrefix(s, "\x1b[200~"):
+		return i18n.T("bracketed paste")
+	case strings.HasPrefix(s, "\x1b[") && len(s) > 2:
+		params := s[2 : len(s)-1]
+		desc := i18n.Tf("CSI sequence, final byte %q", s[len(s)-1:])
+		if params != "" {
+			desc += i18n.Tf(", parameters %s", params)
+		}
+		var key, mod int
+		if _, err := fmt.Sscanf(params, "%d;%d", &key, &mod); err == nil && mod > 1 {
+			desc += " (" + keyModifiers(mod) + ")"
+		}
+		return desc
+	case strings.HasPrefix(s, "\x1bO") && len(s) == 3:
+		return i18n.Tf("SS3 sequence, final byte %q", s[2:])
+	case s == "\x1b":
+		return i18n.T("escape")
+	case data[0] == 0x1B:
+		return i18n.T("escape prefix (alt) + ") + describeSequence(data[1:])
+	case len(data) == 1 && (data[0] < 0x20 || data[0] == 0x7F):
+		return i18n.T("control character")
+	case !utf8.Valid(data):
+		return i18n.T("not valid UTF-8")
+	case utf8.RuneCount(data) == 1:
+		return i18n.Tf("character U+%04X", []rune(s)[0])
+	default:
+		return i18n.Tf("%d characters", utf8.RuneCount(data))
+	}
+}
+
+// renderKeyCapture renders the captured keys, each with its bytes, and
+// the bytes of the selected one run through the byte analyzer.
+func (a *App) renderKeyCapture() string {
+	var b strings.Builder
+
+	b.WriteString(a.styles.Title.Render(i18n.T("Key Capture")))
+	b.WriteString("\n\n")
+	if a.keyRecording {
+		b.WriteString(a.styles.Success.Render(i18n.T("● Recording: press keys or paste text")))
+	} else {
+		b.WriteString(a.styles.Muted.Render(i18n.T("Stopped")))
+	}
+	if a.inputTap == nil {
+		b.WriteString(a.styles.Muted.Render(i18n.T(" • raw bytes unavailable, only text and control keys are rebuilt")))
+	}
+	b.WriteString("\n\n")
+
+	if len(a.keyEvents) == 0 {
+		b.WriteString(a.styles.Muted.Render(i18n.T("No keys yet")))
+		b.WriteString("\n")
+	}
+
+	width := max(a.width-70, 12)
+	rows := max(a.height-24, 5)
+	top := scrollTop(a.keyCursor, rows, len(a.keyEvents)-1)
+	for i := top; i < min(top+rows, len(a.keyEvents)); i++ {
+		ev := a.keyEvents[i]
+		hex := fmt.Sprintf("% X", ev.data)
+		switch {
+		case len(ev.data) == 0 && ev.raw:
+			hex = i18n.T("(read with the key above)")
+		case len(ev.data) == 0:
+			hex = i18n.T("(unknown)")
+		}
+		line := padCell(truncateWidth(ev.name, 14), 16) +
+			padCell(truncateWidth(hex, 24), 26) +
+			padCell(truncateWidth(escapeSequence(ev.data), 16), 18) +
+			padCell(truncateWidth(describeSequence(ev.data), width), width)
+		if i == a.keyCursor && !a.keyRecording {
+			b.WriteString(a.styles.Highlighted.Padding(0).Render(line))
+		} else {
+			b.WriteString(a.styles.Printable.Render(line))
+		}
+		b.WriteString("\n")
+	}
+
+	// Byte by byte analysis of the selected key
+	if a.keyCursor < len(a.keyEvents) && len(a.keyEvents[a.keyCursor].data) > 0 {
+		b.WriteString("\n")
+		var cells []string
+		for _, c := range a.analyzer.AnalyzeBytes(a.keyEvents[a.keyCursor].data) {
+			cells = append(cells, a.styles.Subtitle.Render(c.Hex)+" "+a.styles.Printable.Render(export.DumpGlyph(c)))
+			if len(cells) == 16 {
+				break
+			}
+		}
+		b.WriteString(strings.Join(cells, "  "))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	if a.keyRecording {
+		b.WriteString(a.styles.Muted.Render(i18n.T("esc twice stops recording")))
+	} else {
+		b.WriteString(a.styles.Muted.Render(i18n.T("↑/↓ select • enter analyze bytes in a new tab • r record again • esc close")))
+	}
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorPrimary).
+		Padding(1, 2).
+		Render(b.String())
+}
@@ -15,12 +15,74 @@ type KeyMap struct {
 	Escape   key.Binding
 	Search   key.Binding
 	Export   key.Binding
+	Import   key.Binding
+	Pin      key.Binding
 	Copy     key.Binding
+	CopyEsc  key.Binding
 	Paste    key.Binding
 	Home     key.Binding
 	End      key.Binding
 	PageUp   key.Binding
 	PageDown key.Binding
+	Goto     key.Binding
+	JumpNext key.Binding
+	JumpPrev key.Binding
+	Mark     key.Binding
+	JumpMark key.Binding
+
+	Filter      key.Binding
+	ScopeFilter key.Binding
+	ClearFilter key.Binding
+
+	NextMatch key.Binding
+	PrevMatch key.Binding
+	Replace   key.Binding
+
+	Delete      key.Binding
+	ReplaceChar key.Binding
+	Insert      key.Binding
+	Picker      key.Binding
+	Browser     key.Binding
+
+	Undo key.Binding
+	Redo key.Binding
+
+	Macro       key.Binding
+	ReplayMacro key.Binding
+
+	Split      key.Binding
+	SwitchPane key.Binding
+	SyncScroll key.Binding
+	BytePane   key.Binding
+
+	Orientation  key.Binding
+	Wrap         key.Binding
+	Columns      key.Binding
+	UniqueSort   key.Binding
+	DumpGroup    key.Binding
+	ByteOrder    key.Binding
+	Codepage     key.Binding
+	Audit        key.Binding
+	Bidi         key.Binding
+	LineBreaks   key.Binding
+	About        key.Binding
+	KeyCapture   key.Binding
+	ScrollLeft   key.Binding
+	ScrollRight  key.Binding
+	Stats        key.Binding
+	Tutorial     key.Binding
+	Placeholders key.Binding
+	Transforms   key.Binding
+	Tokens       key.Binding
+	Unescape     key.Binding
+	Encoding     key.Binding
+	OpenFile     key.Binding
+	Save         key.Binding
+	RecentFiles  key.Binding
+	NewTab       key.Binding
+	CloseTab     key.Binding
+	NextTab      key.Binding
+	PrevTab      key.Binding
 }
 
 // DefaultKeyMap returns the default key bindings.
@@ -70,10 +132,22 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("e"),
 			key.WithHelp("e", "export"),
 		),
+		Import: key.NewBinding(
+			key.WithKeys("o"),
+			key.WithHelp("o", "import JSON"),
+		),
+		Pin: key.NewBinding(
+			key.WithKeys("ctrl+p"),
+			key.WithHelp("ctrl+p", "pin history entry"),
+		),
 		Copy: key.NewBinding(
 			key.WithKeys("c"),
 			key.WithHelp("c", "copy"),
 		),
+		CopyEsc: key.NewBinding(
+			key.WithKeys("C"),
+			key.WithHelp("C", "copy escaped"),
+		),
 		Paste: key.NewBinding(
 			key.WithKeys("ctrl+v"),
 			key.WithHelp("ctrl+v", "paste"),
@@ -94,21 +168,239 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("pgdown", "ctrl+d"),
 			key.WithHelp("pgdn", "page down"),
 		),
+		Goto: key.NewBinding(
+			key.WithKeys(":"),
+			key.WithHelp(":", "go to offset"),
+		),
+		JumpNext: key.NewBinding(
+			key.WithKeys("]"),
+			key.WithHelp("]c/]w/]x/]f", "next control/whitespace/non-ASCII/flagged"),
+		),
+		JumpPrev: key.NewBinding(
+			key.WithKeys("["),
+			key.WithHelp("[c/[w/[x/[f", "previous"),
+		),
+		Mark: key.NewBinding(
+			key.WithKeys("m"),
+			key.WithHelp("m{a-z}", "set mark"),
+		),
+		JumpMark: key.NewBinding(
+			key.WithKeys("`"),
+			key.WithHelp("`{a-z}/``", "jump to mark / back"),
+		),
+		Filter: key.NewBinding(
+			key.WithKeys("f"),
+			key.WithHelp("f", "cycle filter"),
+		),
+		ScopeFilter: key.NewBinding(
+			key.WithKeys("s"),
+			key.WithHelp("s", "script/block filter"),
+		),
+		ClearFilter: key.NewBinding(
+			key.WithKeys("F"),
+			key.WithHelp("F", "clear filter"),
+		),
+		NextMatch: key.NewBinding(
+			key.WithKeys("n"),
+			key.WithHelp("n", "next match"),
+		),
+		PrevMatch: key.NewBinding(
+			key.WithKeys("N"),
+			key.WithHelp("N", "prev match"),
+		),
+		Replace: key.NewBinding(
+			key.WithKeys("R"),
+			key.WithHelp("R", "search & replace"),
+		),
+		Delete: key.NewBinding(
+			key.WithKeys("x"),
+			key.WithHelp("x", "delete char"),
+		),
+		ReplaceChar: key.NewBinding(
+			key.WithKeys("r"),
+			key.WithHelp("r", "replace char (hex edit in binary files)"),
+		),
+		Insert: key.NewBinding(
+			key.WithKeys("i"),
+			key.WithHelp("i", "insert before"),
+		),
+		Picker: key.NewBinding(
+			key.WithKeys("I"),
+			key.WithHelp("I", "insert by name"),
+		),
+		Browser: key.NewBinding(
+			key.WithKeys("ctrl+b"),
+			key.WithHelp("ctrl+b", "block browser"),
+		),
+		Undo: key.NewBinding(
+			key.WithKeys("u", "ctrl+z"),
+			key.WithHelp("u/ctrl+z", "undo"),
+		),
+		Redo: key.NewBinding(
+			key.WithKeys("ctrl+r"),
+			key.WithHelp("ctrl+r", "redo"),
+		),
+		Macro: key.NewBinding(
+			key.WithKeys("Q"),
+			key.WithHelp("Q{a-z}/Q", "record macro / stop"),
+		),
+		ReplayMacro: key.NewBinding(
+			key.WithKeys("@"),
+			key.WithHelp("[n]@{a-z}/@@", "replay macro n times / last"),
+		),
+		Split: key.NewBinding(
+			key.WithKeys("|"),
+			key.WithHelp("|", "split view"),
+		),
+		SwitchPane: key.NewBinding(
+			key.WithKeys("w"),
+			key.WithHelp("w", "switch pane"),
+		),
+		SyncScroll: key.NewBinding(
+			key.WithKeys("="),
+			key.WithHelp("=", "sync scroll"),
+		),
+		BytePane: key.NewBinding(
+			key.WithKeys("b"),
+			key.WithHelp("b", "rune/byte toggle"),
+		),
+		Orientation: key.NewBinding(
+			key.WithKeys("v"),
+			key.WithHelp("v", "vertical table"),
+		),
+		Wrap: key.NewBinding(
+			key.WithKeys("z"),
+			key.WithHelp("z", "wrap table into rows"),
+		),
+		Columns: key.NewBinding(
+			key.WithKeys("T"),
+			key.WithHelp("T", "table columns"),
+		),
+		UniqueSort: key.NewBinding(
+			key.WithKeys("S"),
+			key.WithHelp("S", "sort unique"),
+		),
+		DumpGroup: key.NewBinding(
+			key.WithKeys("W"),
+			key.WithHelp("W", "hex dump group size"),
+		),
+		ByteOrder: key.NewBinding(
+			key.WithKeys("B"),
+			key.WithHelp("B", "hex dump byte order"),
+		),
+		Codepage: key.NewBinding(
+			key.WithKeys("K"),
+			key.WithHelp("K", "interpret bytes as codepage"),
+		),
+		Audit: key.NewBinding(
+			key.WithKeys("A"),
+			key.WithHelp("A", "check ASCII/Latin-1/SMS compatibility"),
+		),
+		Bidi: key.NewBinding(
+			key.WithKeys("O"),
+			key.WithHelp("O", "bidi levels and display order"),
+		),
+		LineBreaks: key.NewBinding(
+			key.WithKeys("Y"),
+			key.WithHelp("Y", "line break opportunities"),
+		),
+		About: key.NewBinding(
+			key.WithKeys("U"),
+			key.WithHelp("U", "version and Unicode data sources"),
+		),
+		KeyCapture: key.NewBinding(
+			key.WithKeys("V"),
+			key.WithHelp("V", "show the bytes keys send (key capture)"),
+		),
+		ScrollLeft: key.NewBinding(
+			key.WithKeys("H", "shift+left"),
+			key.WithHelp("H", "scroll table left"),
+		),
+		ScrollRight: key.NewBinding(
+			key.WithKeys("L", "shift+right"),
+			key.WithHelp("L", "scroll table right"),
+		),
+		Stats: key.NewBinding(
+			key.WithKeys("#"),
+			key.WithHelp("#", "statistics & checksums"),
+		),
+		Tutorial: key.NewBinding(
+			key.WithKeys("t"),
+			key.WithHelp("t", "UTF-8 tutorial"),
+		),
+		Placeholders: key.NewBinding(
+			key.WithKeys("P"),
+			key.WithHelp("P", "control placeholders"),
+		),
+		Transforms: key.NewBinding(
+			key.WithKeys("!"),
+			key.WithHelp("!", "transform input"),
+		),
+		Tokens: key.NewBinding(
+			key.WithKeys("D"),
+			key.WithHelp("D", "decode tokens & encoded runs"),
+		),
+		Unescape: key.NewBinding(
+			key.WithKeys("\\"),
+			key.WithHelp("\\", "interpret backslash escapes"),
+		),
+		Encoding: key.NewBinding(
+			key.WithKeys("E"),
+			key.WithHelp("E", "switch file encoding"),
+		),
+		OpenFile: key.NewBinding(
+			key.WithKeys("ctrl+o"),
+			key.WithHelp("ctrl+o", "open file"),
+		),
+		Save: key.NewBinding(
+			key.WithKeys("ctrl+s"),
+			key.WithHelp("ctrl+s", "save byte edits"),
+		),
+		RecentFiles: key.NewBinding(
+			key.WithKeys("ctrl+g"),
+			key.WithHelp("ctrl+g", "recent files"),
+		),
+		NewTab: key.NewBinding(
+			key.WithKeys("ctrl+t"),
+			key.WithHelp("ctrl+t", "new tab"),
+		),
+		CloseTab: key.NewBinding(
+			key.WithKeys("ctrl+x"),
+			key.WithHelp("ctrl+x", "close tab"),
+		),
+		// "gt" and "gT" are handled as two-key sequences in navigation mode
+		NextTab: key.NewBinding(
+			key.WithKeys("ctrl+pgdown"),
+			key.WithHelp("gt/ctrl+pgdn", "next tab"),
+		),
+		PrevTab: key.NewBinding(
+			key.WithKeys("ctrl+pgup"),
+			key.WithHelp("gT/ctrl+pgup", "previous tab"),
+		),
 	}
 }
 
-// ShortHelp returns key bindings for the short help view.
-func (k KeyMap) ShortHelp() []key.Binding {
-	return []key.Binding{k.Help, k.Quit}
+// HelpGroup is a titled set of key bindings on the help screen.
+type HelpGroup struct {
+	Title string
+	Keys  []key.Binding
 }
 
-// FullHelp returns key bindings for the full help view.
-func (k KeyMap) FullHelp() [][]key.Binding {
-	return [][]key.Binding{
-		{k.Left, k.Right, k.Home, k.End},
-		{k.PageUp, k.PageDown},
-		{k.Tab, k.Enter, k.Escape},
-		{k.Copy, k.Paste, k.Export, k.Search},
-		{k.Help, k.Quit},
+// HelpGroups returns the key bindings grouped by the mode they apply in.
+func (k KeyMap) HelpGroups() []HelpGroup {
+	// Up/Down browse history while typing; in other modes they move
+	history := key.NewBinding(key.WithKeys("up", "down"), key.WithHelp("↑/↓", "browse history"))
+
+	return []HelpGroup{
+		{"Global", []key.Binding{k.Help, k.Quit, k.Tab, k.Browser}},
+		{"Input mode", []key.Binding{history, k.Pin, k.Paste, k.Undo, k.Redo}},
+		{"Navigation", []key.Binding{k.Left, k.Right, k.Home, k.End, k.PageUp, k.PageDown,
+			k.ScrollLeft, k.ScrollRight, k.Goto, k.JumpNext, k.JumpPrev, k.Mark, k.JumpMark, k.Enter, k.Escape}},
+		{"Search & filter", []key.Binding{k.Search, k.NextMatch, k.PrevMatch, k.Filter, k.ScopeFilter, k.ClearFilter}},
+		{"Editing", []key.Binding{k.Delete, k.ReplaceChar, k.Insert, k.Picker, k.Replace, k.Transforms, k.Unescape, k.Undo, k.Redo, k.Macro, k.ReplayMacro}},
+		{"Views", []key.Binding{k.Orientation, k.Wrap, k.Columns, k.BytePane, k.UniqueSort, k.DumpGroup, k.ByteOrder, k.Codepage, k.Audit, k.Bidi, k.LineBreaks, k.About, k.KeyCapture, k.Stats, k.Tutorial, k.Placeholders, k.Tokens}},
+		{"Split view", []key.Binding{k.Split, k.SwitchPane, k.SyncScroll}},
+		{"Tabs", []key.Binding{k.NewTab, k.CloseTab, k.NextTab, k.PrevTab}},
+		{"Clipboard & files", []key.Binding{k.Copy, k.CopyEsc, k.Export, k.Import, k.OpenFile, k.RecentFiles, k.Save, k.Encoding}},
 	}
 }
@@ -0,0 +1,26 @@
+package app
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"stringinspect/internal/analysis"
+)
+
+// glyph returns the display form of a character for a table cell.
+// Combining marks take no width of their own, so they are shown on a
+// dotted circle to keep cells aligned and the mark visible.
+func glyph(c analysis.Character) string {
+	if unicode.Is(unicode.M, c.Rune) {
+		return "◌" + c.Char
+	}
+	return c.Char
+}
+
+// padCell pads s with spaces to width terminal cells. Unlike %-*s this
+// counts display width, so wide CJK and emoji glyphs line up.
+func padCell(s string, width int) string {
+	return s + strings.Repeat(" ", max(width-lipgloss.Width(s), 0))
+}
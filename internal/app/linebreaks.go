@@ -0,0 +1,180 @@
+package app
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"stringinspect/internal/analysis"
+	"stringinspect/internal/export"
+	"stringinspect/internal/i18n"
+)
+
+// defaultBreakWidth is the line width the line break preview starts at.
+const defaultBreakWidth = 40
+
+// wrapLine is a line of the line break preview.
+type wrapLine struct {
+	start, end int  // Range of characters on the line
+	overflow   bool // A segment without break opportunities is wider than the line
+}
+
+// openLineBreaks shows where the visible text may wrap.
+func (a *App) openLineBreaks() {
+	if len(a.characters) == 0 {
+		a.statusMsg = i18n.T("Nothing to wrap")
+		return
+	}
+	a.lineBreaks = analysis.LineBreaks(a.visibleText())
+	if a.breakWidth == 0 {
+		a.breakWidth = min(defaultBreakWidth, a.maxBreakWidth())
+	}
+	a.breakWidth = min(a.breakWidth, a.maxBreakWidth())
+	a.breakTop = 0
+	a.showLineBreaks = true
+}
+
+// maxBreakWidth returns the widest line the preview has room for.
+func (a *App) maxBreakWidth() int {
+	return max(a.width-20, 4)
+}
+
+// handleLineBreaks handles keyboard input for the line break preview.
+func (a *App) handleLineBreaks(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q", "Y":
+		a.showLineBreaks = false
+	case "left", "h":
+		a.breakWidth = max(a.breakWidth-1, 4)
+	case "right", "l":
+		a.breakWidth = min(a.breakWidth+1, a.maxBreakWidth())
+	case "up", "k":
+		a.breakTop = max(a.breakTop-1, 0)
+	case "down", "j":
+		a.breakTop = min(a.breakTop+1, max(len(a.wrapLines())-1, 0))
+	}
+	return a, nil
+}
+
+// glyphWidth returns the columns a character takes in the preview.
+func glyphWidth(c analysis.Character) int {
+	return lipgloss.Width(export.DumpGlyph(c))
+}
+
+// wrapLines wraps the visible text at the preview width, breaking only
+// where the algorithm allows: each line takes segments until the next
+// one, less its trailing spaces, would not fit.
+func (a *App) wrapLines() []wrapLine {
+	var lines []wrapLine
+	line := wrapLine{}
+	used := 0
+	segStart := 0
+	for i := 0; i <= len(a.lineBreaks); i++ {
+		segEnd, mandatory := len(a.characters), false
+		if i < len(a.lineBreaks) {
+			segEnd, mandatory = a.lineBreaks[i].Offset, a.lineBreaks[i].Mandatory
+		}
+
+		width, trimmed := 0, 0
+		for j := segStart; j < segEnd; j++ {
+			width += glyphWidth(a.characters[j])
+			if a.characters[j].Rune != ' ' {
+				trimmed = width
+			}
+		}
+		if used > 0 && used+trimmed > a.breakWidth {
+			line.end = segStart
+			lines = append(lines, line)
+			line, used = wrapLine{start: segStart}, 0
+		}
+		if used+trimmed > a.breakWidth {
+			line.overflow = true
+		}
+		used += width
+
+		if mandatory {
+			line.end = segEnd
+			lines = append(lines, line)
+			line, used = wrapLine{start: segEnd}, 0
+		}
+		segStart = segEnd
+	}
+	if line.start < len(a.characters) {
+		line.end = len(a.characters)
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// renderLineBreaks renders the line break preview: the text wrapped at
+// the chosen width, with a mark under each character a line may start at.
+func (a *App) renderLineBreaks() string {
+	var b strings.Builder
+
+	required := 0
+	canBreak := make(map[int]bool)
+	for _, br := range a.lineBreaks {
+		if br.Mandatory {
+			required++
+		} else {
+			canBreak[br.Offset] = true
+		}
+	}
+	lines := a.wrapLines()
+
+	b.WriteString(a.styles.Title.Render(i18n.T("Line Breaks")))
+	b.WriteString("\n")
+	b.WriteString(a.styles.Muted.Render(i18n.Tf("%d break opportunities, %d required • wrapped at %d columns into %d lines",
+		len(a.lineBreaks)-required, required, a.breakWidth, len(lines))))
+	b.WriteString("\n\n")
+
+	rows := max((a.height-20)/2, 3)
+	top := min(a.breakTop, max(len(lines)-rows, 0))
+	for _, line := range lines[top:min(top+rows, len(lines))] {
+		var text, marks strings.Builder
+		col := 0
+		for j := line.start; j < line.end; j++ {
+			c := a.characters[j]
+			g, w := export.DumpGlyph(c), glyphWidth(c)
+			if col < a.breakWidth && col+w > a.breakWidth {
+				text.WriteString(a.styles.Muted.Render("┊"))
+				marks.WriteString(" ")
+			}
+			switch {
+			case col >= a.breakWidth && line.overflow && c.Rune != ' ':
+				text.WriteString(a.styles.Error.Render(g))
+			case c.Type == analysis.CharTypeControl:
+				text.WriteString(a.styles.Control.Render(g))
+			default:
+				text.WriteString(a.styles.Printable.Render(g))
+			}
+			mark := " "
+			if canBreak[j] && j > line.start {
+				mark = "╵"
+			}
+			marks.WriteString(padCell(mark, w))
+			col += w
+			if col == a.breakWidth {
+				text.WriteString(a.styles.Muted.Render("┊"))
+				marks.WriteString(" ")
+			}
+		}
+		if col < a.breakWidth {
+			text.WriteString(strings.Repeat(" ", a.breakWidth-col) + a.styles.Muted.Render("┊"))
+		}
+		b.WriteString(text.String() + "\n")
+		b.WriteString(a.styles.Success.Render(strings.TrimRight(marks.String(), " ")) + "\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(a.styles.Muted.Render(i18n.T("╵ a line may start here • ┊ line width • red runs past it, with nowhere to break")))
+	b.WriteString("\n")
+	b.WriteString(a.styles.Muted.Render(i18n.T("←/→ width • ↑/↓ scroll • esc close")))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorPrimary).
+		Padding(1, 2).
+		Render(b.String())
+}
@@ -0,0 +1,82 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"stringinspect/internal/analysis"
+	"stringinspect/internal/export"
+	"stringinspect/internal/i18n"
+)
+
+// reportLosses lists the characters a raw export could not represent in
+// its target encoding, if any, after the export's status message.
+func (a *App) reportLosses() {
+	_, a.losses = export.Transcode(a.visibleText(), a.exporter.Transcoding)
+	if len(a.losses) == 0 {
+		return
+	}
+	a.statusMsg += i18n.Tf(" • %d characters not in %s replaced", len(a.losses), a.exporter.Transcoding)
+	a.lossCursor = 0
+	a.showLosses = true
+}
+
+// handleLosses handles keyboard input for the transcoding loss report.
+func (a *App) handleLosses(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q":
+		a.showLosses = false
+	case "up", "k":
+		a.lossCursor = max(a.lossCursor-1, 0)
+	case "down", "j":
+		a.lossCursor = min(a.lossCursor+1, len(a.losses)-1)
+	case "enter":
+		// Positions index the exported characters, which are the visible ones
+		a.cursor = min(a.losses[a.lossCursor].Position, max(len(a.characters)-1, 0))
+		a.input.Blur()
+		a.showLosses = false
+	}
+	return a, nil
+}
+
+// renderLosses renders the transcoding loss report: each character the
+// target encoding lacks and what was written in its place.
+func (a *App) renderLosses() string {
+	var b strings.Builder
+
+	b.WriteString(a.styles.Title.Render(i18n.Tf("Not in %s (%d)", a.exporter.Transcoding, len(a.losses))))
+	b.WriteString("\n\n")
+
+	rows := max(a.height-16, 5)
+	top := scrollTop(a.lossCursor, rows, len(a.losses)-1)
+	for i := top; i < min(top+rows, len(a.losses)); i++ {
+		l := a.losses[i]
+		c := a.characters[l.Position]
+		name := truncateWidth(analysis.Name(l.Char), max(a.width-50, 12))
+		line := fmt.Sprintf("%6d  %s  U+%04X  %s", c.RuneOffset, padCell(export.DumpGlyph(c), 2), l.Char, padCell(name, max(a.width-50, 12)))
+		if i == a.lossCursor {
+			b.WriteString(a.styles.Highlighted.Padding(0).Render(line))
+		} else {
+			b.WriteString(a.styles.Printable.Render(line))
+		}
+		b.WriteString("  → ")
+		if l.Suggestion == "?" {
+			b.WriteString(a.styles.Error.Render("?"))
+		} else {
+			b.WriteString(a.styles.Success.Render(l.Suggestion))
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(a.styles.Muted.Render(i18n.T("→ is what was written instead • ↑/↓ select • enter jump to character • esc close")))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorPrimary).
+		Padding(1, 2).
+		Render(b.String())
+}
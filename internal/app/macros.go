@@ -0,0 +1,109 @@
+package app
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"stringinspect/internal/i18n"
+)
+
+// maxReplayKeys bounds the keys one replay sends, so a large count cannot
+// hang the TUI.
+const maxReplayKeys = 100_000
+
+// macroRegister returns the register a key names, a letter a-z, or 0.
+func macroRegister(key string) rune {
+	if len(key) != 1 || key[0] < 'a' || key[0] > 'z' {
+		return 0
+	}
+	return rune(key[0])
+}
+
+// startMacro begins recording the keys that follow into the register key
+// names, replacing what it held.
+func (a *App) startMacro(key string) {
+	reg := macroRegister(key)
+	if reg == 0 {
+		a.statusMsg = i18n.Tf("Macro registers are a-z, not %q", key)
+		return
+	}
+	a.macroReg = reg
+	a.macroKeys = nil
+	a.statusMsg = i18n.Tf("Recording @%c • Q stops", reg)
+}
+
+// recordMacroKey adds a key to the macro being recorded. Every key but
+// quit is kept, in any mode, so a macro can type into prompts and menus
+// too.
+func (a *App) recordMacroKey(msg tea.KeyMsg) {
+	if a.macroReg != 0 && !a.replaying {
+		a.macroKeys = append(a.macroKeys, msg)
+	}
+}
+
+// awaitingMacro reports whether the next key names a macro register,
+// which may be any letter, q included.
+func (a *App) awaitingMacro() bool {
+	return a.pendingKey == "Q" || a.pendingKey == "@"
+}
+
+// stopMacro ends the recording, leaving out the Q that stopped it.
+func (a *App) stopMacro() {
+	keys := a.macroKeys[:max(len(a.macroKeys)-1, 0)]
+	if a.macros == nil {
+		a.macros = make(map[rune][]tea.KeyMsg)
+	}
+	a.macros[a.macroReg] = keys
+	a.statusMsg = i18n.Tf("Recorded @%c: %d keys", a.macroReg, len(keys))
+	a.macroReg = 0
+	a.macroKeys = nil
+}
+
+// replayMacro sends the keys of the register key names count times, as
+// if they were typed. "@" replays the last macro replayed. Macros do not
+// replay other macros, which could recurse forever.
+func (a *App) replayMacro(key string, count int) tea.Cmd {
+	reg := macroRegister(key)
+	if key == "@" {
+		reg = a.lastMacro
+	}
+	keys, ok := a.macros[reg]
+	switch {
+	case key == "@" && reg == 0:
+		a.statusMsg = i18n.T("No macro replayed yet")
+		return nil
+	case reg == 0:
+		a.statusMsg = i18n.Tf("Macro registers are a-z, not %q", key)
+		return nil
+	case a.replaying:
+		a.statusMsg = i18n.T("A macro cannot replay a macro")
+		return nil
+	case reg == a.macroReg:
+		a.statusMsg = i18n.Tf("@%c is being recorded", reg)
+		return nil
+	case !ok:
+		a.statusMsg = i18n.Tf("Macro @%c is empty (Q%c records it)", reg, reg)
+		return nil
+	}
+
+	a.lastMacro = reg
+	a.replaying = true
+	defer func() { a.replaying = false }()
+
+	var cmds []tea.Cmd
+	sent, times := 0, 0
+	for ; times < count && sent+len(keys) <= maxReplayKeys; times++ {
+		for _, k := range keys {
+			_, cmd := a.handleKeyPress(k)
+			a.syncPanes()
+			cmds = append(cmds, cmd)
+		}
+		sent += len(keys)
+	}
+
+	if times < count {
+		a.statusMsg = i18n.Tf("Replayed @%c %d of %d times, stopped at %d keys", reg, times, count, maxReplayKeys)
+	} else {
+		a.statusMsg = i18n.Tf("Replayed @%c %d time(s)", reg, times)
+	}
+	return tea.Batch(cmds...)
+}
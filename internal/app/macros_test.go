@@ -0,0 +1,22 @@
+package app
+
+import "testing"
+
+func TestMacroQDoesNotQuit(t *testing.T) {
+	a := navigating("abcdef")
+	if cmd := press(a, "Q", "q"); cmd != nil {
+		t.Fatal("Qq returned a command, want none")
+	}
+	if a.macroReg != 'q' {
+		t.Fatalf("Qq recording into %q, want q", a.macroReg)
+	}
+	press(a, "x", "Q")
+	if got := len(a.macros['q']); got != 1 {
+		t.Fatalf("macro q has %d keys, want 1", got)
+	}
+
+	press(a, "2", "@", "q")
+	if got := a.input.Value(); got != "def" {
+		t.Errorf("after Qq x Q 2@q the input is %q, want %q", got, "def")
+	}
+}
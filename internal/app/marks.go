@@ -0,0 +1,78 @@
+package app
+
+import "stringinspect/internal/i18n"
+
+// lastJump is the mark "`" jumps back to: where the cursor was before the
+// last jump to a mark, goto, or the first or last character.
+const lastJump = '`'
+
+// setMark records the cursor's character under the mark named by key, a
+// letter a-z. Marks are rune offsets in the unfiltered input, so they
+// survive filtering and belong to the buffer they were set in.
+func (a *App) setMark(key string) {
+	if len(key) != 1 || key[0] < 'a' || key[0] > 'z' {
+		a.statusMsg = i18n.Tf("Marks are a-z, not %q", key)
+		return
+	}
+	if len(a.characters) == 0 {
+		a.statusMsg = i18n.T("Nothing to mark")
+		return
+	}
+	if a.marks == nil {
+		a.marks = make(map[rune]int)
+	}
+	offset := a.characters[a.cursor].RuneOffset
+	a.marks[rune(key[0])] = offset
+	a.statusMsg = i18n.Tf("Mark %s at char %d", key, offset)
+}
+
+// jumpToMark moves the cursor to the mark named by key: a letter, or "`"
+// for the position before the last jump. A mark hidden by the filter
+// takes the cursor to the next visible character.
+func (a *App) jumpToMark(key string) {
+	if len(key) != 1 {
+		a.statusMsg = i18n.Tf("Marks are a-z or `, not %q", key)
+		return
+	}
+	offset, ok := a.marks[rune(key[0])]
+	switch {
+	case !ok && key == "`":
+		a.statusMsg = i18n.T("No jump to go back from")
+		return
+	case !ok:
+		a.statusMsg = i18n.Tf("Mark %s not set (m%s sets it)", key, key)
+		return
+	case len(a.all) == 0 || offset > a.all[len(a.all)-1].RuneOffset:
+		a.statusMsg = i18n.Tf("Mark %s is past the end of the input", key)
+		return
+	}
+
+	a.recordJump()
+	a.cursor = a.indexForRuneOffset(offset)
+	if len(a.characters) > 0 && a.characters[a.cursor].RuneOffset != offset {
+		a.statusMsg = i18n.Tf("Mark %s is hidden by the filter; at the next character", key)
+		return
+	}
+	if key == "`" {
+		a.statusMsg = i18n.Tf("Back at char %d", offset)
+		return
+	}
+	a.statusMsg = i18n.Tf("Mark %s at char %d", key, offset)
+}
+
+// awaitingMark reports whether the next key names a mark, which may be
+// any letter, q included.
+func (a *App) awaitingMark() bool {
+	return a.pendingKey == "m" || a.pendingKey == "`"
+}
+
+// recordJump keeps the cursor's position as the "`" mark before a jump.
+func (a *App) recordJump() {
+	if len(a.characters) == 0 {
+		return
+	}
+	if a.marks == nil {
+		a.marks = make(map[rune]int)
+	}
+	a.marks[lastJump] = a.characters[a.cursor].RuneOffset
+}
@@ -0,0 +1,42 @@
+package app
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// press sends keys to a, each a rune key, and returns the last command.
+func press(a *App, keys ...string) tea.Cmd {
+	var cmd tea.Cmd
+	for _, k := range keys {
+		_, cmd = a.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)})
+	}
+	return cmd
+}
+
+// navigating returns an app showing text in navigation mode.
+func navigating(text string) *App {
+	a := NewWithContent(text)
+	a.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+	a.Update(tea.KeyMsg{Type: tea.KeyTab})
+	return a
+}
+
+func TestMarkQDoesNotQuit(t *testing.T) {
+	a := navigating("abc")
+	if cmd := press(a, "m", "q"); cmd != nil {
+		t.Fatal("mq returned a command, want none")
+	}
+	if _, ok := a.marks['q']; !ok {
+		t.Fatal("mq did not set mark q")
+	}
+
+	press(a, "l", "l")
+	if cmd := press(a, "`", "q"); cmd != nil {
+		t.Fatal("`q returned a command, want none")
+	}
+	if a.cursor != 0 {
+		t.Errorf("`q moved the cursor to %d, want 0", a.cursor)
+	}
+}
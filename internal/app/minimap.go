@@ -0,0 +1,97 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"stringinspect/internal/analysis"
+	"stringinspect/internal/i18n"
+)
+
+// Minimap cell kinds, from least to most important. A cell covering
+// several characters shows the most important one.
+const (
+	mapPlain = iota
+	mapExtended
+	mapControl
+	mapMatch
+	mapFlagged
+)
+
+// viewport returns the range of characters the current view shows, or
+// just the cursor when the view has no fixed window.
+func (a *App) viewport() (start, end int) {
+	switch {
+	case a.viewMode == ViewModeTable && a.tableVertical:
+		rows := a.verticalRows()
+		start = a.viewTop(a.cursor, rows, len(a.characters)-1)
+		return start, min(start+rows, len(a.characters))
+	case a.viewMode == ViewModeTable && a.tableWrap:
+		return a.wrapWindow()
+	case a.viewMode == ViewModeTable:
+		return a.tableWindow()
+	}
+	return a.cursor, a.cursor + 1
+}
+
+// minimapKind classifies a character for the minimap.
+func (a *App) minimapKind(i int, c analysis.Character) int {
+	switch {
+	case c.IsFlagged():
+		return mapFlagged
+	case a.isSearchMatch(i):
+		return mapMatch
+	case c.Type == analysis.CharTypeControl:
+		return mapControl
+	case c.Type == analysis.CharTypeExtended:
+		return mapExtended
+	}
+	return mapPlain
+}
+
+// renderMinimap renders the whole input as a one-line strip with ticks
+// for unusual characters, shading the visible range.
+func (a *App) renderMinimap() string {
+	n := len(a.characters)
+	start, end := a.viewport()
+
+	// Position, and page when the view shows a window of characters
+	label := fmt.Sprintf("  %d/%d", a.cursor+1, n)
+	if per := end - start; per > 1 && n > per {
+		label += " · " + i18n.Tf("page %d/%d", a.cursor/per+1, (n+per-1)/per)
+	}
+	width := min(max(a.width-12-len(label), 10), n)
+
+	ticks := map[int]lipgloss.Style{
+		mapPlain:    a.styles.Muted,
+		mapExtended: a.styles.Extended,
+		mapControl:  a.styles.Control,
+		mapMatch:    lipgloss.NewStyle().Foreground(ColorMatch),
+		mapFlagged:  a.styles.Error,
+	}
+	glyphs := map[int]string{mapPlain: "·", mapExtended: "▌", mapControl: "█", mapMatch: "█", mapFlagged: "▲"}
+
+	var b strings.Builder
+	for cell := 0; cell < width; cell++ {
+		// Characters covered by this cell
+		lo, hi := cell*n/width, (cell+1)*n/width
+		kind := mapPlain
+		for i := lo; i < hi; i++ {
+			kind = max(kind, a.minimapKind(i, a.characters[i]))
+		}
+
+		// The background marks the visible range and the cursor
+		style := ticks[kind]
+		switch {
+		case a.cursor >= lo && a.cursor < hi:
+			style = style.Background(ColorPrimary)
+		case hi > start && lo < end:
+			style = style.Background(ColorSubtle)
+		}
+		b.WriteString(style.Render(glyphs[kind]))
+	}
+
+	return b.String() + a.styles.Muted.Render(label)
+}
@@ -0,0 +1,30 @@
+package app
+
+import (
+	"github.com/charmbracelet/bubbles/cursor"
+	"github.com/charmbracelet/bubbles/textinput"
+)
+
+// setReducedMotion makes the cursors of every input, including those of
+// other tabs and the inactive pane, steady instead of blinking. Blink
+// messages then leave them alone, so nothing on screen moves by itself.
+func (a *App) setReducedMotion(on bool) {
+	mode := cursor.CursorBlink
+	if on {
+		mode = cursor.CursorStatic
+	}
+	for _, in := range []*textinput.Model{
+		&a.input.Model, &a.searchInput, &a.exportInput, &a.importInput, &a.gotoInput,
+		&a.scopeInput, &a.replaceFind, &a.replaceWith, &a.editInput, &a.pickerInput,
+	} {
+		in.Cursor.SetMode(mode)
+	}
+	for i, t := range a.tabs {
+		if i != a.activeTab {
+			t.input.Cursor.SetMode(mode)
+		}
+	}
+	if a.other != nil {
+		a.other.input.Cursor.SetMode(mode)
+	}
+}
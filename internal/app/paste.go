@@ -0,0 +1,168 @@
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"stringinspect/internal/i18n"
+)
+
+// The markers terminals put around pasted text in bracketed paste mode.
+const (
+	pasteStart = "\x1b[200~"
+	pasteEnd   = "\x1b[201~"
+)
+
+// pasteChange is a part of a paste that did not make it into the input
+// as pasted.
+type pasteChange struct {
+	offset int    // Byte offset in the pasted bytes
+	data   []byte // The bytes
+	what   string // What happened to them
+}
+
+// pasteReport compares what was pasted with what the input kept.
+type pasteReport struct {
+	data    []byte // The pasted bytes
+	raw     bool   // data was read from the terminal, not rebuilt
+	kept    int    // Characters the input kept
+	changes []pasteChange
+}
+
+// pastedBytes returns the bytes of the paste msg as the terminal sent
+// them, between the bracketed paste markers. Without them, e.g. when
+// bytes cannot be read from the terminal, the paste is rebuilt from what
+// Bubble Tea decoded, which has already lost invalid UTF-8.
+func (a *App) pastedBytes(msg tea.KeyMsg) ([]byte, bool) {
+	data := a.inputTap.take()
+	if start := bytes.LastIndex(data, []byte(pasteStart)); start >= 0 {
+		rest := data[start+len(pasteStart):]
+		if end := bytes.Index(rest, []byte(pasteEnd)); end >= 0 {
+			return rest[:end], true
+		}
+	}
+	return []byte(string(msg.Runes)), false
+}
+
+// insertedRunes returns what was inserted into before to give after: the
+// part of after between their common prefix and suffix.
+func insertedRunes(before, after string) []rune {
+	b, a := []rune(before), []rune(after)
+	pre := 0
+	for pre < len(b) && pre < len(a) && b[pre] == a[pre] {
+		pre++
+	}
+	suf := 0
+	for suf < len(b)-pre && suf < len(a)-pre && b[len(b)-1-suf] == a[len(a)-1-suf] {
+		suf++
+	}
+	return a[pre : len(a)-suf]
+}
+
+// comparePaste walks the pasted bytes alongside the characters the input
+// kept of them and lists what was lost or replaced. Bubble Tea drops
+// invalid UTF-8 and the text input turns tabs and line breaks into spaces,
+// drops other control characters and U+FFFD, and stops at its length limit.
+func comparePaste(data []byte, kept []rune) []pasteChange {
+	var changes []pasteChange
+	j := 0
+	for off := 0; off < len(data); {
+		r, size := utf8.DecodeRune(data[off:])
+		change := pasteChange{offset: off, data: data[off : off+size]}
+		switch {
+		case r == utf8.RuneError && size == 1:
+			change.what = "not UTF-8, dropped"
+		case j < len(kept) && kept[j] == r:
+			j++
+			change.what = ""
+		case (r == '\t' || r == '\n' || r == '\r') && j < len(kept) && kept[j] == ' ':
+			j++
+			change.what = "replaced by a space"
+		case r == utf8.RuneError || unicode.IsControl(r):
+			change.what = "dropped"
+		default:
+			change.what = "cut off at the input limit"
+		}
+		if change.what != "" {
+			changes = append(changes, change)
+		}
+		off += size
+	}
+	return changes
+}
+
+// checkPaste compares a paste with what the input made of it, given the
+// input before, and shows the differences if there are any.
+func (a *App) checkPaste(msg tea.KeyMsg, before string) {
+	data, raw := a.pastedBytes(msg)
+	kept := insertedRunes(before, a.input.Value())
+	a.paste = &pasteReport{data: data, raw: raw, kept: len(kept), changes: comparePaste(data, kept)}
+	if len(a.paste.changes) == 0 {
+		return
+	}
+	a.statusMsg = i18n.Tf("Paste of %d bytes: %d changed on the way in", len(data), len(a.paste.changes))
+	a.pasteCursor = 0
+	a.showPaste = true
+}
+
+// handlePaste handles keyboard input for the paste report.
+func (a *App) handlePaste(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q":
+		a.showPaste = false
+	case "up", "k":
+		a.pasteCursor = max(a.pasteCursor-1, 0)
+	case "down", "j":
+		a.pasteCursor = min(a.pasteCursor+1, len(a.paste.changes)-1)
+	case "enter":
+		if a.analyzeBytesInTab("paste", a.paste.data) {
+			a.showPaste = false
+			a.statusMsg = i18n.T("Pasted bytes • E to decode as text")
+		}
+	}
+	return a, nil
+}
+
+// renderPaste renders the paste report: the parts of the paste that were
+// dropped or replaced, by offset into the pasted bytes.
+func (a *App) renderPaste() string {
+	var b strings.Builder
+	p := a.paste
+
+	b.WriteString(a.styles.Title.Render(i18n.T("Paste Report")))
+	b.WriteString("\n\n")
+	source := i18n.T("as sent by the terminal")
+	if !p.raw {
+		source = i18n.T("as decoded, invalid UTF-8 already gone")
+	}
+	b.WriteString(a.styles.Subtitle.Render(i18n.Tf("Pasted %d bytes (%s), input kept %d characters", len(p.data), source, p.kept)))
+	b.WriteString("\n\n")
+
+	rows := max(a.height-18, 5)
+	top := scrollTop(a.pasteCursor, rows, len(p.changes)-1)
+	for i := top; i < min(top+rows, len(p.changes)); i++ {
+		c := p.changes[i]
+		line := fmt.Sprintf("%6d  %s  %s", c.offset, padCell(fmt.Sprintf("% X", c.data), 12), padCell(escapeSequence(c.data), 8))
+		if i == a.pasteCursor {
+			b.WriteString(a.styles.Highlighted.Padding(0).Render(line))
+		} else {
+			b.WriteString(a.styles.Printable.Render(line))
+		}
+		b.WriteString("  " + a.styles.Error.Render(i18n.T(c.what)) + "\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(a.styles.Muted.Render(i18n.T("↑/↓ select • enter analyze the pasted bytes in a new tab • esc close")))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorPrimary).
+		Padding(1, 2).
+		Render(b.String())
+}
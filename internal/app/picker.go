@@ -0,0 +1,139 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"stringinspect/internal/analysis"
+	"stringinspect/internal/i18n"
+)
+
+// pickerLimit is the number of candidates shown in the character picker.
+const pickerLimit = 10
+
+// openPicker shows the insert-by-codepoint-or-name picker.
+func (a *App) openPicker() {
+	a.showPicker = true
+	a.pickerInput.SetValue("")
+	a.pickerInput.Focus()
+	a.pickerResults = nil
+	a.pickerCursor = 0
+}
+
+// handlePicker handles keyboard input for the character picker.
+func (a *App) handlePicker(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		a.showPicker = false
+		a.pickerInput.Blur()
+		return a, nil
+
+	case tea.KeyUp:
+		if a.pickerCursor > 0 {
+			a.pickerCursor--
+		}
+		return a, nil
+
+	case tea.KeyDown:
+		if a.pickerCursor < len(a.pickerResults)-1 {
+			a.pickerCursor++
+		}
+		return a, nil
+
+	case tea.KeyEnter:
+		if len(a.pickerResults) == 0 {
+			return a, nil
+		}
+		r := a.pickerResults[a.pickerCursor]
+		a.showPicker = false
+		a.pickerInput.Blur()
+		a.insertRune(r)
+		return a, nil
+	}
+
+	var cmd tea.Cmd
+	a.pickerInput, cmd = a.pickerInput.Update(msg)
+	a.updatePickerResults()
+	return a, cmd
+}
+
+// updatePickerResults refreshes candidates: a codepoint query (U+00A0,
+// 0xA0) yields that character, anything else searches Unicode names.
+func (a *App) updatePickerResults() {
+	query := strings.TrimSpace(a.pickerInput.Value())
+	a.pickerCursor = 0
+
+	upper := strings.ToUpper(query)
+	if strings.HasPrefix(upper, "U+") || strings.HasPrefix(upper, "0X") {
+		text, err := parseCharInput(query)
+		if err != nil {
+			text = ""
+		}
+		a.pickerResults = []rune(text)
+		return
+	}
+	a.pickerResults = analysis.SearchNames(query, pickerLimit)
+}
+
+// insertRune inserts r at the text cursor in input mode, otherwise before
+// the selected character, or appends it when nothing is visible to anchor on.
+func (a *App) insertRune(r rune) {
+	if a.binaryLocked() {
+		return
+	}
+	if a.input.Focused() {
+		pos := a.input.Position()
+		runes := []rune(a.input.Value())
+		a.input.SetValue(string(runes[:pos]) + string(r) + string(runes[pos:]))
+		a.input.SetCursor(pos + 1)
+		a.analyzeInput()
+	} else if a.cursor < len(a.characters) {
+		a.editAtCursor(0, string(r))
+	} else {
+		a.input.SetValue(a.input.Value() + string(r))
+		a.analyzeInput()
+	}
+	a.statusMsg = i18n.Tf("Inserted U+%04X %s", r, analysis.Name(r))
+}
+
+// renderPicker renders the character picker.
+func (a *App) renderPicker() string {
+	var b strings.Builder
+
+	b.WriteString(a.styles.Title.Render(i18n.T("Insert Character")))
+	b.WriteString("\n\n")
+	b.WriteString(a.pickerInput.View())
+	b.WriteString("\n\n")
+
+	if len(a.pickerResults) == 0 {
+		if a.pickerInput.Value() == "" {
+			b.WriteString(a.styles.Muted.Render(i18n.T("Type a codepoint (U+00A0) or name (NO-BREAK SPACE)")))
+		} else {
+			b.WriteString(a.styles.Error.Render(i18n.T("No matching characters")))
+		}
+		b.WriteString("\n")
+	}
+
+	for i, r := range a.pickerResults {
+		char := analysis.Analyze(string(r))[0]
+		line := fmt.Sprintf("%-8s %-4s %s", char.Unicode, char.Char, analysis.Name(r))
+		if i == a.pickerCursor {
+			b.WriteString(a.styles.Highlighted.Padding(0).Render(line))
+		} else {
+			b.WriteString(a.styles.CharStyle(int(char.Type)).Render(line))
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(a.styles.Muted.Render(i18n.T("↑/↓ select • enter insert before cursor • esc cancel")))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorPrimary).
+		Padding(1, 2).
+		Render(b.String())
+}
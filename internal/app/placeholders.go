@@ -0,0 +1,27 @@
+package app
+
+import "stringinspect/internal/i18n"
+
+// cyclePlaceholders switches to the next placeholder style for
+// non-printable characters and saves it as a preference.
+func (a *App) cyclePlaceholders() {
+	a.analyzer.Placeholders = a.analyzer.Placeholders.Next()
+	a.config.Placeholders = a.analyzer.Placeholders.String()
+	a.reanalyze()
+
+	a.statusMsg = i18n.Tf("Placeholders: %s", a.analyzer.Placeholders)
+	if err := a.saveConfig(); err != nil {
+		a.statusMsg += i18n.Tf(" (save failed: %v)", err)
+	}
+}
+
+// reanalyze refreshes the analysis of both panes after a display option
+// changed, keeping cursors in place.
+func (a *App) reanalyze() {
+	a.analyzeInput()
+	if a.split {
+		a.swapPane()
+		a.analyzeInput()
+		a.swapPane()
+	}
+}
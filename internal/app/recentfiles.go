@@ -0,0 +1,146 @@
+package app
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"stringinspect/internal/i18n"
+	"stringinspect/internal/recent"
+)
+
+// SetRecent sets the recently opened files list and where to save it
+// ("" to not save).
+func (a *App) SetRecent(list *recent.List, path string) {
+	a.recentFiles = list
+	a.recentPath = path
+}
+
+// rememberFile adds path to the recently opened files.
+func (a *App) rememberFile(path string) {
+	if a.recentFiles == nil {
+		return
+	}
+	a.recentFiles.Add(path, time.Now())
+	a.saveRecent()
+}
+
+// saveRecent writes the recent files list, reporting failures in the
+// status bar.
+func (a *App) saveRecent() {
+	if a.recentPath == "" {
+		return
+	}
+	if err := a.recentFiles.Save(a.recentPath); err != nil {
+		a.statusMsg = i18n.Tf("Saving recent files failed: %v", err)
+	}
+}
+
+// openRecent shows the recent files overlay.
+func (a *App) openRecent() {
+	if a.recentFiles == nil || len(a.recentFiles.Files) == 0 {
+		a.statusMsg = i18n.T("No recent files (ctrl+o to open one)")
+		return
+	}
+	a.recentCursor = 0
+	a.showRecent = true
+}
+
+// handleRecent handles keyboard input for the recent files overlay.
+func (a *App) handleRecent(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	files := a.recentFiles.Files
+	switch s := msg.String(); s {
+	case "esc", "q":
+		a.showRecent = false
+	case "up", "k":
+		a.recentCursor = max(a.recentCursor-1, 0)
+	case "down", "j":
+		a.recentCursor = min(a.recentCursor+1, len(files)-1)
+	case "x", "delete":
+		// Forget the selected file
+		a.recentFiles.Remove(files[a.recentCursor].Path)
+		a.saveRecent()
+		if len(a.recentFiles.Files) == 0 {
+			a.showRecent = false
+		}
+		a.recentCursor = min(a.recentCursor, max(len(a.recentFiles.Files)-1, 0))
+	case "enter", "1", "2", "3", "4", "5", "6", "7", "8", "9":
+		i := a.recentCursor
+		if s != "enter" {
+			i = int(s[0] - '1')
+		}
+		if i >= len(files) {
+			return a, nil
+		}
+		a.showRecent = false
+		if a.split {
+			a.statusMsg = i18n.T("Close the split view (|) to open files")
+			return a, nil
+		}
+		a.openFile(files[i].Path)
+	}
+	return a, nil
+}
+
+// homeRelative abbreviates paths under the home directory with "~".
+func homeRelative(path string) string {
+	home, err := os.UserHomeDir()
+	if err != nil || home == "" {
+		return path
+	}
+	if rel, err := filepath.Rel(home, path); err == nil && !strings.HasPrefix(rel, "..") {
+		return filepath.Join("~", rel)
+	}
+	return path
+}
+
+// renderRecent renders the recent files overlay.
+func (a *App) renderRecent() string {
+	var b strings.Builder
+
+	b.WriteString(a.styles.Title.Render(i18n.T("Recent Files")))
+	b.WriteString("\n\n")
+
+	rows := max(4, a.height-16)
+	top := scrollTop(a.recentCursor, rows, len(a.recentFiles.Files)-1)
+	for i, e := range a.recentFiles.Files {
+		if i < top || i >= top+rows {
+			continue
+		}
+		hotkey := " "
+		if i < 9 {
+			hotkey = fmt.Sprint(i + 1)
+		}
+		width := max(a.width-44, 20)
+		path := padCell(truncateWidth(homeRelative(e.Path), width), width)
+		line := fmt.Sprintf("%s  %s  %s", hotkey, path, e.Opened.Local().Format("2006-01-02 15:04"))
+		_, err := os.Stat(e.Path)
+		if err != nil {
+			line += i18n.T(" (missing)")
+		}
+
+		switch {
+		case i == a.recentCursor:
+			b.WriteString(a.styles.Highlighted.Padding(0).Render(line))
+		case err != nil:
+			b.WriteString(a.styles.Muted.Render(line))
+		default:
+			b.WriteString(line)
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(a.styles.Muted.Render(i18n.T("↑/↓ select • 1-9/enter open • x forget • esc close")))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorPrimary).
+		Padding(1, 2).
+		Render(b.String())
+}
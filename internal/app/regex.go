@@ -0,0 +1,37 @@
+package app
+
+import (
+	"strings"
+	"unicode"
+
+	"stringinspect/internal/analysis"
+	"stringinspect/internal/i18n"
+)
+
+// renderRegexClasses renders which common classes of Go's regexp syntax
+// the character matches, noting for non-ASCII characters that the Perl
+// and POSIX classes leave them out.
+func (a *App) renderRegexClasses(char analysis.Character) string {
+	var b strings.Builder
+	b.WriteString(a.styles.Subtitle.Render(i18n.T("Regexp Classes (Go)")))
+	b.WriteString("\n")
+
+	var matches, misses []string
+	for _, c := range analysis.RegexClasses(char.Rune) {
+		if c.Matches {
+			matches = append(matches, a.styles.Success.Render(c.Pattern))
+			continue
+		}
+		misses = append(misses, a.styles.Muted.Render(c.Pattern))
+	}
+	if len(matches) == 0 {
+		matches = append(matches, a.styles.Muted.Render(i18n.T("none")))
+	}
+	b.WriteString(a.styles.Muted.Width(14).Render(i18n.T("Matches:")) + " " + strings.Join(matches, " ") + "\n")
+	b.WriteString(a.styles.Muted.Width(14).Render(i18n.T("No match:")) + " " + strings.Join(misses, " ") + "\n")
+	if char.Rune > unicode.MaxASCII {
+		b.WriteString(a.styles.Muted.Render(`\w, \d, \s, and [[:…:]] only match ASCII in Go; \p{…} classes cover all of Unicode`))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
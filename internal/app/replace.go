@@ -0,0 +1,250 @@
+package app
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"stringinspect/internal/export"
+	"stringinspect/internal/i18n"
+)
+
+// replaceState tracks an in-progress search and replace. Literal patterns
+// are compiled with regexp.QuoteMeta so both modes share one code path.
+type replaceState struct {
+	re       *regexp.Regexp
+	regex    bool   // Expand $1-style references in the replacement
+	with     string // Unescaped replacement text
+	pos      int    // Byte offset to resume searching from
+	match    []int  // Submatch indices of the current match (absolute)
+	replaced int    // Number of replacements made
+}
+
+// handleReplacePrompt handles keyboard input for the replace prompt.
+func (a *App) handleReplacePrompt(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		a.closeReplacePrompt()
+		return a, nil
+
+	case "tab", "shift+tab":
+		// Switch between the find and replace fields
+		if a.replaceFind.Focused() {
+			a.replaceFind.Blur()
+			a.replaceWith.Focus()
+		} else {
+			a.replaceWith.Blur()
+			a.replaceFind.Focus()
+		}
+		return a, nil
+
+	case "ctrl+r":
+		a.replaceRegex = !a.replaceRegex
+		return a, nil
+
+	case "enter":
+		state, err := a.newReplaceState()
+		a.closeReplacePrompt()
+		if err != nil {
+			a.statusMsg = i18n.Tf("Replace failed: %v", err)
+			return a, nil
+		}
+		a.replacing = state
+		a.undoStack.Break() // Each replace session is one undo step
+		a.input.Blur()
+		if !a.nextReplaceMatch() {
+			a.replacing = nil
+			a.statusMsg = i18n.T("No matches")
+		}
+		return a, nil
+	}
+
+	var cmd tea.Cmd
+	if a.replaceFind.Focused() {
+		a.replaceFind, cmd = a.replaceFind.Update(msg)
+	} else {
+		a.replaceWith, cmd = a.replaceWith.Update(msg)
+	}
+	return a, cmd
+}
+
+// closeReplacePrompt hides the replace prompt.
+func (a *App) closeReplacePrompt() {
+	a.showReplace = false
+	a.replaceFind.Blur()
+	a.replaceWith.Blur()
+}
+
+// newReplaceState compiles the prompt fields. Literal patterns and all
+// replacements accept \u escapes; regex patterns use Go syntax (\x{200B}).
+func (a *App) newReplaceState() (*replaceState, error) {
+	find := a.replaceFind.Value()
+	if find == "" {
+		return nil, fmt.Errorf("empty pattern")
+	}
+
+	with, err := export.Unescape(a.replaceWith.Value())
+	if err != nil {
+		return nil, fmt.Errorf("replacement: %w", err)
+	}
+
+	if !a.replaceRegex {
+		literal, err := export.Unescape(find)
+		if err != nil {
+			return nil, fmt.Errorf("pattern: %w", err)
+		}
+		find = regexp.QuoteMeta(literal)
+	}
+
+	re, err := regexp.Compile(find)
+	if err != nil {
+		return nil, err
+	}
+
+	return &replaceState{re: re, regex: a.replaceRegex, with: with}, nil
+}
+
+// nextReplaceMatch finds the next non-empty match at or after the resume
+// position and moves the cursor to it. It returns false when none is left.
+func (a *App) nextReplaceMatch() bool {
+	st := a.replacing
+	input := a.input.Value()
+
+	for st.pos <= len(input) {
+		loc := st.re.FindStringSubmatchIndex(input[st.pos:])
+		if loc == nil {
+			return false
+		}
+		for i := range loc {
+			if loc[i] >= 0 {
+				loc[i] += st.pos
+			}
+		}
+
+		// Skip empty matches, which would never make progress
+		if loc[0] == loc[1] {
+			if loc[0] >= len(input) {
+				return false
+			}
+			_, size := utf8.DecodeRuneInString(input[loc[0]:])
+			st.pos = loc[0] + size
+			continue
+		}
+
+		st.match = loc
+		a.cursor = a.indexForRuneOffset(utf8.RuneCountInString(input[:loc[0]]))
+		return true
+	}
+	return false
+}
+
+// replaceCurrent replaces the current match and advances past it.
+func (a *App) replaceCurrent() {
+	st := a.replacing
+	input := a.input.Value()
+
+	with := st.with
+	if st.regex {
+		with = string(st.re.ExpandString(nil, st.with, input, st.match))
+	}
+
+	a.input.SetValue(input[:st.match[0]] + with + input[st.match[1]:])
+	a.undoGroup = undoGroupReplace
+	a.analyzeInput()
+
+	st.pos = min(st.match[0]+len(with), len(a.input.Value()))
+	st.replaced++
+}
+
+// handleReplaceConfirm handles the per-match y/n/a/q confirmation.
+func (a *App) handleReplaceConfirm(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	st := a.replacing
+
+	switch msg.String() {
+	case "y":
+		a.replaceCurrent()
+	case "n":
+		st.pos = st.match[1]
+	case "a":
+		a.replaceCurrent()
+		for a.nextReplaceMatch() {
+			a.replaceCurrent()
+		}
+	case "q", "esc":
+		a.finishReplace()
+		return a, nil
+	default:
+		return a, nil
+	}
+
+	if !a.nextReplaceMatch() {
+		a.finishReplace()
+	}
+	return a, nil
+}
+
+// finishReplace ends the replace session and reports the result.
+func (a *App) finishReplace() {
+	n := a.replacing.replaced
+	a.replacing = nil
+	if n == 1 {
+		a.statusMsg = i18n.T("Replaced 1 occurrence")
+	} else {
+		a.statusMsg = i18n.Tf("Replaced %d occurrences", n)
+	}
+}
+
+// renderReplacePrompt renders the find/replace prompt.
+func (a *App) renderReplacePrompt() string {
+	var b strings.Builder
+
+	b.WriteString(a.styles.Title.Render(i18n.T("Search & Replace")))
+	b.WriteString("\n\n")
+	b.WriteString(a.replaceFind.View())
+	b.WriteString("\n")
+	b.WriteString(a.replaceWith.View())
+	b.WriteString("\n\n")
+
+	mode := i18n.T("literal (\\u escapes allowed)")
+	if a.replaceRegex {
+		mode = i18n.T("regex (Go syntax, $1 in replacement)")
+	}
+	b.WriteString(a.styles.Muted.Render(i18n.Tf("Mode: %s", mode)))
+	b.WriteString("\n\n")
+	b.WriteString(a.styles.Muted.Render(i18n.T("enter start • tab switch field • ctrl+r toggle regex • esc cancel")))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorPrimary).
+		Padding(1, 2).
+		Render(b.String())
+}
+
+// renderReplaceConfirm renders the confirmation for the current match.
+func (a *App) renderReplaceConfirm() string {
+	st := a.replacing
+	input := a.input.Value()
+
+	with := st.with
+	if st.regex {
+		with = string(st.re.ExpandString(nil, st.with, input, st.match))
+	}
+
+	var b strings.Builder
+	b.WriteString(a.styles.Title.Render(i18n.T("Replace?")))
+	b.WriteString("\n\n")
+	b.WriteString(i18n.Tf("%q → %q at char %d",
+		input[st.match[0]:st.match[1]], with, utf8.RuneCountInString(input[:st.match[0]])))
+	b.WriteString("\n\n")
+	b.WriteString(a.styles.Muted.Render(i18n.T("y replace • n skip • a replace all • q stop")))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorWarning).
+		Padding(1, 2).
+		Render(b.String())
+}
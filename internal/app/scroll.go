@@ -0,0 +1,45 @@
+package app
+
+// viewTop is scrollTop for the character views, which follow the scroll
+// settings: the cursor row is kept scroll_margin rows from the edge of the
+// window, or in its middle with center_cursor.
+func (a *App) viewTop(cursorRow, rows, lastRow int) int {
+	top := scrollTop(cursorRow, rows, lastRow)
+	switch {
+	case a.config.CenterCursor:
+		top = cursorRow - rows/2
+	case a.config.ScrollMargin > 0:
+		top = cursorRow - rows + 1 + a.scrollMargin(rows)
+	}
+	return max(0, min(top, lastRow-rows+1))
+}
+
+// scrollMargin returns the scroll_margin for a window of n rows or
+// characters: at most half the window, and 0 for a negative setting.
+func (a *App) scrollMargin(n int) int {
+	return max(0, min(a.config.ScrollMargin, (n-1)/2))
+}
+
+// pageSize returns how many characters PageUp and PageDown move the
+// cursor: the configured page_size, or screen, what the view shows at once.
+func (a *App) pageSize(screen int) int {
+	if n := a.config.PageSize; n > 0 {
+		return n
+	}
+	return max(screen, 1)
+}
+
+// screenChars returns how many characters the current view shows at once,
+// for paging.
+func (a *App) screenChars() int {
+	rows := max(4, a.height-20)
+	switch a.viewMode {
+	case ViewModeCompact:
+		return a.compactLayout().PerLine * rows
+	case ViewModeBytes:
+		return runesPerRow * rows
+	case ViewModeBits:
+		return rows
+	}
+	return a.tableChars()
+}
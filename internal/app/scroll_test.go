@@ -0,0 +1,17 @@
+package app
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNegativeScrollMargin(t *testing.T) {
+	a := navigating(strings.Repeat("x", 200))
+	a.config.ScrollMargin = -3
+	for range 100 {
+		press(a, "l")
+		if start, end := a.tableWindow(); a.cursor < start || a.cursor >= end {
+			t.Fatalf("cursor %d outside the table window %d-%d", a.cursor, start, end)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"stringinspect/internal/analysis"
+	"stringinspect/internal/i18n"
+	"stringinspect/internal/session"
+)
+
+// autosaveInterval is how often the session is saved while it changes.
+const autosaveInterval = 30 * time.Second
+
+// autosaveMsg asks for the session to be saved.
+type autosaveMsg struct{}
+
+// SetSessionPath sets the file the session is autosaved to ("" to not
+// save it).
+func (a *App) SetSessionPath(path string) {
+	a.sessionPath = path
+}
+
+// OfferRestore asks, once the App starts, whether to restore a session
+// left at path by an instance that did not exit normally.
+func (a *App) OfferRestore(s *session.Session, path string) {
+	a.restoreOffer = s
+	a.restorePath = path
+}
+
+// autosaveTick schedules the next autosave.
+func (a *App) autosaveTick() tea.Cmd {
+	if a.sessionPath == "" {
+		return nil
+	}
+	return tea.Tick(autosaveInterval, func(time.Time) tea.Msg { return autosaveMsg{} })
+}
+
+// autosave saves the session if it changed since the last save.
+func (a *App) autosave() {
+	s := a.snapshot()
+	state, err := json.Marshal(struct {
+		Active int
+		Tabs   []session.Tab
+	}{s.Active, s.Tabs})
+	if err != nil || bytes.Equal(state, a.sessionState) {
+		return
+	}
+	if err := s.Save(a.sessionPath); err != nil {
+		a.statusMsg = i18n.Tf("Autosave failed: %v", err)
+		return
+	}
+	a.sessionState = state
+}
+
+// snapshot returns the state of the open tabs. Of a split view only the
+// active pane is kept.
+func (a *App) snapshot() *session.Session {
+	s := &session.Session{PID: os.Getpid(), Saved: time.Now(), Active: a.activeTab}
+	for i, t := range a.tabs {
+		p := t.pane
+		if i == a.activeTab {
+			p = pane{input: a.input, cursor: a.cursor, source: a.source}
+		}
+		st := session.Tab{Name: t.name, Input: p.input.Value(), Cursor: p.cursor}
+		if src := p.source; src != nil && src.binary {
+			st.Path, st.Data = src.path, src.data
+			if src.unsaved() {
+				st.Original = src.original
+			}
+		}
+		s.Tabs = append(s.Tabs, st)
+	}
+	return s
+}
+
+// handleRestore handles the prompt to restore a session.
+func (a *App) handleRestore(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "y", "Y", "enter":
+		a.restoreSession(a.restoreOffer)
+	case "n", "N", "esc":
+		a.statusMsg = i18n.T("Previous session discarded")
+	case "ctrl+c":
+		return a, tea.Quit // The session stays for the next start
+	default:
+		return a, nil
+	}
+	if err := session.Remove(a.restorePath); err != nil {
+		a.statusMsg = i18n.Tf("Removing the previous session failed: %v", err)
+	}
+	a.restoreOffer = nil
+	return a, nil
+}
+
+// restoreSession opens the tabs of a saved session, after any already
+// open unless the only one is empty.
+func (a *App) restoreSession(s *session.Session) {
+	first := a.activeTab
+	if len(a.tabs) > 1 || a.input.Value() != "" || a.source != nil {
+		first = len(a.tabs)
+		if a.activeTab != first-1 {
+			a.saveTab()
+			a.loadTab(first - 1)
+		}
+	}
+	for i, t := range s.Tabs {
+		if i > 0 || first == len(a.tabs) {
+			a.newTab()
+		}
+		if t.Data != nil {
+			path := t.Path
+			if path == "" {
+				path = "-"
+			}
+			a.LoadSource(path, t.Data, analysis.Detection{Binary: "restored session"})
+			a.source.original = t.Original
+		} else {
+			a.input.SetValue(t.Input)
+			a.analyzeInput()
+		}
+		if t.Name != "" {
+			a.tabs[a.activeTab].name = t.Name
+		}
+		a.cursor = min(t.Cursor, max(len(a.characters)-1, 0))
+	}
+
+	a.saveTab()
+	a.loadTab(min(first+s.Active, len(a.tabs)-1))
+	if len(a.characters) > 0 {
+		a.input.Blur()
+	}
+	a.statusMsg = i18n.Tf("Restored %d tab(s) from %s", len(s.Tabs), s.Saved.Format("Jan 2 15:04"))
+}
+
+// renderRestore renders the prompt to restore a session.
+func (a *App) renderRestore() string {
+	s := a.restoreOffer
+	var b strings.Builder
+
+	b.WriteString(a.styles.Title.Render(i18n.T("Restore Session?")))
+	b.WriteString("\n\n")
+	b.WriteString(i18n.Tf("StringInspect did not exit normally on %s.", s.Saved.Format("2006-01-02 15:04")) + "\n")
+	b.WriteString(i18n.Tf("Its last autosave has %d tab(s):", len(s.Tabs)) + "\n\n")
+	for i, t := range s.Tabs {
+		if i == 5 {
+			b.WriteString(a.styles.Muted.Render("  "+i18n.Tf("… %d more", len(s.Tabs)-i)) + "\n")
+			break
+		}
+		name := t.Name
+		if name == "" {
+			name = i18n.T("untitled")
+		}
+		size := i18n.Tf("%d characters", len([]rune(t.Input)))
+		if t.Data != nil {
+			size = i18n.Tf("%d bytes", len(t.Data))
+			if t.Original != nil {
+				size += ", " + i18n.T("unsaved edits")
+			}
+		}
+		b.WriteString(fmt.Sprintf("  %s %s\n", name, a.styles.Muted.Render("("+size+")")))
+	}
+	b.WriteString("\n")
+	b.WriteString(a.styles.Muted.Render(i18n.T("y restore • n discard")))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorPrimary).
+		Padding(1, 2).
+		Render(b.String())
+}
@@ -0,0 +1,126 @@
+package app
+
+import (
+	"path/filepath"
+	"unicode/utf8"
+
+	"stringinspect/internal/analysis"
+	"stringinspect/internal/i18n"
+)
+
+// source is a file loaded as raw bytes, kept so it can be decoded again
+// under a different encoding.
+type source struct {
+	path      string // "" for stdin
+	data      []byte
+	detection analysis.Detection
+	encoding  analysis.TextEncoding // Encoding currently assumed
+	binary    bool                  // Analyzed byte by byte instead of decoded
+
+	// Hex editing: the data as loaded or last saved (nil before the first
+	// edit) and the edits for undo
+	original []byte
+	edits    []byteEdit
+}
+
+// LoadSource sets the input of the active tab to data, read from path
+// ("-" for stdin), decoded as detected, and names the tab after the file.
+// Switching the encoding later decodes the same bytes again.
+func (a *App) LoadSource(path string, data []byte, det analysis.Detection) {
+	a.source = &source{path: path, data: data, detection: det, encoding: det.Encoding, binary: det.Binary != ""}
+	a.tabs[a.activeTab].name = filepath.Base(path)
+	if path == "-" {
+		a.source.path = ""
+		a.tabs[a.activeTab].name = "stdin"
+	}
+	a.decodeSource()
+	if det.Binary != "" {
+		a.statusMsg = i18n.Tf("Binary file (%s): showing bytes • E to decode as text", det.Binary)
+	} else if det.Encoding != analysis.EncodingUTF8 || det.BOM {
+		a.statusMsg = i18n.Tf("Decoded as %s (%s) • E to switch encoding", det.Encoding, det.Reason)
+	}
+}
+
+// LoadImport sets the input of the active tab to the content of a JSON
+// export read from path. Exports made in byte mode hold bytes that are not
+// UTF-8, which are shown as bytes again.
+func (a *App) LoadImport(path, content string) {
+	if utf8.ValidString(content) {
+		a.source = nil // The export replaces any loaded file
+		a.input.SetValue(content)
+		a.analyzeInput()
+	} else {
+		a.LoadSource("-", []byte(content), analysis.Detection{Binary: "exported in byte mode"})
+		a.tabs[a.activeTab].name = filepath.Base(path)
+	}
+	a.cursor = 0
+}
+
+// decodeSource replaces the input with the source decoded in its current
+// encoding, or clears it when the bytes are analyzed directly. This is
+// not an undo step, since the bytes stay the same.
+func (a *App) decodeSource() {
+	text := ""
+	if !a.source.binary {
+		text = analysis.DecodeText(a.source.data, a.source.encoding)
+	}
+	a.input.SetValue(text)
+	a.analyzed = a.input.Value()
+	a.analyzeInput()
+	if a.source.binary {
+		a.input.Blur()
+	}
+}
+
+// binary reports whether the input is a file analyzed byte by byte. The
+// input field is then empty and text editing is unavailable.
+func (a *App) binary() bool {
+	return a.source != nil && a.source.binary
+}
+
+// analyze returns the analysis of the input, or of the file's bytes in
+// byte mode.
+func (a *App) analyze() []analysis.Character {
+	if a.binary() {
+		return a.analyzer.AnalyzeBytes(a.source.data)
+	}
+	return a.analyzer.AnalyzeString(a.input.Value())
+}
+
+// binaryLocked reports, with a status message, that text editing is
+// unavailable because the file is shown as bytes.
+func (a *App) binaryLocked() bool {
+	if a.binary() {
+		a.statusMsg = i18n.T("Showing bytes of a binary file • E to decode as text first")
+	}
+	return a.binary()
+}
+
+// cycleEncoding decodes the loaded file in the next encoding, so a wrong
+// guess or byte order can be corrected. After the last encoding the file
+// is shown as raw bytes.
+func (a *App) cycleEncoding() {
+	if a.source == nil {
+		a.statusMsg = i18n.T("Encoding can only be switched for files (ctrl+o to open one)")
+		return
+	}
+	switch next := a.source.encoding.Next(); {
+	case a.source.binary:
+		a.source.binary = false
+		a.source.encoding = analysis.EncodingUTF8
+	case next == analysis.EncodingUTF8:
+		a.source.binary = true
+	default:
+		a.source.encoding = next
+	}
+	a.decodeSource()
+
+	if a.source.binary {
+		a.statusMsg = i18n.Tf("Showing %d raw bytes", len(a.source.data))
+		return
+	}
+	a.statusMsg = i18n.Tf("Decoding as %s", a.source.encoding)
+	if a.source.encoding == a.source.detection.Encoding {
+		a.statusMsg += i18n.Tf(" (detected by %s)", a.source.detection.Reason)
+	}
+}
@@ -0,0 +1,190 @@
+package app
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"stringinspect/internal/analysis"
+	"stringinspect/internal/i18n"
+	"stringinspect/internal/undo"
+)
+
+// pane holds the per-input state of the inactive side of a split view.
+// The active pane always lives in the App's own fields, so every feature
+// works on it unchanged; switching panes swaps the two.
+type pane struct {
+	input         inputBox
+	all           []analysis.Character
+	characters    []analysis.Character
+	cursor        int
+	searchMatches []int
+	searchCursor  int
+	analyzed      string
+	undoStack     *undo.Stack
+	tableStart    int
+	tableCursor   int
+	source        *source
+	marks         map[rune]int
+}
+
+// toggleSplit turns the split view on, with the second pane starting as a
+// copy of the current input, or off, keeping the first pane.
+func (a *App) toggleSplit() {
+	if a.split {
+		if a.activePane == 1 {
+			a.switchPane()
+		}
+		a.split = false
+		a.other = nil
+		a.statusMsg = i18n.T("Split view closed")
+		return
+	}
+
+	in := a.input
+	in.SetValue(a.input.Value()) // Fresh backing slice, not shared with a.input
+	in.Blur()
+
+	a.other = &pane{
+		input:       in,
+		all:         a.all,
+		characters:  a.characters,
+		cursor:      a.cursor,
+		analyzed:    a.analyzed,
+		undoStack:   undo.New(200),
+		tableStart:  a.tableStart,
+		tableCursor: a.tableCursor,
+	}
+	if a.source != nil {
+		// The panes switch encodings and edit bytes independently
+		src := *a.source
+		src.data = slices.Clone(src.data)
+		src.original = slices.Clone(src.original)
+		src.edits = slices.Clone(src.edits)
+		a.other.source = &src
+	}
+	a.split = true
+	a.activePane = 0
+	a.statusMsg = i18n.T("Split view: w switch pane • = sync scroll • | close")
+}
+
+// swapPane exchanges the App's pane fields with the stored pane.
+func (a *App) swapPane() {
+	p := a.other
+	a.other = &pane{
+		input:         a.input,
+		all:           a.all,
+		characters:    a.characters,
+		cursor:        a.cursor,
+		searchMatches: a.searchMatches,
+		searchCursor:  a.searchCursor,
+		analyzed:      a.analyzed,
+		undoStack:     a.undoStack,
+		tableStart:    a.tableStart,
+		tableCursor:   a.tableCursor,
+		source:        a.source,
+		marks:         a.marks,
+	}
+
+	a.input = p.input
+	a.all = p.all
+	a.characters = p.characters
+	a.cursor = p.cursor
+	a.searchMatches = p.searchMatches
+	a.searchCursor = p.searchCursor
+	a.analyzed = p.analyzed
+	a.undoStack = p.undoStack
+	a.tableStart = p.tableStart
+	a.tableCursor = p.tableCursor
+	a.source = p.source
+	a.marks = p.marks
+	a.activePane = 1 - a.activePane
+}
+
+// switchPane makes the other pane active.
+func (a *App) switchPane() {
+	if !a.split {
+		return
+	}
+	a.swapPane()
+
+	// The filter and search may have changed while the pane was inactive
+	a.characters = a.filter.apply(a.all)
+	a.refreshSearch()
+	a.cursor = min(a.cursor, max(len(a.characters)-1, 0))
+
+	a.statusMsg = i18n.Tf("Pane %s", a.paneLabel())
+}
+
+// paneLabel names the active pane.
+func (a *App) paneLabel() string {
+	if a.activePane == 1 {
+		return "B"
+	}
+	return "A"
+}
+
+// syncPanes mirrors the cursor into the other pane when synchronized
+// scrolling is on.
+func (a *App) syncPanes() {
+	if !a.split || !a.syncScroll {
+		return
+	}
+	a.other.cursor = min(a.cursor, max(len(a.other.characters)-1, 0))
+}
+
+// renderSplit renders both panes side by side, pane A on the left.
+func (a *App) renderSplit() string {
+	width := (a.width - 4) / 2 // App padding
+
+	active := a.renderPane(width, true)
+
+	// Render the inactive pane by swapping it in temporarily, with the
+	// current filter and search applied
+	a.swapPane()
+	a.characters = a.filter.apply(a.all)
+	a.searchMatches = a.findMatches(a.searchQuery)
+	a.cursor = min(a.cursor, max(len(a.characters)-1, 0))
+	inactive := a.renderPane(width, false)
+	a.swapPane()
+
+	left, right := active, inactive
+	if a.activePane == 1 {
+		left, right = inactive, active
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, left, right)
+}
+
+// renderPane renders the pane in the App's fields as a bordered box of
+// the given width.
+func (a *App) renderPane(width int, focused bool) string {
+	savedWidth, savedInput := a.width, a.input.Width
+	a.width = width - 4 // Border and padding
+	a.input.Width = max(a.width-4, 10)
+	defer func() { a.width, a.input.Width = savedWidth, savedInput }()
+
+	title := i18n.Tf("Pane %s • %d chars", a.paneLabel(), len(a.characters))
+	if a.syncScroll {
+		title += " • " + i18n.T("synced")
+	}
+
+	var b strings.Builder
+	b.WriteString(a.styles.Subtitle.Render(title))
+	b.WriteString("\n")
+	b.WriteString(a.renderInput())
+	b.WriteString("\n\n")
+	b.WriteString(a.renderContent())
+
+	border := ColorSubtle
+	if focused {
+		border = ColorPrimary
+	}
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(border).
+		Padding(0, 1).
+		Width(width - 2).
+		Render(b.String())
+}
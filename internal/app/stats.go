@@ -0,0 +1,195 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"golang.org/x/text/unicode/norm"
+
+	"stringinspect/internal/analysis"
+	"stringinspect/internal/i18n"
+)
+
+// statFields lists the status bar statistics by config name.
+var statFields = []string{"runes", "bytes", "graphemes", "lines", "warnings"}
+
+// statShown reports whether a status bar statistic is turned on.
+func (a *App) statShown(name string) bool {
+	return !slices.Contains(a.config.HiddenStats, name)
+}
+
+// toggleStat turns a status bar statistic on or off.
+func (a *App) toggleStat(name string) {
+	if a.statShown(name) {
+		a.config.HiddenStats = append(a.config.HiddenStats, name)
+	} else {
+		a.config.HiddenStats = slices.DeleteFunc(a.config.HiddenStats, func(s string) bool { return s == name })
+	}
+}
+
+// statsSummary is the summary of an analysis, kept because the status bar
+// shows it on every render and summarizing a large file takes a while.
+type statsSummary struct {
+	first   *analysis.Character // First character of the analysis summarized
+	n       int
+	summary analysis.Summary
+}
+
+// summary returns the summary of the whole input, made once per analysis.
+func (a *App) summary() analysis.Summary {
+	if len(a.all) == 0 {
+		return analysis.Summarize(nil)
+	}
+	if c := a.statsSummary; c == nil || c.first != &a.all[0] || c.n != len(a.all) {
+		a.statsSummary = &statsSummary{&a.all[0], len(a.all), analysis.Summarize(a.all)}
+	}
+	return a.statsSummary.summary
+}
+
+// renderStats renders the enabled statistics of the whole input for the
+// status bar. Runes show the visible count too when a filter is active.
+func (a *App) renderStats() string {
+	s := a.summary()
+
+	var parts []string
+	for _, name := range statFields {
+		if !a.statShown(name) {
+			continue
+		}
+		switch name {
+		case "runes":
+			if a.filter.active() {
+				parts = append(parts, fmt.Sprintf("%d/%s", len(a.characters), plural(s.Characters, "1 rune", "%d runes")))
+			} else {
+				parts = append(parts, plural(s.Characters, "1 rune", "%d runes"))
+			}
+		case "bytes":
+			parts = append(parts, plural(s.Bytes, "1 byte", "%d bytes"))
+		case "graphemes":
+			parts = append(parts, plural(s.Graphemes, "1 grapheme", "%d graphemes"))
+		case "lines":
+			parts = append(parts, plural(s.Lines(), "1 line", "%d lines"))
+		case "warnings":
+			if n := s.WarningCount(); n > 0 {
+				parts = append(parts, lipgloss.NewStyle().Foreground(ColorWarning).Render(fmt.Sprintf("⚠ %d", n)))
+			}
+		}
+	}
+	return strings.Join(parts, a.styles.Muted.Render(" · "))
+}
+
+// checksumRow is one line of the checksum list in the statistics panel.
+type checksumRow struct {
+	form string // "UTF-8", "NFC", or "File" for raw bytes
+	analysis.Checksum
+}
+
+// checksumRows computes the checksums of the input's UTF-8 bytes and,
+// when it differs, of its NFC form. A binary file shown as bytes has only
+// the checksums of the file.
+func (a *App) checksumRows() (rows []checksumRow, nfcSame bool) {
+	if a.binary() {
+		for _, c := range analysis.Checksums(a.source.data) {
+			rows = append(rows, checksumRow{"File", c})
+		}
+		return rows, false
+	}
+
+	input := a.input.Value()
+	for _, c := range analysis.Checksums([]byte(input)) {
+		rows = append(rows, checksumRow{"UTF-8", c})
+	}
+	nfc := norm.NFC.String(input)
+	if nfc == input {
+		return rows, true
+	}
+	for _, c := range analysis.Checksums([]byte(nfc)) {
+		rows = append(rows, checksumRow{"NFC", c})
+	}
+	return rows, false
+}
+
+// handleStatsMenu handles keyboard input for the statistics panel, where
+// number keys toggle status bar statistics and checksums can be copied.
+func (a *App) handleStatsMenu(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	rows, _ := a.checksumRows()
+	switch s := msg.String(); s {
+	case "esc", "enter", "q", "#":
+		a.showStats = false
+		if err := a.saveConfig(); err != nil {
+			a.statusMsg = i18n.Tf("Status bar updated, save failed: %v", err)
+		}
+	case "up", "k":
+		a.statsCursor = max(a.statsCursor-1, 0)
+	case "down", "j":
+		a.statsCursor = min(a.statsCursor+1, len(rows)-1)
+	case "c":
+		row := rows[min(a.statsCursor, len(rows)-1)]
+		backend := a.copyText(row.Value)
+		a.statusMsg = i18n.Tf("Copied %s (%s) to %s", row.Name, row.form, i18n.T(backend))
+	default:
+		if len(s) == 1 && s[0] >= '1' && int(s[0]-'1') < len(statFields) {
+			a.toggleStat(statFields[s[0]-'1'])
+		}
+	}
+	return a, nil
+}
+
+// renderStatsMenu renders the statistics panel.
+func (a *App) renderStatsMenu() string {
+	var b strings.Builder
+
+	b.WriteString(a.styles.Title.Render(i18n.T("Statistics")))
+	b.WriteString("\n\n")
+	b.WriteString(a.styles.Subtitle.Render(i18n.T("Status bar")))
+	b.WriteString("\n")
+	for i, name := range statFields {
+		box := "[ ]"
+		style := a.styles.Muted
+		if a.statShown(name) {
+			box = "[x]"
+			style = a.styles.Printable
+		}
+		b.WriteString(style.Render(fmt.Sprintf("%d %s %s", i+1, box, i18n.T(name))))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(a.styles.Subtitle.Render(i18n.T("Checksums")))
+	b.WriteString("\n")
+	rows, nfcSame := a.checksumRows()
+	cursor := min(a.statsCursor, len(rows)-1)
+	for i, row := range rows {
+		line := fmt.Sprintf("%s %-7s  %s", padCell(i18n.T(row.form), 5), row.Name, row.Value)
+		if i == cursor {
+			b.WriteString(a.styles.Highlighted.Padding(0).Render(line))
+		} else {
+			b.WriteString(a.styles.Printable.Render(line))
+		}
+		b.WriteString("\n")
+	}
+	if nfcSame {
+		b.WriteString(a.styles.Muted.Render(i18n.T("The input is already in NFC form")))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(a.styles.Muted.Render(i18n.T("1-5 toggle • ↑/↓ select • c copy checksum • enter close")))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorPrimary).
+		Padding(1, 2).
+		Render(b.String())
+}
+
+// plural formats a count with a singular or plural noun.
+func plural(n int, one, other string) string {
+	if n == 1 {
+		return i18n.T(one)
+	}
+	return i18n.Tf(other, n)
+}
@@ -14,6 +14,7 @@ var (
 	ColorWhitespace = lipgloss.Color("#00E2C7") // Cyan - whitespace chars
 	ColorControl    = lipgloss.Color("#FF7698") // Pink/red - control chars
 	ColorExtended   = lipgloss.Color("#FDFF90") // Yellow - extended ASCII
+	ColorMatch      = lipgloss.Color("#FF9E3B") // Orange - search matches
 	ColorBackground = lipgloss.Color("#1a1a1a") // Dark background
 )
 
@@ -42,6 +43,8 @@ type Styles struct {
 	TableHeader   lipgloss.Style
 	TableCell     lipgloss.Style
 	TableSelected lipgloss.Style
+	SearchMatch   lipgloss.Style
+	Modified      lipgloss.Style // Edited bytes not yet saved
 	TableLabel    lipgloss.Style
 
 	// Input styles
@@ -123,6 +126,14 @@ func DefaultStyles() Styles {
 			Bold(true).
 			Padding(0, 1),
 
+		SearchMatch: lipgloss.NewStyle().
+			Background(ColorMatch).
+			Foreground(ColorBackground),
+
+		Modified: lipgloss.NewStyle().
+			Foreground(ColorError).
+			Bold(true),
+
 		TableLabel: lipgloss.NewStyle().
 			Foreground(ColorMuted).
 			Width(8),
@@ -0,0 +1,167 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	"stringinspect/internal/i18n"
+	"stringinspect/internal/undo"
+)
+
+// tab is one open buffer. The active tab's state lives in the App's
+// fields; the others keep theirs here until switched to.
+type tab struct {
+	name string // File name, "" for typed input
+	pane
+	viewMode    ViewMode
+	searchQuery string
+	byteCursor  int
+}
+
+// saveTab stores the App's buffer state in the active tab.
+func (a *App) saveTab() {
+	a.undoStack.Break()
+	t := a.tabs[a.activeTab]
+	t.pane = pane{
+		input:         a.input,
+		all:           a.all,
+		characters:    a.characters,
+		cursor:        a.cursor,
+		searchMatches: a.searchMatches,
+		searchCursor:  a.searchCursor,
+		analyzed:      a.analyzed,
+		undoStack:     a.undoStack,
+		tableStart:    a.tableStart,
+		tableCursor:   a.tableCursor,
+		source:        a.source,
+		marks:         a.marks,
+	}
+	t.viewMode = a.viewMode
+	t.searchQuery = a.searchQuery
+	t.byteCursor = a.byteCursor
+}
+
+// loadTab makes tab i active, restoring its buffer state. The filter is
+// shared by all tabs, and the display settings may have changed, so the
+// input is analyzed again.
+func (a *App) loadTab(i int) {
+	t := a.tabs[i]
+	a.activeTab = i
+	a.input = t.input
+	a.analyzed = t.analyzed
+	a.undoStack = t.undoStack
+	a.undoGroup = ""
+	a.viewMode = t.viewMode
+	a.searchQuery = t.searchQuery
+	a.byteCursor = t.byteCursor
+	a.source = t.source
+	a.tableStart = t.tableStart
+	a.tableCursor = t.tableCursor
+	a.marks = t.marks
+
+	a.all = a.analyze()
+	a.characters = a.filter.apply(a.all)
+	a.refreshSearch()
+	a.cursor = min(t.cursor, max(len(a.characters)-1, 0))
+}
+
+// tabsLocked reports, with a status message, that tabs cannot change
+// while the split view is open, since its panes belong to one tab.
+func (a *App) tabsLocked() bool {
+	if a.split {
+		a.statusMsg = i18n.T("Close the split view (|) to use tabs")
+	}
+	return a.split
+}
+
+// newTab opens an empty tab after the current one and switches to it.
+func (a *App) newTab() {
+	if a.tabsLocked() {
+		return
+	}
+	a.saveTab()
+
+	in := a.input
+	in.SetValue("") // Fresh backing slice, not shared with the old tab
+	in.Focus()
+	t := &tab{pane: pane{input: in, undoStack: undo.New(200)}, viewMode: ViewModeTable}
+
+	i := a.activeTab + 1
+	a.tabs = append(a.tabs[:i], append([]*tab{t}, a.tabs[i:]...)...)
+	a.loadTab(i)
+	a.statusMsg = i18n.Tf("Tab %d of %d", i+1, len(a.tabs))
+}
+
+// NewTab opens an empty tab for the next file to load. The first file
+// goes into the tab the App starts with.
+func (a *App) NewTab() {
+	a.newTab()
+}
+
+// closeTab closes the active tab, switching to the one before it.
+func (a *App) closeTab() {
+	if a.tabsLocked() {
+		return
+	}
+	if len(a.tabs) == 1 {
+		a.statusMsg = i18n.T("The last tab cannot be closed")
+		return
+	}
+	name := a.tabLabel(a.activeTab)
+	a.tabs = append(a.tabs[:a.activeTab], a.tabs[a.activeTab+1:]...)
+	a.loadTab(max(a.activeTab-1, 0))
+	a.statusMsg = i18n.Tf("Closed %s", name)
+}
+
+// cycleTab switches to the next or previous tab, wrapping around.
+func (a *App) cycleTab(forward bool) {
+	if len(a.tabs) == 1 {
+		a.statusMsg = i18n.T("Only one tab open (ctrl+t opens another)")
+		return
+	}
+	if a.tabsLocked() {
+		return
+	}
+	a.saveTab()
+	n := len(a.tabs)
+	i := (a.activeTab + 1) % n
+	if !forward {
+		i = (a.activeTab + n - 1) % n
+	}
+	a.loadTab(i)
+	a.statusMsg = i18n.Tf("Tab %d of %d: %s", i+1, n, a.tabLabel(i))
+}
+
+// tabLabel names tab i after its file, or the start of its text.
+func (a *App) tabLabel(i int) string {
+	t := a.tabs[i]
+	if t.name != "" {
+		return t.name
+	}
+	text := t.input.Value()
+	if i == a.activeTab {
+		text = a.input.Value()
+	}
+	if text = strings.TrimSpace(text); text == "" {
+		return "untitled"
+	}
+	return truncateWidth(printableText(strings.Join(strings.Fields(text), " ")), 16)
+}
+
+// renderTabBar renders the open tabs, or nothing when there is only one.
+func (a *App) renderTabBar() string {
+	if len(a.tabs) < 2 {
+		return ""
+	}
+	width := max((a.width-4)/len(a.tabs)-3, 8)
+	parts := make([]string, len(a.tabs))
+	for i := range a.tabs {
+		label := fmt.Sprintf(" %d %s ", i+1, truncateWidth(a.tabLabel(i), width))
+		if i == a.activeTab {
+			parts[i] = a.styles.Highlighted.Padding(0).Render(label)
+		} else {
+			parts[i] = a.styles.Muted.Render(label)
+		}
+	}
+	return strings.Join(parts, a.styles.Muted.Render("│"))
+}
@@ -0,0 +1,184 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"stringinspect/internal/analysis"
+	"stringinspect/internal/detect"
+	"stringinspect/internal/i18n"
+	"stringinspect/internal/transform"
+)
+
+// maxTokensShown limits how many tokens the panel lists at once.
+const maxTokensShown = 5
+
+// fixTransforms maps token kinds to the transform that replaces them with
+// the characters they stand for.
+var fixTransforms = map[string]string{
+	"HTML entity":     "&",
+	"escape sequence": "\\",
+}
+
+// tokenScan is the result of scanning an input for tokens.
+type tokenScan struct {
+	input   string
+	matches []detect.Match
+}
+
+// tokens returns the structured tokens found in the input. The scan is
+// cached per input, since views consult it for every cell.
+func (a *App) tokens() []detect.Match {
+	input := a.input.Value()
+	if a.tokenScan == nil || a.tokenScan.input != input {
+		a.tokenScan = &tokenScan{input, detect.Find(input)}
+	}
+	return a.tokenScan.matches
+}
+
+// inToken reports whether the character starts within a detected token.
+func (a *App) inToken(char analysis.Character) bool {
+	for _, m := range a.tokens() {
+		if char.ByteOffset >= m.Start && char.ByteOffset < m.End {
+			return true
+		}
+	}
+	return false
+}
+
+// analyzeDecoded opens the decoded bytes of an encoded substring in the
+// second pane of the split view, leaving the original input untouched.
+func (a *App) analyzeDecoded(m detect.Match) {
+	if !a.split {
+		a.toggleSplit()
+	}
+	if a.activePane == 0 {
+		a.switchPane()
+	}
+	a.input.SetValue(string(m.Decoded))
+	a.source = nil // No longer the file's text
+	a.undoStack.Break()
+	a.analyzeInput()
+	a.cursor = 0
+	a.input.Blur()
+	a.statusMsg = i18n.Tf("Decoded %s in pane B (w to switch back, u to undo)", m.Kind)
+}
+
+// openTokens shows the decoded tokens panel.
+func (a *App) openTokens() {
+	if len(a.tokens()) == 0 {
+		a.statusMsg = i18n.T("No identifiers, tokens, or encoded runs found")
+		return
+	}
+	a.tokenCursor = 0
+	a.showTokens = true
+}
+
+// handleTokens handles keyboard input for the decoded tokens panel.
+func (a *App) handleTokens(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	matches := a.tokens()
+	switch msg.String() {
+	case "esc", "q", "D":
+		a.showTokens = false
+	case "up", "k":
+		a.tokenCursor = max(a.tokenCursor-1, 0)
+	case "down", "j":
+		a.tokenCursor = min(a.tokenCursor+1, len(matches)-1)
+	case "enter":
+		// Jump to the token in the character views
+		m := matches[a.tokenCursor]
+		a.cursor = a.indexForRuneOffset(utf8.RuneCountInString(a.input.Value()[:m.Start]))
+		a.input.Blur()
+		a.showTokens = false
+	case "a":
+		if m := matches[a.tokenCursor]; m.Decoded != nil {
+			a.showTokens = false
+			a.analyzeDecoded(m)
+		}
+	case "u":
+		if t, ok := a.fixTransform(matches[a.tokenCursor]); ok {
+			a.applyTransform(t)
+			a.showTokens = false
+		}
+	}
+	return a, nil
+}
+
+// fixTransform returns the transform that replaces all tokens of m's kind
+// with what they stand for, if there is one.
+func (a *App) fixTransform(m detect.Match) (transform.Transform, bool) {
+	k, ok := fixTransforms[m.Kind]
+	if !ok {
+		return transform.Transform{}, false
+	}
+	return transform.Find(a.transforms, k)
+}
+
+// renderTokenHint summarizes the tokens found in the input, if any.
+func (a *App) renderTokenHint() string {
+	matches := a.tokens()
+	if len(matches) == 0 {
+		return ""
+	}
+	hint := matches[0].Summary
+	if len(matches) > 1 {
+		hint += i18n.Tf(" and %d more", len(matches)-1)
+	}
+	hint = i18n.Tf("Found %s • D to decode", hint)
+	if slices.ContainsFunc(matches, func(m detect.Match) bool { return m.Kind == "escape sequence" }) {
+		hint += i18n.T(" • \\ to interpret escapes")
+	}
+	return a.styles.Muted.Render(hint)
+}
+
+// renderTokens renders the decoded tokens panel.
+func (a *App) renderTokens() string {
+	var b strings.Builder
+
+	matches := a.tokens()
+	b.WriteString(a.styles.Title.Render(i18n.Tf("Decoded Tokens (%d)", len(matches))))
+	b.WriteString("\n")
+
+	top := scrollTop(a.tokenCursor, maxTokensShown, len(matches)-1)
+	for i := top; i < min(top+maxTokensShown, len(matches)); i++ {
+		m := matches[i]
+		b.WriteString("\n")
+		text := truncateWidth(m.Text, max(a.width-lipgloss.Width(m.Summary)-16, 16))
+		title := fmt.Sprintf("%s  %s", m.Summary, text)
+		if i == a.tokenCursor {
+			b.WriteString(a.styles.Highlighted.Padding(0).Render(title))
+		} else {
+			b.WriteString(a.styles.Printable.Render(title))
+		}
+		b.WriteString("\n")
+		for _, f := range m.Fields {
+			// Multi-line values (decoded JSON) are indented under the name
+			value := strings.ReplaceAll(f.Value, "\n", "\n"+strings.Repeat(" ", 19))
+			b.WriteString(fmt.Sprintf("  %s %s\n", a.styles.Muted.Render(padCell(f.Name+":", 16)), value))
+		}
+		for _, p := range m.Problems {
+			b.WriteString("  " + a.styles.Error.Render(i18n.Tf("Invalid: %s", p)) + "\n")
+		}
+	}
+
+	b.WriteString("\n")
+	if len(matches) > maxTokensShown {
+		b.WriteString(a.styles.Muted.Render(fmt.Sprintf("%d/%d", a.tokenCursor+1, len(matches))) + "\n")
+	}
+	keys := i18n.T("↑/↓ select • enter jump to token • a analyze decoded in split view")
+	if t, ok := a.fixTransform(matches[a.tokenCursor]); ok {
+		keys += " • u " + i18n.T(t.Name)
+	}
+	b.WriteString(a.styles.Muted.Render(keys + i18n.T(" • esc close")))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorPrimary).
+		Padding(1, 2).
+		Render(b.String())
+}
@@ -0,0 +1,140 @@
+package app
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"stringinspect/internal/i18n"
+	"stringinspect/internal/transform"
+)
+
+// maxReportLines limits the change report shown in the transform preview.
+const maxReportLines = 8
+
+// openTransforms shows the transform menu.
+func (a *App) openTransforms() {
+	a.transformCursor = 0
+	a.showTransforms = true
+}
+
+// handleTransforms handles keyboard input for the transform menu. A
+// transform's hotkey applies it directly.
+func (a *App) handleTransforms(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q", "!":
+		a.showTransforms = false
+	case "up", "k":
+		a.transformCursor = max(a.transformCursor-1, 0)
+	case "down", "j":
+		a.transformCursor = min(a.transformCursor+1, len(a.transforms)-1)
+	case "left":
+		if a.transformCursor >= a.transformRows() {
+			a.transformCursor -= a.transformRows()
+		}
+	case "right":
+		a.transformCursor = min(a.transformCursor+a.transformRows(), len(a.transforms)-1)
+	case "enter":
+		a.applyTransform(a.transforms[a.transformCursor])
+	default:
+		if t, ok := transform.Find(a.transforms, msg.String()); ok {
+			a.applyTransform(t)
+		}
+	}
+	return a, nil
+}
+
+// transformRows returns the number of menu rows per column.
+func (a *App) transformRows() int {
+	return (len(a.transforms) + 1) / 2
+}
+
+// applyTransform replaces the input with the transform's output as a
+// single undo step and re-analyzes it.
+func (a *App) applyTransform(t transform.Transform) {
+	a.showTransforms = false
+	input := a.input.Value()
+	res := t.Apply(input)
+	if res.Err != nil {
+		a.statusMsg = i18n.Tf("%s failed: %v", i18n.T(t.Name), res.Err)
+		return
+	}
+	if !res.Changed(input) {
+		a.statusMsg = i18n.Tf("%s: no changes", i18n.T(t.Name))
+		return
+	}
+
+	a.input.SetValue(res.Output)
+	a.undoStack.Break()
+	a.analyzeInput()
+	a.cursor = min(a.cursor, max(len(a.characters)-1, 0))
+	if len(a.characters) == 0 {
+		a.input.Focus()
+	}
+	summary := i18n.T("done")
+	if len(res.Report) > 0 {
+		summary = res.Report[0] // Details are in the preview
+	}
+	a.statusMsg = i18n.Tf("%s: %s (u to undo)", i18n.T(t.Name), summary)
+}
+
+// renderTransforms renders the transform menu with a preview of the
+// selected transform.
+func (a *App) renderTransforms() string {
+	var b strings.Builder
+
+	b.WriteString(a.styles.Title.Render(i18n.T("Transform Input")))
+	b.WriteString("\n\n")
+
+	// Two columns keep the menu short enough to fit below the view
+	rows := a.transformRows()
+	var cols []string
+	for start := 0; start < len(a.transforms); start += rows {
+		var col strings.Builder
+		for i := start; i < min(start+rows, len(a.transforms)); i++ {
+			t := a.transforms[i]
+			line := t.Key + "  " + padCell(i18n.T(t.Name), 30)
+			if i == a.transformCursor {
+				col.WriteString(a.styles.Highlighted.Padding(0).Render(line))
+			} else {
+				col.WriteString(a.styles.Printable.Render(line))
+			}
+			col.WriteString("\n")
+		}
+		cols = append(cols, col.String())
+	}
+	b.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, cols...))
+
+	// Preview
+	input := a.input.Value()
+	res := a.transforms[a.transformCursor].Apply(input)
+	b.WriteString("\n")
+	switch {
+	case res.Err != nil:
+		b.WriteString(a.styles.Error.Render(res.Err.Error()))
+	case res.Changed(input):
+		preview := truncateWidth(strings.ReplaceAll(res.Output, "\n", "⏎"), max(min(a.width-12, 80), 20))
+		b.WriteString(a.styles.Muted.Render(i18n.T("Result: ")) + a.styles.Printable.Render(preview))
+		for i, line := range res.Report {
+			if i == maxReportLines {
+				line = i18n.Tf("… %d more", len(res.Report)-i)
+			}
+			b.WriteString("\n" + a.styles.Muted.Render("  "+line))
+			if i == maxReportLines {
+				break
+			}
+		}
+	default:
+		b.WriteString(a.styles.Muted.Render(i18n.T("No changes")))
+	}
+
+	b.WriteString("\n\n")
+	b.WriteString(a.styles.Muted.Render(i18n.T("arrows select • enter or key apply • esc cancel")))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorPrimary).
+		Padding(1, 2).
+		Render(b.String())
+}
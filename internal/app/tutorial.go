@@ -0,0 +1,148 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"stringinspect/internal/analysis"
+	"stringinspect/internal/i18n"
+)
+
+// utf8Ranges lists the UTF-8 sequence lengths with the codepoints they
+// cover and the bits they carry.
+var utf8Ranges = []struct {
+	last    rune
+	bits    int
+	pattern string
+}{
+	{0x7F, 7, "0xxxxxxx"},
+	{0x7FF, 11, "110xxxxx 10xxxxxx"},
+	{0xFFFF, 16, "1110xxxx 10xxxxxx 10xxxxxx"},
+	{0x10FFFF, 21, "11110xxx 10xxxxxx 10xxxxxx 10xxxxxx"},
+}
+
+// tutorialSteps is the number of steps in the encoding walkthrough.
+const tutorialSteps = 5
+
+// openTutorial starts the encoding walkthrough for the selected character.
+func (a *App) openTutorial() {
+	if a.cursor >= len(a.characters) {
+		return
+	}
+	a.tutorialChar = a.characters[a.cursor]
+	a.tutorialStep = 0
+	a.showTutorial = true
+}
+
+// handleTutorial handles keyboard input for the walkthrough.
+func (a *App) handleTutorial(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q", "t":
+		a.showTutorial = false
+	case "right", "l", "enter", " ":
+		if a.tutorialStep < tutorialSteps-1 {
+			a.tutorialStep++
+		} else if msg.String() == "enter" {
+			a.showTutorial = false
+		}
+	case "left", "h", "backspace":
+		a.tutorialStep = max(a.tutorialStep-1, 0)
+	}
+	return a, nil
+}
+
+// renderTutorial renders the current step of the walkthrough.
+func (a *App) renderTutorial() string {
+	char := a.tutorialChar
+	var b strings.Builder
+
+	b.WriteString(a.styles.Title.Render(i18n.Tf("How %s is encoded in UTF-8 (step %d/%d)",
+		char.Unicode, a.tutorialStep+1, tutorialSteps)))
+	b.WriteString("\n\n")
+
+	if char.Rune == utf8.RuneError && len(char.UTF8Bytes) == 1 {
+		b.WriteString(i18n.Tf("Byte %02X is not valid UTF-8 on its own, so there is no codepoint to encode.", char.UTF8Bytes[0]) + "\n")
+		b.WriteString(i18n.T("It was probably produced by a different encoding or a truncated sequence."))
+		return a.tutorialBox(b.String())
+	}
+
+	bits := analysis.UTF8Structure(char.UTF8Bytes)
+	n := len(bits)
+	width := utf8Ranges[n-1].bits
+	binary := fmt.Sprintf("%0*b", width, char.Rune)
+	marker, payload := a.styles.Control, a.styles.Success
+
+	switch a.tutorialStep {
+	case 0:
+		b.WriteString(i18n.Tf("The character %s is %s.", glyph(char), analysis.Name(char.Rune)) + "\n\n")
+		b.WriteString(i18n.Tf("Unicode gives it the number (codepoint) %s,", a.styles.Printable.Render(char.Unicode)) + "\n")
+		b.WriteString(i18n.Tf("which is %d in decimal and 0x%X in hexadecimal.", char.Rune, char.Rune) + "\n\n")
+		b.WriteString(i18n.T("UTF-8 stores this number in 1 to 4 bytes. Let's see how."))
+
+	case 1:
+		b.WriteString(i18n.T("First write the codepoint in binary:") + "\n\n")
+		b.WriteString(fmt.Sprintf("  0x%X = %s\n\n", char.Rune, payload.Render(fmt.Sprintf("%b", char.Rune))))
+		b.WriteString(i18n.Tf("That is %d significant bits.", len(fmt.Sprintf("%b", char.Rune))))
+
+	case 2:
+		b.WriteString(i18n.T("The number of bits decides how many bytes are needed:") + "\n\n")
+		prev := rune(-1)
+		for i, r := range utf8Ranges {
+			span := padCell(fmt.Sprintf("U+%04X–U+%04X", prev+1, r.last), 17)
+			line := "  " + span + "  " + i18n.Tf("%d byte(s)  %2d bits", i+1, r.bits) + "  " + r.pattern
+			if i == n-1 {
+				b.WriteString(a.styles.Highlighted.Padding(0).Render(line))
+			} else {
+				b.WriteString(a.styles.Muted.Render(line))
+			}
+			b.WriteString("\n")
+			prev = r.last
+		}
+		b.WriteString("\n" + i18n.Tf("%s falls in the highlighted range, so it takes %d byte(s) with room for %d bits.", char.Unicode, n, width))
+
+	case 3:
+		b.WriteString(i18n.Tf("Pad the binary to %d bits and cut it into the byte slots (x in the pattern):", width) + "\n\n")
+		b.WriteString("  " + payload.Render(binary) + "\n")
+		groups := make([]string, n)
+		for i, bb := range bits {
+			groups[i] = payload.Render(bb.Payload)
+		}
+		b.WriteString("  " + strings.Join(groups, " ") + "\n\n")
+		if n == 1 {
+			b.WriteString(i18n.T("A single byte carries all 7 bits."))
+		} else {
+			b.WriteString(i18n.T("Continuation bytes each carry 6 bits; the lead byte gets what is left."))
+		}
+
+	case 4:
+		b.WriteString(i18n.T("Prefix each slot with its marker bits and read the bytes off:") + "\n\n")
+		for i, bb := range bits {
+			role := i18n.T("continuation (10)")
+			if i == 0 {
+				role = i18n.Tf("lead (%s)", bb.Marker)
+			}
+			b.WriteString(fmt.Sprintf("  %s%s = %s  %s\n", marker.Render(bb.Marker), payload.Render(bb.Payload),
+				a.styles.Printable.Render(fmt.Sprintf("%02X", bb.Value)), a.styles.Muted.Render(role)))
+		}
+		b.WriteString("\n" + i18n.Tf("So %s is stored as the bytes %s.", char.Unicode, a.styles.Printable.Render(char.UTF8Hex)))
+		if n > 1 {
+			b.WriteString("\n" + i18n.T("A decoder counts the 1s of the lead byte to know how many bytes follow."))
+		}
+	}
+
+	return a.tutorialBox(b.String())
+}
+
+// tutorialBox frames the walkthrough with its key hints.
+func (a *App) tutorialBox(content string) string {
+	hint := a.styles.Muted.Render(i18n.T("→/enter next • ← back • esc close"))
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorPrimary).
+		Padding(1, 2).
+		Render(content + "\n\n" + hint)
+}
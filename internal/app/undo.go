@@ -0,0 +1,78 @@
+package app
+
+import (
+	"unicode/utf8"
+
+	"stringinspect/internal/i18n"
+)
+
+// Undo groups coalesce consecutive changes of one kind into a single step.
+const (
+	undoGroupTyping  = "typing"
+	undoGroupReplace = "replace"
+	undoGroupHistory = "history"
+)
+
+// recordUndo saves the previously analyzed text before a change to the
+// input. It is called from analyzeInput, which consumes a.undoGroup.
+func (a *App) recordUndo(input string) {
+	if input != a.analyzed {
+		a.undoStack.Record(a.analyzed, a.undoGroup)
+		a.analyzed = input
+	}
+	a.undoGroup = ""
+}
+
+// restoreUndo undoes (or redoes) the last input change, placing the cursor
+// where the texts first differ.
+func (a *App) restoreUndo(redo bool) {
+	current := a.input.Value()
+
+	var text string
+	var ok bool
+	if redo {
+		text, ok = a.undoStack.Redo(current)
+	} else {
+		text, ok = a.undoStack.Undo(current)
+	}
+	if !ok {
+		if redo {
+			a.statusMsg = i18n.T("Nothing to redo")
+		} else {
+			a.statusMsg = i18n.T("Nothing to undo")
+		}
+		return
+	}
+
+	a.input.SetValue(text)
+	a.analyzed = a.input.Value() // Restoring is not itself an undoable change
+	a.analyzeInput()
+
+	pos := commonPrefixRunes(current, text)
+	a.input.SetCursor(pos)
+	a.cursor = a.indexForRuneOffset(pos)
+	if len(a.characters) == 0 {
+		a.input.Focus()
+	}
+
+	if redo {
+		a.statusMsg = i18n.Tf("Redo (%d more)", a.undoStack.RedoLen())
+	} else {
+		a.statusMsg = i18n.Tf("Undo (%d more)", a.undoStack.UndoLen())
+	}
+}
+
+// commonPrefixRunes returns the number of leading runes a and b share.
+func commonPrefixRunes(a, b string) int {
+	n := 0
+	for a != "" && b != "" {
+		ra, sa := utf8.DecodeRuneInString(a)
+		rb, sb := utf8.DecodeRuneInString(b)
+		if ra != rb {
+			break
+		}
+		a, b = a[sa:], b[sb:]
+		n++
+	}
+	return n
+}
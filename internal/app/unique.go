@@ -0,0 +1,125 @@
+package app
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"stringinspect/internal/analysis"
+	"stringinspect/internal/i18n"
+)
+
+// uniqueSort is the ordering of the unique-characters view.
+type uniqueSort int
+
+const (
+	uniqueByFirst uniqueSort = iota
+	uniqueByCount
+	uniqueByCodepoint
+)
+
+// String returns the display name of the ordering.
+func (s uniqueSort) String() string {
+	switch s {
+	case uniqueByCount:
+		return "count"
+	case uniqueByCodepoint:
+		return "codepoint"
+	default:
+		return "first position"
+	}
+}
+
+// uniqueList returns the distinct visible characters in the chosen order.
+func (a *App) uniqueList() []analysis.Distinct {
+	list := analysis.Unique(a.characters)
+	switch a.uniqueSort {
+	case uniqueByCount:
+		slices.SortStableFunc(list, func(x, y analysis.Distinct) int {
+			return cmp.Or(cmp.Compare(y.Count, x.Count), cmp.Compare(x.Rune, y.Rune))
+		})
+	case uniqueByCodepoint:
+		slices.SortFunc(list, func(x, y analysis.Distinct) int {
+			return cmp.Compare(x.Rune, y.Rune)
+		})
+	}
+	return list
+}
+
+// uniqueRow returns the row of the selected character in list.
+func (a *App) uniqueRow(list []analysis.Distinct) int {
+	r := a.characters[a.cursor].Rune
+	return max(slices.IndexFunc(list, func(d analysis.Distinct) bool { return d.Rune == r }), 0)
+}
+
+// handleUniqueView handles keys specific to the unique-characters view.
+// Moving selects a distinct character by putting the cursor on its first
+// occurrence. It returns false for keys that should fall through.
+func (a *App) handleUniqueView(msg tea.KeyMsg) bool {
+	if len(a.characters) == 0 {
+		return false
+	}
+
+	if key.Matches(msg, a.keys.UniqueSort) {
+		a.uniqueSort = (a.uniqueSort + 1) % 3
+		a.statusMsg = i18n.Tf("Sorted by %s", i18n.T(a.uniqueSort.String()))
+		return true
+	}
+
+	list := a.uniqueList()
+	row := a.uniqueRow(list)
+	switch msg.String() {
+	case "up", "k":
+		row = max(row-1, 0)
+	case "down", "j":
+		row = min(row+1, len(list)-1)
+	case "home", "g":
+		row = 0
+	case "end", "G":
+		row = len(list) - 1
+	default:
+		return false
+	}
+	a.cursor = list[row].First
+	a.statusMsg = ""
+	return true
+}
+
+// renderUniqueView lists each distinct character once with its count and
+// first position.
+func (a *App) renderUniqueView() string {
+	var b strings.Builder
+
+	list := a.uniqueList()
+	b.WriteString(a.styles.Title.Render(i18n.Tf("Unique Characters (%d distinct, by %s)", len(list), i18n.T(a.uniqueSort.String()))))
+	b.WriteString("\n\n")
+	b.WriteString(a.styles.TableHeader.Render(fmt.Sprintf(" %-7s %-10s %7s %8s  %s", i18n.T("Char"), "Unicode", i18n.T("Count"), i18n.T("First"), i18n.T("Name"))))
+	b.WriteString("\n")
+
+	selected := a.uniqueRow(list)
+	rows := max(4, a.height-22)
+	top := scrollTop(selected, rows, len(list)-1)
+	for i := top; i < top+rows && i < len(list); i++ {
+		d := list[i]
+		name := truncateWidth(analysis.Name(d.Rune), max(a.width-41, 10))
+		line := fmt.Sprintf(" %s %-10s %7d %8d  %s", padCell(glyph(d.Character), 7), d.Unicode, d.Count, d.RuneOffset, name)
+
+		style := a.styles.CharStyle(int(d.Type))
+		switch {
+		case i == selected:
+			style = a.styles.TableSelected.Padding(0)
+		case a.isSearchMatch(d.First):
+			style = a.styles.SearchMatch
+		}
+		b.WriteString(style.Render(line))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(a.styles.Muted.Render(i18n.T("↑/↓ select • S sort by first/count/codepoint")))
+	return b.String()
+}
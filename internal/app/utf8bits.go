@@ -0,0 +1,55 @@
+package app
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"stringinspect/internal/analysis"
+	"stringinspect/internal/i18n"
+)
+
+// renderUTF8Bits renders the UTF-8 bit structure of a character: each
+// byte in binary with marker bits set apart from payload bits, followed by
+// the payload bits reassembled into the codepoint.
+func (a *App) renderUTF8Bits(char analysis.Character) string {
+	bits := analysis.UTF8Structure(char.UTF8Bytes)
+	marker := a.styles.Control
+	payload := a.styles.Success
+
+	var b strings.Builder
+	b.WriteString(a.styles.Subtitle.Render(i18n.T("UTF-8 Structure")))
+	b.WriteString("\n")
+
+	for i, bb := range bits {
+		kind := i18n.T("continuation")
+		switch {
+		case bb.Marker == "":
+			kind = i18n.T("invalid")
+		case bb.Marker == "0":
+			kind = i18n.T("single byte")
+		case bb.Marker != "10":
+			kind = i18n.Tf("lead of %d", len(bb.Marker)-1)
+		}
+
+		label := a.styles.Muted.Width(14).Render(i18n.Tf("Byte %d (%02X):", i+1, bb.Value))
+		b.WriteString(label + " " + marker.Render(bb.Marker) + " " + payload.Render(bb.Payload))
+		b.WriteString(a.styles.Muted.Render("  " + kind))
+		b.WriteString("\n")
+	}
+
+	// Reassemble the payload bits, grouped per byte for readability
+	groups := make([]string, len(bits))
+	for i, bb := range bits {
+		groups[i] = payload.Render(bb.Payload)
+	}
+	value, err := strconv.ParseUint(analysis.UTF8Payload(bits), 2, 32)
+	label := a.styles.Muted.Width(14).Render(i18n.T("Codepoint:"))
+	b.WriteString(label + " " + strings.Join(groups, " "))
+	if err == nil {
+		b.WriteString(a.styles.Printable.Render(fmt.Sprintf(" = 0x%X = U+%04X", value, value)))
+	}
+	b.WriteString("\n")
+
+	return b.String()
+}
@@ -0,0 +1,97 @@
+package app
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"stringinspect/internal/i18n"
+)
+
+// verticalRows returns how many characters fit in the vertical table.
+func (a *App) verticalRows() int {
+	return max(4, a.height-20)
+}
+
+// handleVerticalTable handles keys specific to the vertical table. It
+// returns false for keys that should fall through to the normal bindings.
+func (a *App) handleVerticalTable(msg tea.KeyMsg) bool {
+	if len(a.characters) == 0 {
+		return false
+	}
+
+	switch msg.String() {
+	case "up", "k":
+		a.cursor = max(a.cursor-1, 0)
+	case "down", "j":
+		a.cursor = min(a.cursor+1, len(a.characters)-1)
+	case "pgup", "ctrl+u":
+		a.cursor = max(a.cursor-a.pageSize(a.verticalRows()), 0)
+	case "pgdown", "ctrl+d":
+		a.cursor = min(a.cursor+a.pageSize(a.verticalRows()), len(a.characters)-1)
+	default:
+		return false
+	}
+	return true
+}
+
+// renderVerticalTable renders the table with one character per row, which
+// keeps every column readable on narrow terminals.
+func (a *App) renderVerticalTable() string {
+	var b strings.Builder
+
+	cols := resolveColumns(a.verticalColumns, defaultVerticalColumns)
+	rows := a.verticalRows()
+	top := a.viewTop(a.cursor, rows, len(a.characters)-1)
+	end := min(top+rows, len(a.characters))
+	widths := a.verticalWidths(cols, a.characters[top:end])
+
+	// Flexible columns (the name) share whatever width is left
+	used, flexible := 0, 0
+	for _, w := range widths {
+		used += w
+		if w == 0 {
+			flexible++
+		}
+	}
+	for i := range widths {
+		if widths[i] == 0 {
+			widths[i] = max((a.width-used-4)/flexible, 10)
+		}
+	}
+
+	header := make([]string, len(cols))
+	for i, col := range cols {
+		header[i] = a.styles.TableHeader.Width(widths[i]).PaddingLeft(1).Render(truncateWidth(i18n.T(col.label), widths[i]-1))
+	}
+	b.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, header...))
+	b.WriteString("\n")
+
+	for i := top; i < end; i++ {
+		char := a.characters[i]
+		style := a.cellStyle(i, char).Padding(0, 1)
+		for j, col := range cols {
+			value := truncateWidth(col.fn(char), widths[j]-2)
+			b.WriteString(style.Width(widths[j]).Render(value))
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(a.styles.Muted.Render(i18n.Tf("↑/↓ scroll (%d/%d) • v horizontal layout", a.cursor+1, len(a.characters))))
+	return b.String()
+}
+
+// truncateWidth shortens s to at most width terminal cells, marking the
+// cut with an ellipsis.
+func truncateWidth(s string, width int) string {
+	if lipgloss.Width(s) <= width {
+		return s
+	}
+	runes := []rune(s)
+	for len(runes) > 0 && lipgloss.Width(string(runes))+1 > width {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes) + "…"
+}
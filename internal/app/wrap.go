@@ -0,0 +1,91 @@
+package app
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"stringinspect/internal/i18n"
+)
+
+// wrapBands returns how many bands of characters fit on screen in the
+// wrapped table. A band is a row per column and a blank line.
+func (a *App) wrapBands() int {
+	return max(1, a.verticalRows()/(len(a.activeColumns())+1))
+}
+
+// wrapWindow returns the range of characters visible in the wrapped
+// table: the bands around the cursor's.
+func (a *App) wrapWindow() (start, end int) {
+	if len(a.characters) == 0 {
+		return 0, 0
+	}
+	per := a.tableChars()
+	top := a.viewTop(a.cursor/per, a.wrapBands(), (len(a.characters)-1)/per)
+	start = top * per
+	return start, min(start+a.wrapBands()*per, len(a.characters))
+}
+
+// toggleWrap switches the table between one band scrolled sideways and
+// bands stacked like lines of text.
+func (a *App) toggleWrap() {
+	a.tableWrap = !a.tableWrap
+	a.tableVertical = false
+	a.viewMode = ViewModeTable
+	if a.tableWrap {
+		a.statusMsg = i18n.T("Table: wrapped into rows")
+	} else {
+		a.statusMsg = i18n.T("Table: one row, scrolled sideways")
+	}
+}
+
+// handleWrappedTable handles keys specific to the wrapped table. It
+// returns false for keys that should fall through to the normal bindings.
+func (a *App) handleWrappedTable(msg tea.KeyMsg) bool {
+	if len(a.characters) == 0 {
+		return false
+	}
+
+	per := a.tableChars()
+	page := a.pageSize(per * a.wrapBands())
+	last := len(a.characters) - 1
+	switch msg.String() {
+	case "up", "k":
+		if a.cursor >= per {
+			a.cursor -= per
+		}
+	case "down", "j":
+		if a.cursor/per < last/per {
+			a.cursor = min(a.cursor+per, last)
+		}
+	case "pgup", "ctrl+u":
+		a.cursor = max(a.cursor-page, a.cursor%per)
+	case "pgdown", "ctrl+d":
+		a.cursor = min(a.cursor+page, last)
+	default:
+		return false
+	}
+	return true
+}
+
+// renderWrappedTable renders the table in bands of as many characters as
+// fit across, so long single-line text such as minified JSON reads down the
+// screen instead of off its side.
+func (a *App) renderWrappedTable() string {
+	var b strings.Builder
+
+	per := a.tableChars()
+	start, end := a.wrapWindow()
+	for i := start; i < end; i += per {
+		if i > start {
+			b.WriteString("\n")
+		}
+		b.WriteString(a.renderTableRows(i, min(i+per, end)))
+	}
+
+	b.WriteString("\n")
+	rows := (len(a.characters)-1)/per + 1
+	b.WriteString(a.styles.Muted.Render(i18n.Tf("↑/↓ rows %d–%d of %d • z one row", start/per+1, (end-1)/per+1, rows)))
+
+	return b.String()
+}
@@ -0,0 +1,92 @@
+// Package config loads and saves persistent user preferences.
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+// Config holds the preferences that survive between sessions. Empty
+// fields mean the application default.
+type Config struct {
+	TableColumns    []string       `json:"table_columns,omitempty"`    // Rows of the horizontal table, in order
+	VerticalColumns []string       `json:"vertical_columns,omitempty"` // Columns of the vertical table, in order
+	HiddenStats     []string       `json:"hidden_stats,omitempty"`     // Status bar statistics turned off
+	CellWidth       int            `json:"cell_width,omitempty"`       // Width of the horizontal table's cells; 0 fits the values
+	ColumnWidths    map[string]int `json:"column_widths,omitempty"`    // Widths of vertical table columns by name; unset ones fit
+	Placeholders    string         `json:"placeholders,omitempty"`     // Display style of non-printable characters
+	Sanitize        []string       `json:"sanitize,omitempty"`         // Invisible character classes the sanitize transform removes
+	ReopenLast      bool           `json:"reopen_last,omitempty"`      // Load the last opened file when started without one
+	ReducedMotion   bool           `json:"reduced_motion,omitempty"`   // Steady cursors, nothing animated
+	PageSize        int            `json:"page_size,omitempty"`        // Characters PageUp/PageDown move; 0 is a screen
+	ScrollMargin    int            `json:"scroll_margin,omitempty"`    // Rows or characters kept between the cursor and the edge
+	CenterCursor    bool           `json:"center_cursor,omitempty"`    // Scroll to keep the cursor in the middle
+	Language        string         `json:"language,omitempty"`         // Language of the TUI, e.g. "de"; "" follows $LANG
+}
+
+// DefaultPath returns the config file location under the user's config
+// directory (e.g. ~/.config/stringinspect/config.json).
+func DefaultPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "stringinspect", "config.json"), nil
+}
+
+// StateDir returns the directory for state kept between sessions, like
+// the recent files and autosaved sessions: $XDG_STATE_HOME/stringinspect,
+// ~/.local/state on Unix-like systems without it, and the config directory
+// on macOS and Windows.
+func StateDir() (string, error) {
+	dir := os.Getenv("XDG_STATE_HOME")
+	if dir == "" {
+		var err error
+		switch runtime.GOOS {
+		case "darwin", "windows":
+			dir, err = os.UserConfigDir()
+		default:
+			dir, err = os.UserHomeDir()
+			dir = filepath.Join(dir, ".local", "state")
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	return filepath.Join(dir, "stringinspect"), nil
+}
+
+// Load reads the config at path. A missing file is not an error and
+// yields an empty Config.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &Config{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return &cfg, nil
+}
+
+// Save writes the config to path, creating its directory if needed.
+func (c *Config) Save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
@@ -0,0 +1,56 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestLoadMissingFile(t *testing.T) {
+	cfg, err := Load(filepath.Join(t.TempDir(), "missing.json"))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(cfg.TableColumns) != 0 {
+		t.Errorf("TableColumns = %v, want empty", cfg.TableColumns)
+	}
+}
+
+func TestSaveLoadRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "config.json")
+	want := &Config{
+		TableColumns:    []string{"char", "name", "utf16"},
+		VerticalColumns: []string{"hex"},
+		HiddenStats:     []string{"graphemes"},
+	}
+	if err := want.Save(path); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	got, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if !slices.Equal(got.TableColumns, want.TableColumns) || !slices.Equal(got.VerticalColumns, want.VerticalColumns) ||
+		!slices.Equal(got.HiddenStats, want.HiddenStats) {
+		t.Errorf("Load() = %+v, want %+v", got, want)
+	}
+}
+
+func TestLoadInvalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte("{not json"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(path); err == nil {
+		t.Error("Load() of invalid JSON succeeded")
+	}
+}
+
+func TestStateDir(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", "/tmp/state")
+	if dir, err := StateDir(); err != nil || dir != filepath.Join("/tmp/state", "stringinspect") {
+		t.Errorf("StateDir() = %q, %v, want /tmp/state/stringinspect", dir, err)
+	}
+}
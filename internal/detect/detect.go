@@ -0,0 +1,70 @@
+// Package detect recognizes structured tokens such as UUIDs, ULIDs, JWTs,
+// HTML entities, backslash escapes, and embedded base64, hex, or percent-encoded runs in text
+// and decodes them.
+package detect
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Field is one decoded property of a token.
+type Field struct {
+	Name  string
+	Value string
+}
+
+// Match is a token found in the text.
+type Match struct {
+	Kind       string // e.g. "UUID"
+	Summary    string // Short description, e.g. "UUID v4 (random)"
+	Text       string
+	Start, End int // Byte offsets in the scanned text
+	Fields     []Field
+	Problems   []string // Why the token is not valid, if it is not
+
+	// Decoded holds the bytes an encoded substring stands for, or nil for
+	// tokens that are not encodings
+	Decoded []byte
+}
+
+// Valid reports whether the token passed validation.
+func (m Match) Valid() bool {
+	return len(m.Problems) == 0
+}
+
+// recognizers scan text for one kind of token each. Earlier ones win when
+// two match the same span, so specific formats come before the generic
+// encodings.
+var recognizers = []func(s string) []Match{
+	uuids,
+	ulids,
+	jwts,
+	entities,
+	escapes,
+	hexBlobs,
+	percentRuns,
+	base64Runs,
+}
+
+// Find returns the tokens in s ordered by position. Where tokens overlap,
+// such as an ID-like run inside a JWT, the longer one wins.
+func Find(s string) []Match {
+	var found []Match
+	for _, recognize := range recognizers {
+		found = append(found, recognize(s)...)
+	}
+	slices.SortStableFunc(found, func(x, y Match) int {
+		return cmp.Or(cmp.Compare(x.Start, y.Start), cmp.Compare(y.End, x.End))
+	})
+
+	var matches []Match
+	end := 0
+	for _, m := range found {
+		if m.Start >= end {
+			matches = append(matches, m)
+			end = m.End
+		}
+	}
+	return matches
+}
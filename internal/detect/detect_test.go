@@ -0,0 +1,197 @@
+package detect
+
+import (
+	"strings"
+	"testing"
+)
+
+func field(m Match, name string) string {
+	for _, f := range m.Fields {
+		if f.Name == name {
+			return f.Value
+		}
+	}
+	return ""
+}
+
+func TestUUID(t *testing.T) {
+	tests := []struct {
+		input     string
+		summary   string
+		timestamp string
+		valid     bool
+	}{
+		{"f47ac10b-58cc-4372-a567-0e02b2c3d479", "UUID v4 (random)", "", true},
+		{"C232AB00-9414-11EC-B3C8-9E6BDECED846", "UUID v1 (time-based)", "2022-02-22T19:22:22Z", true},
+		{"1EC9414C-232A-6B00-B3C8-9E6BDECED846", "UUID v6 (reordered time-based)", "2022-02-22T19:22:22Z", true},
+		{"017F22E2-79B0-7CC3-98C4-DC0C0C07398F", "UUID v7 (Unix time-based)", "2022-02-22T19:22:22Z", true},
+		{"00000000-0000-0000-0000-000000000000", "Nil UUID", "", true},
+		{"f47ac10b-58cc-0372-a567-0e02b2c3d479", "UUID with unknown version 0", "", false},
+		{"f47ac10b-58cc-4372-0567-0e02b2c3d479", "UUID with NCS (reserved) variant", "", false},
+	}
+
+	for _, tt := range tests {
+		matches := Find("id=" + tt.input + ";")
+		if len(matches) != 1 {
+			t.Fatalf("Find(%q) found %d matches", tt.input, len(matches))
+		}
+		m := matches[0]
+		if m.Summary != tt.summary || m.Valid() != tt.valid || m.Start != 3 || m.Text != tt.input {
+			t.Errorf("%s: got %q valid=%v start=%d", tt.input, m.Summary, m.Valid(), m.Start)
+		}
+		if got := field(m, "Timestamp"); got != tt.timestamp {
+			t.Errorf("%s: timestamp %q, want %q", tt.input, got, tt.timestamp)
+		}
+	}
+}
+
+func TestULID(t *testing.T) {
+	matches := Find("event 01ARZ3NDEKTSV4RRFFQ69G5FAV done")
+	if len(matches) != 1 {
+		t.Fatalf("found %d matches", len(matches))
+	}
+	m := matches[0]
+	if m.Kind != "ULID" || !m.Valid() || m.Start != 6 {
+		t.Errorf("got %+v", m)
+	}
+	if got := field(m, "Timestamp"); got != "2016-07-30T23:54:10.259Z" {
+		t.Errorf("timestamp = %q", got)
+	}
+
+	if m := Find("81ARZ3NDEKTSV4RRFFQ69G5FAV"); len(m) != 1 || m[0].Valid() {
+		t.Errorf("overflowing ULID accepted: %+v", m)
+	}
+	if m := Find("abcdefghjkmnpqrstvwxyzabcd"); len(m) != 0 {
+		t.Errorf("word without digits matched: %+v", m)
+	}
+}
+
+func TestJWT(t *testing.T) {
+	// {"alg":"HS256","typ":"JWT"} {"sub":"1234567890","name":"John Doe","iat":1516239022}
+	token := "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9." +
+		"eyJzdWIiOiIxMjM0NTY3ODkwIiwibmFtZSI6IkpvaG4gRG9lIiwiaWF0IjoxNTE2MjM5MDIyfQ." +
+		"SflKxwRJSMeKKF2QT4fwpMeJf36POk6yJV_adQssw5c"
+
+	matches := Find("Authorization: Bearer " + token)
+	if len(matches) != 1 {
+		t.Fatalf("found %d matches", len(matches))
+	}
+	m := matches[0]
+	if m.Kind != "JWT" || m.Text != token || !m.Valid() || m.Summary != "JWT HS256 (signature not verified)" {
+		t.Errorf("got %+v", m)
+	}
+	if got := field(m, "Header"); !strings.Contains(got, `"alg": "HS256"`) {
+		t.Errorf("header = %q", got)
+	}
+	if got := field(m, "Payload"); !strings.Contains(got, `"name": "John Doe"`) {
+		t.Errorf("payload = %q", got)
+	}
+	if got := field(m, "iat"); got != "2018-01-18T01:30:22Z" {
+		t.Errorf("iat = %q", got)
+	}
+
+	// Corrupt payload
+	m = Find("eyJhbGciOiJIUzI1NiJ9.eyJzdWIiOi.sig")[0]
+	if m.Valid() {
+		t.Errorf("corrupt token accepted: %+v", m)
+	}
+}
+
+func TestFindOverlap(t *testing.T) {
+	// The payload contains a ULID-like run between dashes
+	token := "eyJhbGciOiJub25lIn0.eyJqdGkiOiIwMUFSWjNOREVLVFNWNFJSRkZRNjlHNUZBViJ9-01ARZ3NDEKTSV4RRFFQ69G5FAV."
+	matches := Find(token + " 01ARZ3NDEKTSV4RRFFQ69G5FAV")
+	if len(matches) != 2 || matches[0].Kind != "JWT" || matches[1].Kind != "ULID" {
+		t.Errorf("got %+v", matches)
+	}
+}
+
+func TestEncodedRuns(t *testing.T) {
+	tests := []struct {
+		input   string
+		kind    string
+		text    string
+		decoded string
+	}{
+		{"payload=SGVsbG8sIFdvcmxkIQ== end", "base64", "SGVsbG8sIFdvcmxkIQ==", "Hello, World!"},
+		{"key 48656c6c6f20576f726c64 end", "hex", "48656c6c6f20576f726c64", "Hello World"},
+		{"key 0x48656C6C6F20576F end", "hex", "0x48656C6C6F20576F", "Hello Wo"},
+		{"go to /search?q=caf%C3%A9%20au%20lait now", "percent-encoded", "/search?q=caf%C3%A9%20au%20lait", "/search?q=café au lait"},
+	}
+
+	for _, tt := range tests {
+		matches := Find(tt.input)
+		if len(matches) != 1 {
+			t.Errorf("Find(%q) found %d matches: %+v", tt.input, len(matches), matches)
+			continue
+		}
+		m := matches[0]
+		if m.Kind != tt.kind || m.Text != tt.text || string(m.Decoded) != tt.decoded {
+			t.Errorf("Find(%q) = %s %q → %q", tt.input, m.Kind, m.Text, m.Decoded)
+		}
+		if tt.input[m.Start:m.End] != tt.text {
+			t.Errorf("Find(%q): offsets %d-%d", tt.input, m.Start, m.End)
+		}
+	}
+
+	// Words, numbers, and paths are not encodings
+	for _, s := range []string{
+		"internationalization and counterrevolutionaries",
+		"order 12345678901234567890",
+		"/usr/local/share/applications",
+		"a single %20 escape",
+		"f47ac10b-58cc-4372-a567-0e02b2c3d479",
+	} {
+		for _, m := range Find(s) {
+			if m.Decoded != nil {
+				t.Errorf("Find(%q) found %s %q", s, m.Kind, m.Text)
+			}
+		}
+	}
+}
+
+func TestEntities(t *testing.T) {
+	matches := Find("caf&#233; &amp; &#x1F600; &bogus; &#xD800;")
+	want := []struct {
+		text, decoded string
+		valid         bool
+	}{
+		{"&#233;", "é", true},
+		{"&amp;", "&", true},
+		{"&#x1F600;", "😀", true},
+		{"&#xD800;", "\uFFFD", false},
+	}
+	if len(matches) != len(want) {
+		t.Fatalf("found %d matches: %+v", len(matches), matches)
+	}
+	for i, w := range want {
+		m := matches[i]
+		if m.Kind != "HTML entity" || m.Text != w.text || string(m.Decoded) != w.decoded || m.Valid() != w.valid {
+			t.Errorf("match %d = %+v, want %+v", i, m, w)
+		}
+	}
+}
+
+func TestEscapes(t *testing.T) {
+	matches := Find(`GET /x\tok\n caf\u00e9 \ud83d\ude00 \x1b[0m \q \uD800!`)
+	want := []struct {
+		text, decoded string
+		valid         bool
+	}{
+		{`\t`, "\t", true},
+		{`\n`, "\n", true},
+		{`\u00e9`, "é", true},
+		{`\ud83d\ude00`, "😀", true},
+		{`\x1b`, "\x1b", true},
+		{`\uD800`, "\uFFFD", false},
+	}
+	if len(matches) != len(want) {
+		t.Fatalf("found %d matches: %+v", len(matches), matches)
+	}
+	for i, w := range want {
+		m := matches[i]
+		if m.Kind != "escape sequence" || m.Text != w.text || string(m.Decoded) != w.decoded || m.Valid() != w.valid {
+			t.Errorf("match %d = %+v, want %+v", i, m, w)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package detect
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	base64Pattern  = regexp.MustCompile(`[A-Za-z0-9+/_-]{16,}={0,2}`)
+	hexPattern     = regexp.MustCompile(`\b(?:0[xX])?[0-9A-Fa-f]{16,}\b`)
+	percentPattern = regexp.MustCompile(`[\w.~!$&'()*+,;=:@/?-]*(?:%[0-9A-Fa-f]{2}[\w.~!$&'()*+,;=:@/?-]*){2,}`)
+)
+
+// encodedMatch builds the match of an encoded substring, describing what
+// it decodes to.
+func encodedMatch(kind, text string, start int, data []byte) Match {
+	m := Match{Kind: kind, Text: text, Start: start, End: start + len(text), Decoded: data}
+	if utf8.Valid(data) {
+		m.Summary = fmt.Sprintf("%s (%d bytes of text)", kind, len(data))
+		m.Fields = []Field{{"Decoded", string(data)}}
+	} else {
+		m.Summary = fmt.Sprintf("%s (%d bytes of binary data)", kind, len(data))
+		m.Fields = []Field{{"Decoded", hex.EncodeToString(data[:min(len(data), 32)])}}
+	}
+	return m
+}
+
+// base64Runs finds runs that decode as standard or URL-safe base64. To
+// avoid matching long words, a run needs upper and lower case letters and
+// a digit or symbol.
+func base64Runs(s string) []Match {
+	var matches []Match
+	for _, loc := range base64Pattern.FindAllStringIndex(s, -1) {
+		text := s[loc[0]:loc[1]]
+		var upper, lower, other bool
+		for _, r := range text {
+			switch {
+			case unicode.IsUpper(r):
+				upper = true
+			case unicode.IsLower(r):
+				lower = true
+			default:
+				other = true
+			}
+		}
+		if !upper || !lower || !other {
+			continue
+		}
+
+		enc := base64.RawStdEncoding
+		if strings.ContainsAny(text, "-_") {
+			enc = base64.RawURLEncoding
+		}
+		data, err := enc.DecodeString(strings.TrimRight(text, "="))
+		if err != nil {
+			continue
+		}
+		matches = append(matches, encodedMatch("base64", text, loc[0], data))
+	}
+	return matches
+}
+
+// hexBlobs finds runs of at least 16 hex digits (8 bytes) with both
+// letters and digits, which rules out plain numbers and words.
+func hexBlobs(s string) []Match {
+	var matches []Match
+	for _, loc := range hexPattern.FindAllStringIndex(s, -1) {
+		text := s[loc[0]:loc[1]]
+		digits := strings.TrimPrefix(strings.TrimPrefix(text, "0x"), "0X")
+		if len(digits)%2 != 0 || !strings.ContainsAny(digits, "0123456789") ||
+			!strings.ContainsAny(digits, "abcdefABCDEF") {
+			continue
+		}
+		data, _ := hex.DecodeString(digits)
+		matches = append(matches, encodedMatch("hex", text, loc[0], data))
+	}
+	return matches
+}
+
+// percentRuns finds URL-like runs with at least two %XX escapes.
+func percentRuns(s string) []Match {
+	var matches []Match
+	for _, loc := range percentPattern.FindAllStringIndex(s, -1) {
+		text := s[loc[0]:loc[1]]
+		decoded, err := url.PathUnescape(text)
+		if err != nil {
+			continue
+		}
+		matches = append(matches, encodedMatch("percent-encoded", text, loc[0], []byte(decoded)))
+	}
+	return matches
+}
@@ -0,0 +1,41 @@
+package detect
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"unicode/utf8"
+)
+
+// EntityPattern matches HTML character references: named (&amp;),
+// decimal (&#233;), and hexadecimal (&#x1F600;).
+var EntityPattern = regexp.MustCompile(`&(?:#[0-9]{1,7}|#[xX][0-9A-Fa-f]{1,6}|[A-Za-z][A-Za-z0-9]{1,31});`)
+
+// entities finds HTML character references. Unknown names are skipped;
+// numeric references to invalid codepoints are flagged.
+func entities(s string) []Match {
+	var matches []Match
+	for _, loc := range EntityPattern.FindAllStringIndex(s, -1) {
+		text := s[loc[0]:loc[1]]
+		decoded := html.UnescapeString(text)
+		if decoded == text {
+			continue
+		}
+		m := Match{
+			Kind:    "HTML entity",
+			Summary: fmt.Sprintf("HTML entity → %s", decoded),
+			Text:    text,
+			Start:   loc[0],
+			End:     loc[1],
+			Decoded: []byte(decoded),
+		}
+		for _, r := range decoded {
+			m.Fields = append(m.Fields, Field{"Character", fmt.Sprintf("U+%04X", r)})
+		}
+		if text[1] == '#' && decoded == string(utf8.RuneError) {
+			m.Problems = append(m.Problems, "does not refer to a valid character")
+		}
+		matches = append(matches, m)
+	}
+	return matches
+}
@@ -0,0 +1,45 @@
+package detect
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"stringinspect/internal/analysis"
+	"stringinspect/internal/export"
+)
+
+// EscapePattern matches the backslash escapes that export.Unescape
+// understands. A surrogate pair written as two \u escapes is one match.
+var EscapePattern = regexp.MustCompile(`\\(?:u[dD][89abAB][0-9a-fA-F]{2}\\u[dD][c-fC-F][0-9a-fA-F]{2}|` +
+	`u\{[0-9a-fA-F]{1,6}\}|u[0-9a-fA-F]{4}|U[0-9a-fA-F]{8}|x[0-9a-fA-F]{2}|[nrt0\\])`)
+
+// escapes finds literal backslash escapes, as in quoted strings and log
+// lines.
+func escapes(s string) []Match {
+	var matches []Match
+	for _, loc := range EscapePattern.FindAllStringIndex(s, -1) {
+		text := s[loc[0]:loc[1]]
+		decoded, err := export.Unescape(text)
+		if err != nil {
+			continue
+		}
+		m := Match{
+			Kind:    "escape sequence",
+			Text:    text,
+			Start:   loc[0],
+			End:     loc[1],
+			Decoded: []byte(decoded),
+		}
+		r, _ := utf8.DecodeRuneInString(decoded)
+		m.Summary = fmt.Sprintf("Escape for U+%04X", r)
+		m.Fields = []Field{{"Character", fmt.Sprintf("U+%04X %s", r, analysis.Name(r))}}
+		if r == utf8.RuneError && !strings.EqualFold(text[2:], "FFFD") {
+			// A lone surrogate, which Unescape replaces
+			m.Problems = append(m.Problems, "does not encode a valid character")
+		}
+		matches = append(matches, m)
+	}
+	return matches
+}
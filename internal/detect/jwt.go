@@ -0,0 +1,74 @@
+package detect
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// jwtPattern matches three base64url segments whose header starts with
+// "ey", the encoding of `{"`.
+var jwtPattern = regexp.MustCompile(`\bey[A-Za-z0-9_-]+\.ey[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`)
+
+// jwtTimeClaims are registered claims holding Unix timestamps.
+var jwtTimeClaims = []string{"iat", "nbf", "exp"}
+
+// jwts finds JSON Web Tokens and decodes their header and payload. The
+// signature is not verified.
+func jwts(s string) []Match {
+	var matches []Match
+	for _, loc := range jwtPattern.FindAllStringIndex(s, -1) {
+		matches = append(matches, decodeJWT(s[loc[0]:loc[1]], loc[0]))
+	}
+	return matches
+}
+
+// decodeJWT pretty-prints the header and payload of a token.
+func decodeJWT(text string, start int) Match {
+	m := Match{Kind: "JWT", Summary: "JWT (signature not verified)", Text: text, Start: start, End: start + len(text)}
+	parts := strings.Split(text, ".")
+
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	for i, name := range []string{"Header", "Payload"} {
+		data, err := base64.RawURLEncoding.DecodeString(parts[i])
+		if err != nil {
+			m.Problems = append(m.Problems, fmt.Sprintf("%s is not valid base64url", strings.ToLower(name)))
+			continue
+		}
+		var pretty bytes.Buffer
+		if err := json.Indent(&pretty, data, "", "  "); err != nil {
+			m.Problems = append(m.Problems, fmt.Sprintf("%s is not valid JSON", strings.ToLower(name)))
+			continue
+		}
+		m.Fields = append(m.Fields, Field{name, pretty.String()})
+
+		if i == 0 {
+			json.Unmarshal(data, &header)
+			continue
+		}
+		var claims map[string]any
+		if json.Unmarshal(data, &claims) == nil {
+			for _, claim := range jwtTimeClaims {
+				if v, ok := claims[claim].(float64); ok {
+					m.Fields = append(m.Fields, Field{claim, time.Unix(int64(v), 0).UTC().Format(time.RFC3339)})
+				}
+			}
+		}
+	}
+
+	switch {
+	case header.Alg == "none" || header.Alg == "":
+		m.Summary = "JWT (unsigned)"
+	case parts[2] == "":
+		m.Problems = append(m.Problems, "signature is missing")
+	default:
+		m.Summary = fmt.Sprintf("JWT %s (signature not verified)", header.Alg)
+	}
+	return m
+}
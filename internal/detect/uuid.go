@@ -0,0 +1,158 @@
+package detect
+
+import (
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	uuidPattern = regexp.MustCompile(`(?i)\b[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}\b`)
+	ulidPattern = regexp.MustCompile(`(?i)\b[0-9a-hjkmnp-tv-z]{26}\b`)
+)
+
+// uuidVersions describes the UUID versions of RFC 9562.
+var uuidVersions = map[int]string{
+	1: "time-based",
+	2: "DCE security",
+	3: "name-based, MD5",
+	4: "random",
+	5: "name-based, SHA-1",
+	6: "reordered time-based",
+	7: "Unix time-based",
+	8: "custom",
+}
+
+// gregorianOffset is the number of 100 ns intervals between the UUID
+// epoch (1582-10-15) and the Unix epoch.
+const gregorianOffset = 122192928000000000
+
+// uuids finds UUIDs in their canonical 8-4-4-4-12 form.
+func uuids(s string) []Match {
+	var matches []Match
+	for _, loc := range uuidPattern.FindAllStringIndex(s, -1) {
+		matches = append(matches, decodeUUID(s[loc[0]:loc[1]], loc[0]))
+	}
+	return matches
+}
+
+// decodeUUID decodes the version, variant, and any embedded timestamp.
+func decodeUUID(text string, start int) Match {
+	m := Match{Kind: "UUID", Text: text, Start: start, End: start + len(text)}
+	b, _ := hex.DecodeString(strings.ReplaceAll(text, "-", ""))
+
+	switch strings.ToLower(text) {
+	case "00000000-0000-0000-0000-000000000000":
+		m.Summary = "Nil UUID"
+		return m
+	case "ffffffff-ffff-ffff-ffff-ffffffffffff":
+		m.Summary = "Max UUID"
+		return m
+	}
+
+	version := int(b[6] >> 4)
+	var variant string
+	switch {
+	case b[8]&0x80 == 0:
+		variant = "NCS (reserved)"
+	case b[8]&0xC0 == 0x80:
+		variant = "RFC 9562"
+	case b[8]&0xE0 == 0xC0:
+		variant = "Microsoft (reserved)"
+	default:
+		variant = "future (reserved)"
+	}
+	m.Fields = append(m.Fields, Field{"Variant", variant})
+
+	if variant != "RFC 9562" {
+		m.Summary = "UUID with " + variant + " variant"
+		m.Problems = append(m.Problems, "variant is not RFC 9562, so the version is meaningless")
+		return m
+	}
+
+	name, ok := uuidVersions[version]
+	if !ok {
+		m.Summary = fmt.Sprintf("UUID with unknown version %d", version)
+		m.Problems = append(m.Problems, fmt.Sprintf("version %d is not defined", version))
+		return m
+	}
+	m.Summary = fmt.Sprintf("UUID v%d (%s)", version, name)
+	m.Fields = append([]Field{{"Version", fmt.Sprintf("%d (%s)", version, name)}}, m.Fields...)
+
+	switch version {
+	case 1, 6:
+		var ticks uint64 // 100 ns intervals since 1582-10-15
+		timeLow := uint64(b[0])<<24 | uint64(b[1])<<16 | uint64(b[2])<<8 | uint64(b[3])
+		timeMid := uint64(b[4])<<8 | uint64(b[5])
+		timeHigh := uint64(b[6]&0x0F)<<8 | uint64(b[7])
+		if version == 1 {
+			ticks = timeHigh<<48 | timeMid<<32 | timeLow
+		} else {
+			ticks = (timeLow<<16|timeMid)<<12 | timeHigh
+		}
+		unix100ns := int64(ticks) - gregorianOffset
+		t := time.Unix(unix100ns/1e7, unix100ns%1e7*100).UTC()
+		m.Fields = append(m.Fields,
+			Field{"Timestamp", t.Format(time.RFC3339Nano)},
+			Field{"Clock sequence", fmt.Sprintf("%d", int(b[8]&0x3F)<<8|int(b[9]))},
+			Field{"Node", formatNode(b[10:])},
+		)
+	case 7:
+		ms := int64(b[0])<<40 | int64(b[1])<<32 | int64(b[2])<<24 | int64(b[3])<<16 | int64(b[4])<<8 | int64(b[5])
+		m.Fields = append(m.Fields, Field{"Timestamp", time.UnixMilli(ms).UTC().Format(time.RFC3339Nano)})
+	}
+	return m
+}
+
+// formatNode formats the node field of a time-based UUID, noting whether
+// it is a real MAC address or random.
+func formatNode(node []byte) string {
+	parts := make([]string, len(node))
+	for i, x := range node {
+		parts[i] = fmt.Sprintf("%02x", x)
+	}
+	kind := "MAC address"
+	if node[0]&0x01 != 0 {
+		kind = "random"
+	}
+	return strings.Join(parts, ":") + " (" + kind + ")"
+}
+
+// crockford is the Crockford base32 alphabet used by ULIDs.
+const crockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// ulids finds ULIDs: 26 Crockford base32 characters.
+func ulids(s string) []Match {
+	var matches []Match
+	for _, loc := range ulidPattern.FindAllStringIndex(s, -1) {
+		text := s[loc[0]:loc[1]]
+		if !strings.ContainsAny(text, "0123456789") {
+			continue // A long word, not an identifier
+		}
+		matches = append(matches, decodeULID(text, loc[0]))
+	}
+	return matches
+}
+
+// decodeULID decodes the timestamp and randomness of a ULID.
+func decodeULID(text string, start int) Match {
+	m := Match{Kind: "ULID", Summary: "ULID", Text: text, Start: start, End: start + len(text)}
+
+	upper := strings.ToUpper(text)
+	if upper[0] > '7' {
+		m.Problems = append(m.Problems, "first character must be 0-7, or the timestamp overflows 48 bits")
+		return m
+	}
+
+	var ms int64
+	for _, c := range upper[:10] {
+		ms = ms<<5 | int64(strings.IndexRune(crockford, c))
+	}
+	m.Fields = []Field{
+		{"Timestamp", time.UnixMilli(ms).UTC().Format(time.RFC3339Nano)},
+		{"Randomness", upper[10:]},
+	}
+	return m
+}
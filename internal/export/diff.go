@@ -0,0 +1,217 @@
+package export
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"stringinspect/internal/analysis"
+)
+
+// DiffSide is one of the two texts of a diff export.
+type DiffSide struct {
+	Name     string // File name, or the pane of the split view
+	Encoding string // Encoding the text was decoded from, "" if not from a file
+	Text     string
+}
+
+// JSONDiffSide describes one of the compared texts in JSON.
+type JSONDiffSide struct {
+	Name       string `json:"name"`
+	Encoding   string `json:"encoding,omitempty"`
+	Characters int    `json:"characters"`
+}
+
+// JSONDiffPair is a differing position of the alignment in JSON. A and B
+// are the rune offsets in each text, -1 on the side without a character;
+// Line and Column locate the position in A, or in B for an insertion.
+type JSONDiffPair struct {
+	Op       string `json:"op"`
+	A        int    `json:"a"`
+	B        int    `json:"b"`
+	Line     int    `json:"line"`
+	Column   int    `json:"column"`
+	UnicodeA string `json:"unicode_a,omitempty"`
+	NameA    string `json:"name_a,omitempty"`
+	UnicodeB string `json:"unicode_b,omitempty"`
+	NameB    string `json:"name_b,omitempty"`
+	Class    string `json:"class,omitempty"` // e.g. "whitespace", "lookalike", "invisible"
+}
+
+// JSONDiff is the JSON export of a comparison of two texts: each side and
+// every position where they differ.
+type JSONDiff struct {
+	A           JSONDiffSide   `json:"a"`
+	B           JSONDiffSide   `json:"b"`
+	ExportedAt  string         `json:"exported_at"`
+	Identical   bool           `json:"identical"`
+	Classes     map[string]int `json:"classes"` // Differences of each class
+	Differences []JSONDiffPair `json:"differences"`
+}
+
+// NewJSONDiff compares two texts and converts the differences to JSON.
+func NewJSONDiff(a, b DiffSide) JSONDiff {
+	out := JSONDiff{
+		A:           JSONDiffSide{Name: a.Name, Encoding: a.Encoding, Characters: len([]rune(a.Text))},
+		B:           JSONDiffSide{Name: b.Name, Encoding: b.Encoding, Characters: len([]rune(b.Text))},
+		ExportedAt:  time.Now().Format(time.RFC3339),
+		Identical:   a.Text == b.Text,
+		Classes:     make(map[string]int),
+		Differences: []JSONDiffPair{},
+	}
+
+	linesA, linesB := newLineStarts(a.Text), newLineStarts(b.Text)
+	for _, p := range analysis.AlignTexts(a.Text, b.Text) {
+		if p.Op == analysis.DiffSame {
+			continue
+		}
+		jp := JSONDiffPair{Op: p.Op.String(), A: p.A, B: p.B, Class: p.Class}
+		if p.A >= 0 {
+			jp.UnicodeA, jp.NameA = fmt.Sprintf("U+%04X", p.RuneA), analysis.Name(p.RuneA)
+			jp.Line, jp.Column = linesA.position(p.A)
+		} else {
+			jp.Line, jp.Column = linesB.position(p.B)
+		}
+		if p.B >= 0 {
+			jp.UnicodeB, jp.NameB = fmt.Sprintf("U+%04X", p.RuneB), analysis.Name(p.RuneB)
+		}
+		if p.Class != "" {
+			out.Classes[p.Class]++
+		}
+		out.Differences = append(out.Differences, jp)
+	}
+	return out
+}
+
+// lineStarts holds the rune offsets at which the lines of a text start.
+type lineStarts []int
+
+// newLineStarts finds the lines of s, which end at LF.
+func newLineStarts(s string) lineStarts {
+	starts := lineStarts{0}
+	i := 0
+	for _, r := range s {
+		i++
+		if r == '\n' {
+			starts = append(starts, i)
+		}
+	}
+	return starts
+}
+
+// position returns the 1-based line and column of a rune offset.
+func (ls lineStarts) position(offset int) (int, int) {
+	line := 0
+	for line+1 < len(ls) && ls[line+1] <= offset {
+		line++
+	}
+	return line + 1, offset - ls[line] + 1
+}
+
+// WriteDiff writes the comparison of two texts to w as a plain text
+// report (FormatText) or JSON (FormatJSON).
+func WriteDiff(w io.Writer, a, b DiffSide, format Format) error {
+	d := NewJSONDiff(a, b)
+	switch format {
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "  ")
+		return enc.Encode(d)
+	case FormatText:
+		return writeDiffText(w, d)
+	default:
+		return fmt.Errorf("diffs export as %s or %s, not %s", FormatText, FormatJSON, format)
+	}
+}
+
+// writeDiffText writes a diff as a report of one line per difference,
+// such as "rune 47, line 3, column 5: U+00A0 NO-BREAK SPACE vs U+0020
+// SPACE [whitespace]".
+func writeDiffText(w io.Writer, d JSONDiff) error {
+	bw := bufio.NewWriter(w)
+
+	bw.WriteString("StringInspect Diff\n")
+	bw.WriteString("==================\n\n")
+	for _, side := range []struct {
+		label string
+		JSONDiffSide
+	}{{"A", d.A}, {"B", d.B}} {
+		fmt.Fprintf(bw, "%s: %s (", side.label, side.Name)
+		if side.Encoding != "" {
+			fmt.Fprintf(bw, "%s, ", side.Encoding)
+		}
+		fmt.Fprintf(bw, "%d characters)\n", side.Characters)
+	}
+	bw.WriteString("\n")
+
+	if d.Identical {
+		bw.WriteString("The texts are identical\n")
+		return bw.Flush()
+	}
+	fmt.Fprintf(bw, "%d differences\n\n", len(d.Differences))
+	for _, p := range d.Differences {
+		where := fmt.Sprintf("rune %d", p.A)
+		switch {
+		case p.Op == "inserted":
+			where = fmt.Sprintf("rune %d of B", p.B)
+		case p.B >= 0 && p.B != p.A:
+			where = fmt.Sprintf("rune %d (B %d)", p.A, p.B)
+		}
+		fmt.Fprintf(bw, "%s, line %d, column %d: ", where, p.Line, p.Column)
+
+		switch p.Op {
+		case "changed":
+			fmt.Fprintf(bw, "%s %s vs %s %s", p.UnicodeA, p.NameA, p.UnicodeB, p.NameB)
+		case "deleted":
+			fmt.Fprintf(bw, "only in A: %s %s", p.UnicodeA, p.NameA)
+		case "inserted":
+			fmt.Fprintf(bw, "only in B: %s %s", p.UnicodeB, p.NameB)
+		}
+		if p.Class != "" {
+			fmt.Fprintf(bw, " [%s]", p.Class)
+		}
+		bw.WriteString("\n")
+	}
+	return bw.Flush()
+}
+
+// ExportDiffTo writes the comparison of two texts to path and returns its
+// absolute path. Unless overwrite is set, an existing file is left
+// untouched and ErrFileExists is returned.
+func (e *Exporter) ExportDiffTo(path string, a, b DiffSide, format Format, overwrite bool) (string, error) {
+	if format != FormatText && format != FormatJSON {
+		return "", fmt.Errorf("diffs export as %s or %s, not %s", FormatText, FormatJSON, format)
+	}
+
+	flags := os.O_WRONLY | os.O_CREATE | os.O_EXCL
+	if overwrite {
+		flags = os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	}
+	file, err := os.OpenFile(path, flags, 0644)
+	if errors.Is(err, fs.ErrExist) {
+		return "", fmt.Errorf("%s: %w", path, ErrFileExists)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to create file: %w", err)
+	}
+
+	if err := WriteDiff(file, a, b, format); err != nil {
+		file.Close()
+		os.Remove(path)
+		return "", err
+	}
+	if err := file.Close(); err != nil {
+		return "", fmt.Errorf("failed to close file: %w", err)
+	}
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	return path, nil
+}
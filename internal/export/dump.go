@@ -0,0 +1,193 @@
+package export
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"stringinspect/internal/analysis"
+)
+
+// BytesPerDumpLine is how many bytes a line of the grouped hex dump shows.
+const BytesPerDumpLine = 16
+
+// DumpOptions lays out the hex dump FormatDump writes, as the TUI's compact
+// view does with the same settings.
+type DumpOptions struct {
+	Width    int                // Columns the lines must fit; 0 for no limit
+	Group    int                // Bytes per group; above 1 the dump shows bytes
+	Little   bool               // Groups are read little-endian
+	Codepage *analysis.Codepage // Text column of the byte dump; nil for ASCII
+}
+
+// bytes reports whether the dump shows bytes rather than codepoints.
+func (o DumpOptions) bytes() bool {
+	return o.Group > 1 || o.Codepage != nil
+}
+
+// DumpLayout is the layout of the codepoint dump: characters per line and
+// the widths of the hex and glyph cells.
+type DumpLayout struct {
+	PerLine    int
+	HexWidth   int
+	GlyphWidth int
+}
+
+// NewDumpLayout returns the layout of the codepoint dump of chars in width
+// columns: 16 characters per line, or fewer halvings to fit narrow ones.
+// Cells are as wide as the widest codepoint and glyph, so rows with CJK or
+// emoji stay aligned with plain ASCII rows.
+func NewDumpLayout(chars []analysis.Character, width int) DumpLayout {
+	l := DumpLayout{PerLine: 16, HexWidth: 2, GlyphWidth: 1}
+	for _, c := range chars {
+		l.HexWidth = max(l.HexWidth, len(c.Hex))
+		l.GlyphWidth = max(l.GlyphWidth, lipgloss.Width(DumpGlyph(c)))
+	}
+	for width > 0 && l.PerLine > 4 && 6+l.PerLine*(l.HexWidth+1+l.GlyphWidth)+4 > width {
+		l.PerLine /= 2
+	}
+	return l
+}
+
+// DumpGlyph returns the glyph shown in the text column of the hex dump, or
+// "." for characters without a single-glyph form. Combining marks are
+// shown on a dotted circle.
+func DumpGlyph(c analysis.Character) string {
+	g := c.Char
+	if unicode.Is(unicode.M, c.Rune) {
+		g = "◌" + c.Char
+	}
+	if w := lipgloss.Width(g); w < 1 || w > 2 || utf8.RuneCountInString(c.Char) != 1 {
+		return "."
+	}
+	return g
+}
+
+// DumpPainter styles a cell of a dump line: the cells of character i, or
+// the offset for i = -1.
+type DumpPainter func(i int, s string) string
+
+// plainPainter leaves cells as they are.
+func plainPainter(_ int, s string) string { return s }
+
+// DumpLine renders the line of the codepoint dump that starts at
+// character i: its offset, the codepoints in hex, and the glyphs. A nil
+// paint leaves it plain.
+func DumpLine(chars []analysis.Character, i int, l DumpLayout, paint DumpPainter) string {
+	if paint == nil {
+		paint = plainPainter
+	}
+	var b strings.Builder
+
+	// Offsets are original positions, which differ from i when filtered
+	b.WriteString(paint(-1, fmt.Sprintf("%04X  ", chars[i].RuneOffset)))
+
+	for j := range l.PerLine {
+		if idx := i + j; idx < len(chars) {
+			b.WriteString(paint(idx, fmt.Sprintf("%*s", l.HexWidth, chars[idx].Hex)) + " ")
+		} else {
+			b.WriteString(strings.Repeat(" ", l.HexWidth+1))
+		}
+
+		// Extra space in middle
+		if j == l.PerLine/2-1 {
+			b.WriteString(" ")
+		}
+	}
+
+	b.WriteString(" │ ")
+	for idx := i; idx < i+l.PerLine && idx < len(chars); idx++ {
+		g := DumpGlyph(chars[idx])
+		b.WriteString(paint(idx, g+strings.Repeat(" ", max(l.GlyphWidth-lipgloss.Width(g), 0))))
+	}
+	return b.String()
+}
+
+// DumpByte is one byte of the grouped hex dump and the character it
+// belongs to.
+type DumpByte struct {
+	Value  byte
+	Offset int
+	Char   int // Index into the characters
+}
+
+// DumpBytes lists the UTF-8 bytes of chars, which are the file's bytes for
+// a file analyzed byte by byte.
+func DumpBytes(chars []analysis.Character) []DumpByte {
+	var bytes []DumpByte
+	for i, c := range chars {
+		for j, b := range c.UTF8Bytes {
+			bytes = append(bytes, DumpByte{b, c.ByteOffset + j, i})
+		}
+	}
+	return bytes
+}
+
+// ByteDumpLine renders a row of the grouped hex dump. Groups are shown in
+// the chosen byte order like `xxd -e`, and the text column shows each byte
+// as ASCII or as the chosen codepage would. A nil paint leaves it plain.
+func ByteDumpLine(row []DumpByte, o DumpOptions, paint DumpPainter) string {
+	if paint == nil {
+		paint = plainPainter
+	}
+	var b strings.Builder
+
+	b.WriteString(paint(-1, fmt.Sprintf("%04X  ", row[0].Offset)))
+
+	group := max(o.Group, 1)
+	for g := 0; g < BytesPerDumpLine; g += group {
+		for j := range group {
+			// Little-endian groups are shown most significant byte first
+			k := g + j
+			if o.Little {
+				k = g + group - 1 - j
+			}
+			if k >= len(row) {
+				b.WriteString("  ")
+				continue
+			}
+			b.WriteString(paint(row[k].Char, fmt.Sprintf("%02X", row[k].Value)))
+		}
+		b.WriteString(" ")
+	}
+
+	b.WriteString("│ ")
+	for _, d := range row {
+		text := "."
+		if o.Codepage != nil {
+			text = string(o.Codepage.Rune(d.Value))
+		} else if d.Value >= 0x20 && d.Value < 0x7F {
+			text = string(rune(d.Value))
+		}
+		b.WriteString(paint(d.Char, text))
+	}
+	return b.String()
+}
+
+// exportDump writes every line of the hex dump as plain text, laid out
+// as the compact view would with e.Dump.
+func (e *Exporter) exportDump(w io.Writer, chars []analysis.Character) error {
+	bw := bufio.NewWriter(w)
+	line := func(s string) {
+		bw.WriteString(strings.TrimRight(s, " "))
+		bw.WriteString("\n")
+	}
+
+	if e.Dump.bytes() {
+		bytes := DumpBytes(chars)
+		for i := 0; i < len(bytes); i += BytesPerDumpLine {
+			line(ByteDumpLine(bytes[i:min(i+BytesPerDumpLine, len(bytes))], e.Dump, nil))
+		}
+	} else {
+		l := NewDumpLayout(chars, e.Dump.Width)
+		for i := 0; i < len(chars); i += l.PerLine {
+			line(DumpLine(chars, i, l, nil))
+		}
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,234 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+
+	"stringinspect/internal/analysis"
+)
+
+// EscapeStyle selects the target language syntax for Unicode escapes.
+type EscapeStyle int
+
+const (
+	EscapeGo EscapeStyle = iota
+	EscapeC
+	EscapePython
+	EscapeJavaScript
+	EscapeJava
+	EscapeRust
+)
+
+// EscapeStyles lists every escape style in cycling order.
+var EscapeStyles = []EscapeStyle{EscapeGo, EscapeC, EscapePython, EscapeJavaScript, EscapeJava, EscapeRust}
+
+func (s EscapeStyle) String() string {
+	switch s {
+	case EscapeGo:
+		return "Go"
+	case EscapeC:
+		return "C"
+	case EscapePython:
+		return "Python"
+	case EscapeJavaScript:
+		return "JavaScript"
+	case EscapeJava:
+		return "Java"
+	case EscapeRust:
+		return "Rust"
+	default:
+		return "Unknown"
+	}
+}
+
+// Next returns the style following s, wrapping around at the end.
+func (s EscapeStyle) Next() EscapeStyle {
+	return EscapeStyles[(int(s)+1)%len(EscapeStyles)]
+}
+
+// EscapeUnicode renders s with every non-ASCII character replaced by a
+// Unicode escape in the given style. ASCII control characters are escaped
+// too, so the result is safe to print; other ASCII characters are kept
+// as-is.
+func EscapeUnicode(s string, style EscapeStyle) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r < 0x20 || r == 0x7F {
+			b.WriteString(escapeControl(r, style))
+			continue
+		}
+		if r < 0x80 {
+			b.WriteRune(r)
+			continue
+		}
+
+		switch style {
+		case EscapeJavaScript, EscapeJava:
+			// UTF-16 based languages need surrogate pairs above the BMP
+			if r > 0xFFFF {
+				hi, lo := utf16.EncodeRune(r)
+				b.WriteString(fmt.Sprintf("\\u%04X\\u%04X", hi, lo))
+			} else {
+				b.WriteString(fmt.Sprintf("\\u%04X", r))
+			}
+		case EscapeRust:
+			b.WriteString(fmt.Sprintf("\\u{%X}", r))
+		case EscapeC:
+			// C forbids universal character names below U+00A0, so the C1
+			// controls are written as their UTF-8 bytes in octal
+			if r < 0xA0 {
+				for _, c := range []byte(string(r)) {
+					b.WriteString(fmt.Sprintf("\\%03o", c))
+				}
+				continue
+			}
+			fallthrough
+		default:
+			if r > 0xFFFF {
+				b.WriteString(fmt.Sprintf("\\U%08X", r))
+			} else {
+				b.WriteString(fmt.Sprintf("\\u%04X", r))
+			}
+		}
+	}
+	return b.String()
+}
+
+// escapeControl escapes an ASCII control character. Java has no \x
+// escapes, and C's run on for as many hex digits as follow, so those use
+// \u and octal.
+func escapeControl(r rune, style EscapeStyle) string {
+	switch r {
+	case '\n':
+		return `\n`
+	case '\r':
+		return `\r`
+	case '\t':
+		return `\t`
+	}
+	switch style {
+	case EscapeJava:
+		return fmt.Sprintf("\\u%04X", r)
+	case EscapeC:
+		return fmt.Sprintf("\\%03o", r)
+	default:
+		return fmt.Sprintf("\\x%02X", r)
+	}
+}
+
+// exportEscaped exports the original string with Unicode escapes.
+func (e *Exporter) exportEscaped(w io.Writer, chars []analysis.Character) error {
+	escaped := EscapeUnicode(originalString(chars), e.EscapeStyle)
+	_, err := io.WriteString(w, escaped+"\n")
+	return err
+}
+
+// originalString reconstructs the analyzed string from the character runes.
+func originalString(chars []analysis.Character) string {
+	var b strings.Builder
+	for _, c := range chars {
+		b.WriteRune(c.Rune)
+	}
+	return b.String()
+}
+
+// Unescape interprets backslash escapes in s, accepting every style that
+// EscapeUnicode produces: \uXXXX (including UTF-16 surrogate pairs),
+// \UXXXXXXXX, and \u{X...}, plus \n, \r, \t, \\, \xHH (read as a
+// codepoint, as in Python and JavaScript, so the result stays valid UTF-8),
+// and C's octal \NNN (read as a byte, so C escapes of UTF-8 bytes decode).
+func Unescape(s string) (string, error) {
+	if !strings.Contains(s, "\\") {
+		return s, nil
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' {
+			b.WriteByte(s[i])
+			continue
+		}
+		if i+1 >= len(s) {
+			return "", fmt.Errorf("trailing backslash")
+		}
+
+		i++
+		switch c := s[i]; c {
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 't':
+			b.WriteByte('\t')
+		case '0', '1', '2', '3', '4', '5', '6', '7':
+			n := 1
+			for n < 3 && i+n < len(s) && s[i+n] >= '0' && s[i+n] <= '7' {
+				n++
+			}
+			v, err := strconv.ParseUint(s[i:i+n], 8, 8)
+			if err != nil {
+				return "", fmt.Errorf("invalid escape \\%s", s[i:i+n])
+			}
+			b.WriteByte(byte(v))
+			i += n - 1
+		case '\\':
+			b.WriteByte('\\')
+		case 'x', 'u', 'U':
+			r, n, err := unescapeCodepoint(s[i:])
+			if err != nil {
+				return "", err
+			}
+			i += n - 1
+
+			// Combine a UTF-16 surrogate pair written as two \u escapes
+			if utf16.IsSurrogate(r) && strings.HasPrefix(s[i+1:], "\\u") {
+				if lo, m, err := unescapeCodepoint(s[i+2:]); err == nil && utf16.IsSurrogate(lo) {
+					r = utf16.DecodeRune(r, lo)
+					i += m + 1
+				}
+			}
+			b.WriteRune(r)
+		default:
+			return "", fmt.Errorf("unknown escape \\%c", c)
+		}
+	}
+	return b.String(), nil
+}
+
+// unescapeCodepoint parses the escape body starting at the 'x', 'u', or 'U'
+// in s and returns the value and the number of bytes consumed.
+func unescapeCodepoint(s string) (rune, int, error) {
+	digits := 4
+	switch s[0] {
+	case 'x':
+		digits = 2
+	case 'U':
+		digits = 8
+	}
+
+	// Rust-style \u{1F600}
+	if s[0] == 'u' && strings.HasPrefix(s, "u{") {
+		end := strings.IndexByte(s, '}')
+		if end < 0 {
+			return 0, 0, fmt.Errorf("unterminated \\u{ escape")
+		}
+		v, err := strconv.ParseUint(s[2:end], 16, 32)
+		if err != nil || v > unicode.MaxRune {
+			return 0, 0, fmt.Errorf("invalid escape \\%s", s[:end+1])
+		}
+		return rune(v), end + 1, nil
+	}
+
+	if len(s) < 1+digits {
+		return 0, 0, fmt.Errorf("short escape \\%s", s)
+	}
+	v, err := strconv.ParseUint(s[1:1+digits], 16, 32)
+	if err != nil || v > unicode.MaxRune {
+		return 0, 0, fmt.Errorf("invalid escape \\%s", s[:1+digits])
+	}
+	return rune(v), 1 + digits, nil
+}
@@ -1,13 +1,20 @@
 package export
 
 import (
+	"bufio"
 	"encoding/csv"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"io/fs"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
+	"github.com/charmbracelet/lipgloss"
+
 	"stringinspect/internal/analysis"
 )
 
@@ -18,8 +25,21 @@ const (
 	FormatText Format = iota
 	FormatJSON
 	FormatCSV
+	FormatGoBytes
+	FormatCArray
+	FormatEscaped
+	FormatXLSX
+	FormatTemplate
+	FormatSVG
+	FormatProtobuf
+	FormatRaw
+	FormatSkeleton
+	FormatDump
 )
 
+// Formats lists every export format in menu order.
+var Formats = []Format{FormatText, FormatJSON, FormatCSV, FormatXLSX, FormatSVG, FormatGoBytes, FormatCArray, FormatEscaped, FormatRaw, FormatSkeleton, FormatProtobuf, FormatTemplate, FormatDump}
+
 func (f Format) String() string {
 	switch f {
 	case FormatText:
@@ -28,11 +48,65 @@ func (f Format) String() string {
 		return "JSON"
 	case FormatCSV:
 		return "CSV"
+	case FormatGoBytes:
+		return "Go"
+	case FormatCArray:
+		return "C"
+	case FormatEscaped:
+		return "Escaped"
+	case FormatXLSX:
+		return "XLSX"
+	case FormatTemplate:
+		return "Template"
+	case FormatSVG:
+		return "SVG"
+	case FormatProtobuf:
+		return "Protobuf"
+	case FormatRaw:
+		return "Raw"
+	case FormatSkeleton:
+		return "Skeleton"
+	case FormatDump:
+		return "Dump"
 	default:
 		return "Unknown"
 	}
 }
 
+// Description returns a short human-readable description of the format.
+func (f Format) Description() string {
+	switch f {
+	case FormatText:
+		return "Plain text table"
+	case FormatJSON:
+		return "Structured JSON"
+	case FormatCSV:
+		return "Comma-separated values"
+	case FormatGoBytes:
+		return "Go []byte literal"
+	case FormatCArray:
+		return "C unsigned char[] initializer"
+	case FormatEscaped:
+		return "String with Unicode escapes"
+	case FormatXLSX:
+		return "Excel workbook"
+	case FormatTemplate:
+		return "Custom text/template file"
+	case FormatSVG:
+		return "Colored hex dump image"
+	case FormatProtobuf:
+		return "Binary stringinspect.v1.Analysis message"
+	case FormatRaw:
+		return "Text re-encoded as raw bytes"
+	case FormatSkeleton:
+		return "Lines with their UTS #39 lookalike skeleton"
+	case FormatDump:
+		return "Hex dump as the compact view shows it"
+	default:
+		return ""
+	}
+}
+
 // Extension returns the file extension for the format.
 func (f Format) Extension() string {
 	switch f {
@@ -42,94 +116,257 @@ func (f Format) Extension() string {
 		return "json"
 	case FormatCSV:
 		return "csv"
+	case FormatGoBytes:
+		return "go"
+	case FormatCArray:
+		return "h"
+	case FormatXLSX:
+		return "xlsx"
+	case FormatSVG:
+		return "svg"
+	case FormatProtobuf:
+		return "pb"
+	case FormatSkeleton:
+		return "tsv"
 	default:
 		return "txt"
 	}
 }
 
 // Exporter handles exporting character analysis to various formats.
-type Exporter struct{}
+type Exporter struct {
+	// EscapeStyle selects the escape syntax used by FormatEscaped.
+	EscapeStyle EscapeStyle
+
+	// TemplatePath is the text/template file rendered by FormatTemplate.
+	TemplatePath string
+
+	// Transcoding selects the encoding FormatRaw writes the text in.
+	Transcoding Transcoding
+
+	// Dump lays out the hex dump FormatDump writes.
+	Dump DumpOptions
+
+	// Renderer, when set, colors the rows of FormatText by character type
+	// as far as its terminal allows, for printing to one.
+	Renderer *lipgloss.Renderer
+}
 
 // NewExporter creates a new Exporter.
 func NewExporter() *Exporter {
 	return &Exporter{}
 }
 
-// Export exports the characters to the specified format and returns the filename.
+// ErrFileExists is returned by ExportTo when the target exists and
+// overwriting was not requested.
+var ErrFileExists = errors.New("file already exists")
+
+// Export exports the characters to the specified format under a timestamped
+// filename and returns its absolute path. A counter suffix is appended when
+// a file with the same name already exists.
 func (e *Exporter) Export(chars []analysis.Character, format Format) (string, error) {
 	if len(chars) == 0 {
 		return "", fmt.Errorf("no characters to export")
 	}
 
 	// Generate filename with timestamp
-	timestamp := time.Now().Format("20060102-150405")
-	filename := fmt.Sprintf("stringinspect-%s.%s", timestamp, format.Extension())
+	base := "stringinspect-" + time.Now().Format("20060102-150405")
+	for n := 0; ; n++ {
+		filename := e.numberedFilename(base, n, format)
+
+		// O_EXCL makes the existence check and creation atomic
+		file, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+		if errors.Is(err, fs.ErrExist) {
+			continue
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to create file: %w", err)
+		}
+
+		return e.writeFile(file, chars, format)
+	}
+}
+
+// DefaultFilename returns the timestamped filename Export would currently
+// choose for format.
+func (e *Exporter) DefaultFilename(format Format) string {
+	base := "stringinspect-" + time.Now().Format("20060102-150405")
+	for n := 0; ; n++ {
+		filename := e.numberedFilename(base, n, format)
+		if _, err := os.Stat(filename); errors.Is(err, fs.ErrNotExist) {
+			return filename
+		}
+	}
+}
+
+// ExportTo exports the characters to path and returns its absolute path.
+// Unless overwrite is set, an existing file is left untouched and
+// ErrFileExists is returned.
+func (e *Exporter) ExportTo(path string, chars []analysis.Character, format Format, overwrite bool) (string, error) {
+	if len(chars) == 0 {
+		return "", fmt.Errorf("no characters to export")
+	}
+
+	flags := os.O_WRONLY | os.O_CREATE | os.O_EXCL
+	if overwrite {
+		flags = os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	}
+
+	file, err := os.OpenFile(path, flags, 0644)
+	if errors.Is(err, fs.ErrExist) {
+		return "", fmt.Errorf("%s: %w", path, ErrFileExists)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to create file: %w", err)
+	}
+
+	return e.writeFile(file, chars, format)
+}
+
+// writeFile writes the export to an open file, closes it, and returns its
+// absolute path. The file is removed if writing fails.
+func (e *Exporter) writeFile(file *os.File, chars []analysis.Character, format Format) (string, error) {
+	filename := file.Name()
+
+	if err := e.Write(file, chars, format); err != nil {
+		file.Close()
+		os.Remove(filename)
+		return "", err
+	}
+
+	if err := file.Close(); err != nil {
+		return "", fmt.Errorf("failed to close file: %w", err)
+	}
+
+	if abs, err := filepath.Abs(filename); err == nil {
+		filename = abs
+	}
+	return filename, nil
+}
+
+// numberedFilename returns base with the format extension, adding a
+// "-n" counter suffix for n > 0.
+func (e *Exporter) numberedFilename(base string, n int, format Format) string {
+	if n > 0 {
+		base = fmt.Sprintf("%s-%d", base, n)
+	}
+	return base + "." + e.extension(format)
+}
 
-	var err error
+// Write writes the characters in the specified format to w.
+func (e *Exporter) Write(w io.Writer, chars []analysis.Character, format Format) error {
 	switch format {
 	case FormatText:
-		err = e.exportText(chars, filename)
+		return e.exportText(w, chars)
 	case FormatJSON:
-		err = e.exportJSON(chars, filename)
+		return e.exportJSON(w, chars)
 	case FormatCSV:
-		err = e.exportCSV(chars, filename)
+		return e.exportCSV(w, chars)
+	case FormatXLSX:
+		return e.exportXLSX(w, chars)
+	case FormatSVG:
+		return e.exportSVG(w, chars)
+	case FormatProtobuf:
+		return e.exportProtobuf(w, chars)
+	case FormatGoBytes, FormatCArray:
+		return e.exportSource(w, chars, format)
+	case FormatEscaped:
+		return e.exportEscaped(w, chars)
+	case FormatRaw:
+		return e.exportRaw(w, chars)
+	case FormatSkeleton:
+		return e.exportSkeleton(w, chars)
+	case FormatTemplate:
+		return e.exportTemplate(w, chars)
+	case FormatDump:
+		return e.exportDump(w, chars)
 	default:
-		return "", fmt.Errorf("unsupported format: %v", format)
+		return fmt.Errorf("unsupported format: %v", format)
 	}
+}
 
-	if err != nil {
-		return "", err
+// extension returns the file extension used when exporting in format.
+func (e *Exporter) extension(format Format) string {
+	if format == FormatTemplate && e.TemplatePath != "" {
+		return templateExtension(e.TemplatePath)
 	}
-
-	return filename, nil
+	return format.Extension()
 }
 
-// exportText exports characters to a text file.
-func (e *Exporter) exportText(chars []analysis.Character, filename string) error {
-	var b strings.Builder
+// exportText exports characters as a plain text table.
+// Rows are streamed through a buffered writer rather than built in memory.
+func (e *Exporter) exportText(w io.Writer, chars []analysis.Character) error {
+	bw := bufio.NewWriter(w)
 
-	b.WriteString("StringInspect Export\n")
-	b.WriteString("====================\n\n")
+	bw.WriteString("StringInspect Export\n")
+	bw.WriteString("====================\n\n")
 
 	// Original string
-	b.WriteString("Original: ")
+	bw.WriteString("Original: ")
 	for _, c := range chars {
-		b.WriteString(c.Char)
+		bw.WriteString(c.Char)
+	}
+	bw.WriteString("\n\n")
+
+	// Summary statistics
+	bw.WriteString("Summary\n-------\n")
+	for _, line := range summaryLines(analysis.Summarize(chars)) {
+		bw.WriteString(line + "\n")
 	}
-	b.WriteString("\n\n")
+	bw.WriteString("\n")
 
 	// Character table
-	b.WriteString(fmt.Sprintf("%-6s %-8s %-6s %-6s %-10s %-10s %-12s\n",
-		"Pos", "Char", "Hex", "Dec", "Oct", "Unicode", "UTF-8"))
-	b.WriteString(strings.Repeat("-", 70) + "\n")
+	fmt.Fprintf(bw, "%-6s %-8s %-6s %-6s %-10s %-10s %-12s\n",
+		"Pos", "Char", "Hex", "Dec", "Oct", "Unicode", "UTF-8")
+	bw.WriteString(strings.Repeat("-", 70) + "\n")
 
-	for i, c := range chars {
+	paint := e.typePainter()
+	for _, c := range chars {
 		charDisplay := c.Char
 		if len(charDisplay) > 6 {
 			charDisplay = charDisplay[:6]
 		}
-		b.WriteString(fmt.Sprintf("%-6d %-8s %-6s %-6d %-10s %-10s %-12s\n",
-			i, charDisplay, c.Hex, c.Dec, c.Oct, c.Unicode, c.UTF8Hex))
+		row := fmt.Sprintf("%-6d %-8s %-6s %-6d %-10s %-10s %-12s",
+			c.RuneOffset, charDisplay, c.Hex, c.Dec, c.Oct, c.Unicode, c.UTF8Hex)
+		bw.WriteString(paint(c.Type, row) + "\n")
 	}
 
-	b.WriteString(fmt.Sprintf("\nTotal: %d characters\n", len(chars)))
+	fmt.Fprintf(bw, "\nTotal: %d characters\n", len(chars))
+
+	return bw.Flush()
+}
 
-	return os.WriteFile(filename, []byte(b.String()), 0644)
+// typePainter returns a function that colors text by character type with
+// e.Renderer, or leaves it as is without one.
+func (e *Exporter) typePainter() func(t analysis.CharType, s string) string {
+	if e.Renderer == nil {
+		return func(_ analysis.CharType, s string) string { return s }
+	}
+	styles := make(map[analysis.CharType]lipgloss.Style)
+	return func(t analysis.CharType, s string) string {
+		style, ok := styles[t]
+		if !ok {
+			style = e.Renderer.NewStyle().Foreground(lipgloss.Color(typeColor(t)))
+			styles[t] = style
+		}
+		return style.Render(s)
+	}
 }
 
 // JSONCharacter is the JSON representation of a character.
 type JSONCharacter struct {
-	Position   int    `json:"position"`
-	Char       string `json:"char"`
-	Hex        string `json:"hex"`
-	Decimal    int    `json:"decimal"`
-	Octal      string `json:"octal"`
-	Binary     string `json:"binary"`
-	Unicode    string `json:"unicode"`
-	UTF8Bytes  string `json:"utf8_bytes"`
-	Type       string `json:"type"`
-	ByteOffset int    `json:"byte_offset"`
-	RuneOffset int    `json:"rune_offset"`
+	Position   int      `json:"position"`
+	Char       string   `json:"char"`
+	Hex        string   `json:"hex"`
+	Decimal    int      `json:"decimal"`
+	Octal      string   `json:"octal"`
+	Binary     string   `json:"binary"`
+	Unicode    string   `json:"unicode"`
+	UTF8Bytes  string   `json:"utf8_bytes"`
+	Type       string   `json:"type"`
+	ByteOffset int      `json:"byte_offset"`
+	RuneOffset int      `json:"rune_offset"`
+	Warnings   []string `json:"warnings"` // e.g. "invisible", "bidi-control", "confusable"
 }
 
 // JSONExport is the top-level JSON export structure.
@@ -137,60 +374,142 @@ type JSONExport struct {
 	Original   string          `json:"original"`
 	Count      int             `json:"count"`
 	ExportedAt string          `json:"exported_at"`
+	Summary    *JSONSummary    `json:"summary,omitempty"`
+	Warnings   *JSONWarnings   `json:"warnings,omitempty"`
 	Characters []JSONCharacter `json:"characters"`
 }
 
-// exportJSON exports characters to a JSON file.
-func (e *Exporter) exportJSON(chars []analysis.Character, filename string) error {
+// JSONWarnings summarizes the warnings of the characters of a JSON export.
+type JSONWarnings struct {
+	Flagged int            `json:"flagged"` // Characters with any warning
+	Counts  map[string]int `json:"counts"`  // Characters with each warning
+}
+
+// jsonWarningConfusable is the warning for a letter that passes for an
+// ASCII one among Latin letters, which depends on the word around it
+// rather than on the character alone.
+const jsonWarningConfusable = "confusable"
+
+// jsonWarnings finds the characters the word around them makes
+// confusable and summarizes the warnings of all characters, a pass ahead
+// of writing them that keeps none of their JSON representations.
+func jsonWarnings(chars []analysis.Character) (map[int]bool, JSONWarnings) {
+	runes := make([]rune, len(chars))
+	for i, c := range chars {
+		runes[i] = c.Rune
+	}
+	confusable := make(map[int]bool)
+	for _, i := range analysis.ConfusableOffsets(runes) {
+		confusable[i] = true
+	}
+
+	summary := JSONWarnings{Counts: make(map[string]int)}
+	for i, c := range chars {
+		warnings := c.Warnings()
+		for _, w := range warnings {
+			summary.Counts[w.String()]++
+		}
+		if confusable[i] {
+			summary.Counts[jsonWarningConfusable]++
+		}
+		if len(warnings) > 0 || confusable[i] {
+			summary.Flagged++
+		}
+	}
+	return confusable, summary
+}
+
+// exportJSON exports characters as JSON.
+// The output matches an indented JSONExport, but characters are encoded one
+// at a time so large analyses are never held in memory twice.
+func (e *Exporter) exportJSON(w io.Writer, chars []analysis.Character) error {
+	bw := bufio.NewWriter(w)
+
 	// Build original string
 	var original strings.Builder
 	for _, c := range chars {
 		original.WriteString(c.Char)
 	}
+	confusable, warnings := jsonWarnings(chars)
+
+	// The summary counts warnings from the same pass, confusable included,
+	// so the export has one warning total
+	summary := newJSONSummary(analysis.Summarize(chars))
+	summary.Warnings = warnings.Counts
+
+	header := []struct {
+		key   string
+		value any
+	}{
+		{"original", original.String()},
+		{"count", len(chars)},
+		{"exported_at", time.Now().Format(time.RFC3339)},
+		{"summary", summary},
+		{"warnings", warnings},
+	}
 
-	// Convert characters
-	jsonChars := make([]JSONCharacter, len(chars))
-	for i, c := range chars {
-		jsonChars[i] = JSONCharacter{
-			Position:   i,
-			Char:       c.Char,
-			Hex:        c.Hex,
-			Decimal:    int(c.Dec),
-			Octal:      c.Oct,
-			Binary:     c.Bin,
-			Unicode:    c.Unicode,
-			UTF8Bytes:  c.UTF8Hex,
-			Type:       c.Type.String(),
-			ByteOffset: c.ByteOffset,
-			RuneOffset: c.RuneOffset,
+	bw.WriteString("{\n")
+	for _, h := range header {
+		data, err := json.MarshalIndent(h.value, "  ", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
 		}
+		fmt.Fprintf(bw, "  %q: %s,\n", h.key, data)
 	}
 
-	export := JSONExport{
-		Original:   original.String(),
-		Count:      len(chars),
-		ExportedAt: time.Now().Format(time.RFC3339),
-		Characters: jsonChars,
+	bw.WriteString("  \"characters\": [")
+	for i, c := range chars {
+		jc := NewJSONCharacter(c)
+		if confusable[i] {
+			jc.Warnings = append(jc.Warnings, jsonWarningConfusable)
+		}
+		data, err := json.MarshalIndent(jc, "    ", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		if i > 0 {
+			bw.WriteString(",")
+		}
+		bw.WriteString("\n    ")
+		bw.Write(data)
 	}
-
-	data, err := json.MarshalIndent(export, "", "  ")
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON: %w", err)
+	if len(chars) > 0 {
+		bw.WriteString("\n  ")
 	}
+	bw.WriteString("]\n}\n")
 
-	return os.WriteFile(filename, data, 0644)
+	return bw.Flush()
 }
 
-// exportCSV exports characters to a CSV file.
-func (e *Exporter) exportCSV(chars []analysis.Character, filename string) error {
-	file, err := os.Create(filename)
-	if err != nil {
-		return fmt.Errorf("failed to create file: %w", err)
+// NewJSONCharacter converts a character to its JSON representation, with
+// the warnings of the character alone. The position is the original rune
+// offset, so filtered exports keep gaps.
+func NewJSONCharacter(c analysis.Character) JSONCharacter {
+	warnings := []string{}
+	for _, w := range c.Warnings() {
+		warnings = append(warnings, w.String())
+	}
+	return JSONCharacter{
+		Position:   c.RuneOffset,
+		Char:       c.Char,
+		Hex:        c.Hex,
+		Decimal:    c.Dec,
+		Octal:      c.Oct,
+		Binary:     c.Bin,
+		Unicode:    c.Unicode,
+		UTF8Bytes:  c.UTF8Hex,
+		Type:       c.Type.String(),
+		ByteOffset: c.ByteOffset,
+		RuneOffset: c.RuneOffset,
+		Warnings:   warnings,
 	}
-	defer file.Close()
+}
 
-	writer := csv.NewWriter(file)
-	defer writer.Flush()
+// exportCSV exports characters as comma-separated values. The output is
+// the header and one row per character only, for CSV readers; the summary
+// statistics are in the text and JSON exports.
+func (e *Exporter) exportCSV(w io.Writer, chars []analysis.Character) error {
+	writer := csv.NewWriter(w)
 
 	// Write header
 	header := []string{"Position", "Char", "Hex", "Decimal", "Octal", "Binary", "Unicode", "UTF8_Bytes", "Type"}
@@ -199,9 +518,9 @@ func (e *Exporter) exportCSV(chars []analysis.Character, filename string) error
 	}
 
 	// Write rows
-	for i, c := range chars {
+	for _, c := range chars {
 		row := []string{
-			fmt.Sprintf("%d", i),
+			fmt.Sprintf("%d", c.RuneOffset),
 			c.Char,
 			c.Hex,
 			fmt.Sprintf("%d", c.Dec),
@@ -216,5 +535,18 @@ func (e *Exporter) exportCSV(chars []analysis.Character, filename string) error
 		}
 	}
 
-	return nil
+	writer.Flush()
+	return writer.Error()
+}
+
+// ParseFormat returns the format matching name, which may be a format name
+// ("json") or a file extension ("txt").
+func ParseFormat(name string) (Format, error) {
+	name = strings.ToLower(strings.TrimPrefix(name, "."))
+	for _, f := range Formats {
+		if strings.ToLower(f.String()) == name || f.Extension() == name {
+			return f, nil
+		}
+	}
+	return FormatText, fmt.Errorf("unknown format %q", name)
 }
@@ -0,0 +1,519 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"go/parser"
+	"go/token"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/muesli/termenv"
+	"google.golang.org/protobuf/proto"
+
+	"stringinspect/internal/analysis"
+	"stringinspect/internal/pb"
+)
+
+func TestEscapeUnicode(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		style EscapeStyle
+		want  string
+	}{
+		{"ASCII untouched", "Hello, world!", EscapeGo, "Hello, world!"},
+		{"Go BMP", "café", EscapeGo, "caf\\u00E9"},
+		{"Go astral", "😀", EscapeGo, "\\U0001F600"},
+		{"Python astral", "😀", EscapePython, "\\U0001F600"},
+		{"JavaScript surrogates", "😀", EscapeJavaScript, "\\uD83D\\uDE00"},
+		{"Java surrogates", "a😀b", EscapeJava, "a\\uD83D\\uDE00b"},
+		{"Rust braces", "é😀", EscapeRust, "\\u{E9}\\u{1F600}"},
+		{"Go controls", "a\x00\n\x1b", EscapeGo, "a\\x00\\n\\x1B"},
+		{"C octal", "\x1bA", EscapeC, "\\033A"},
+		{"C C1 controls", "\u0085é", EscapeC, "\\302\\205\\u00E9"},
+		{"Java controls", "\t\x7f", EscapeJava, "\\t\\u007F"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := EscapeUnicode(tt.input, tt.style)
+			if got != tt.want {
+				t.Errorf("EscapeUnicode(%q, %v) = %q, want %q", tt.input, tt.style, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnescape(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"plain", "plain"},
+		{"caf\\u00E9", "café"},
+		{"\\U0001F600", "😀"},
+		{"\\uD83D\\uDE00", "😀"},
+		{"\\u{E9}\\u{1F600}", "é😀"},
+		{"a\\tb\\n\\\\", "a\tb\n\\"},
+		{"\\xA0", "\u00A0"},
+		{"\\033[\\302\\205", "\x1b[\u0085"},
+	}
+
+	for _, tt := range tests {
+		got, err := Unescape(tt.input)
+		if err != nil {
+			t.Errorf("Unescape(%q) error: %v", tt.input, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Unescape(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+
+	for _, bad := range []string{"\\", "\\u12", "\\q", "\\u{110000}", "\\400"} {
+		if _, err := Unescape(bad); err == nil {
+			t.Errorf("Unescape(%q) succeeded, want error", bad)
+		}
+	}
+
+	// Every escape style round-trips
+	for _, style := range EscapeStyles {
+		in := "é😀\u200B\u0085\x1b"
+		got, err := Unescape(EscapeUnicode(in, style))
+		if err != nil || got != in {
+			t.Errorf("Unescape(EscapeUnicode(%q, %v)) = %q, %v", in, style, got, err)
+		}
+	}
+}
+
+func TestTemplateExtension(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"report.md.tmpl", "md"},
+		{"/tmp/out.html.tpl", "html"},
+		{"plain.tmpl", "txt"},
+		{"layout.csv", "csv"},
+	}
+
+	for _, tt := range tests {
+		if got := templateExtension(tt.path); got != tt.want {
+			t.Errorf("templateExtension(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestJSONStreamingOutput(t *testing.T) {
+	chars := analysis.Analyze("Hé😀")
+
+	var buf bytes.Buffer
+	if err := NewExporter().Write(&buf, chars, FormatJSON); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	var got JSONExport
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("streamed JSON does not parse: %v\n%s", err, buf.String())
+	}
+	if got.Count != 3 || len(got.Characters) != 3 {
+		t.Fatalf("count = %d, characters = %d, want 3", got.Count, len(got.Characters))
+	}
+	if got.Characters[2].Decimal != 0x1F600 {
+		t.Errorf("characters[2].decimal = %d, want %d", got.Characters[2].Decimal, 0x1F600)
+	}
+}
+
+func TestJSONWarnings(t *testing.T) {
+	// The Cyrillic а is confusable among Latin letters, not in a Russian word
+	chars := analysis.Analyze("pаypal\u200b да")
+
+	var buf bytes.Buffer
+	if err := NewExporter().Write(&buf, chars, FormatJSON); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	var got JSONExport
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("JSON does not parse: %v", err)
+	}
+
+	want := map[int][]string{1: {"confusable"}, 6: {"invisible"}}
+	for i, c := range got.Characters {
+		if c.Warnings == nil {
+			t.Errorf("characters[%d].warnings is null, want an array", i)
+		}
+		if !slices.Equal(c.Warnings, want[i]) {
+			t.Errorf("characters[%d].warnings = %v, want %v", i, c.Warnings, want[i])
+		}
+	}
+	if got.Warnings == nil || got.Warnings.Flagged != 2 ||
+		got.Warnings.Counts["confusable"] != 1 || got.Warnings.Counts["invisible"] != 1 {
+		t.Errorf("warnings = %+v, want 2 flagged, 1 confusable, 1 invisible", got.Warnings)
+	}
+
+	// The summary counts the same warnings
+	total := func(counts map[string]int) (n int) {
+		for _, c := range counts {
+			n += c
+		}
+		return n
+	}
+	if got.Summary == nil || total(got.Summary.Warnings) != total(got.Warnings.Counts) {
+		t.Errorf("summary.warnings = %v, want the counts of warnings, %v", got.Summary, got.Warnings.Counts)
+	}
+}
+
+func TestWriteDiff(t *testing.T) {
+	a := DiffSide{Name: "a.txt", Encoding: "UTF-8", Text: "one\ntwo\u00A0three"}
+	b := DiffSide{Name: "b.txt", Encoding: "UTF-8", Text: "one\ntwo three!"}
+
+	var buf bytes.Buffer
+	if err := WriteDiff(&buf, a, b, FormatText); err != nil {
+		t.Fatalf("WriteDiff() error = %v", err)
+	}
+	for _, want := range []string{
+		"A: a.txt (UTF-8, 13 characters)",
+		"rune 7, line 2, column 4: U+00A0 NO-BREAK SPACE vs U+0020 SPACE [whitespace]",
+		"rune 13 of B, line 2, column 10: only in B: U+0021 EXCLAMATION MARK",
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("text diff lacks %q:\n%s", want, buf.String())
+		}
+	}
+
+	buf.Reset()
+	if err := WriteDiff(&buf, a, b, FormatJSON); err != nil {
+		t.Fatalf("WriteDiff() error = %v", err)
+	}
+	var got JSONDiff
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("JSON diff does not parse: %v", err)
+	}
+	if got.Identical || len(got.Differences) != 2 || got.Classes["whitespace"] != 1 {
+		t.Errorf("JSON diff = %+v", got)
+	}
+
+	if err := WriteDiff(&buf, a, b, FormatCSV); err == nil {
+		t.Error("WriteDiff() accepted CSV")
+	}
+}
+
+func TestProtobufRoundTrip(t *testing.T) {
+	chars := analysis.Analyze("a 😀")
+
+	var buf bytes.Buffer
+	if err := NewExporter().Write(&buf, chars, FormatProtobuf); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	var got pb.Analysis
+	if err := proto.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if got.GetOriginal() != "a 😀" {
+		t.Errorf("original = %q, want %q", got.GetOriginal(), "a 😀")
+	}
+	if len(got.GetCharacters()) != 3 || got.GetCharacters()[2].GetCodepoint() != 0x1F600 {
+		t.Errorf("characters = %v", got.GetCharacters())
+	}
+	if got.GetSummary().GetWarnings()["unusual-whitespace"] != 1 {
+		t.Errorf("summary warnings = %v", got.GetSummary().GetWarnings())
+	}
+}
+
+func TestImportRoundTrip(t *testing.T) {
+	input := "a\tb \x1b é😀"
+
+	var buf bytes.Buffer
+	if err := NewExporter().Write(&buf, analysis.Analyze(input), FormatJSON); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	got, err := Import(&buf)
+	if err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+	if got != input {
+		t.Errorf("Import() = %q, want %q", got, input)
+	}
+}
+
+func TestImportBytes(t *testing.T) {
+	data := []byte{'A', 0x80, 0xFF, 0x00}
+
+	var buf bytes.Buffer
+	if err := NewExporter().Write(&buf, analysis.NewAnalyzer().AnalyzeBytes(data), FormatJSON); err != nil {
+		t.Fatal(err)
+	}
+	got, err := Import(&buf)
+	if err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+	if got != string(data) {
+		t.Errorf("Import() = %q, want %q", got, data)
+	}
+}
+
+func TestImportFiltered(t *testing.T) {
+	chars := analysis.Analyze("a b")
+	var buf bytes.Buffer
+	if err := NewExporter().Write(&buf, []analysis.Character{chars[0], chars[2]}, FormatJSON); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := Import(&buf); err == nil {
+		t.Errorf("Import() of a filtered export = %q, want an error", got)
+	}
+}
+
+func TestTranscode(t *testing.T) {
+	tests := []struct {
+		text   string
+		to     Transcoding
+		want   []byte
+		losses []Loss
+	}{
+		{"hé", TranscodeUTF8, []byte("hé"), nil},
+		{"hé", TranscodeUTF8BOM, []byte("\xEF\xBB\xBFhé"), nil},
+		{"hé", TranscodeUTF16LE, []byte{'h', 0, 0xE9, 0}, nil},
+		{"hé", TranscodeUTF16LEBOM, []byte{0xFF, 0xFE, 'h', 0, 0xE9, 0}, nil},
+		{"hé", TranscodeUTF16BEBOM, []byte{0xFE, 0xFF, 0, 'h', 0, 0xE9}, nil},
+		{"hé€", TranscodeWindows1252, []byte("h\xE9\x80"), nil},
+		{"hé€ő", TranscodeLatin1, []byte("h\xE9EURo"), []Loss{{2, '€', "EUR"}, {3, 'ő', "o"}}},
+		{"日本 😀", TranscodeShiftJIS, []byte("\x93\xfa\x96\x7b ?"), []Loss{{3, '😀', "?"}}},
+	}
+
+	for _, tt := range tests {
+		got, losses := Transcode(tt.text, tt.to)
+		if !bytes.Equal(got, tt.want) || !slices.Equal(losses, tt.losses) {
+			t.Errorf("Transcode(%q, %v) = % X, %v, want % X, %v", tt.text, tt.to, got, losses, tt.want, tt.losses)
+		}
+	}
+}
+
+func TestParseTranscoding(t *testing.T) {
+	for _, name := range []string{"utf-16le-bom", "UTF-16LE BOM", "utf_16le_bom"} {
+		if got, err := ParseTranscoding(name); err != nil || got != TranscodeUTF16LEBOM {
+			t.Errorf("ParseTranscoding(%q) = %v, %v", name, got, err)
+		}
+	}
+	if _, err := ParseTranscoding("ebcdic"); err == nil {
+		t.Error("ParseTranscoding(ebcdic) should fail")
+	}
+}
+
+func TestExportSkeleton(t *testing.T) {
+	var buf bytes.Buffer
+	chars := analysis.Analyze("paypal\r\np\u0430ypa1\n\nad\tmin\n")
+	if err := NewExporter().Write(&buf, chars, FormatSkeleton); err != nil {
+		t.Fatal(err)
+	}
+	want := "paypal\tpaypal\np\u0430ypa1\tpaypal\nad min\tad rnin\n"
+	if got := buf.String(); got != want {
+		t.Errorf("skeleton export = %q, want %q", got, want)
+	}
+}
+
+func TestExportGoCompiles(t *testing.T) {
+	var buf bytes.Buffer
+	if err := NewExporter().Write(&buf, analysis.Analyze("héllo"), FormatGoBytes); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := parser.ParseFile(token.NewFileSet(), "data.go", buf.Bytes(), 0); err != nil {
+		t.Errorf("Go export is not a Go source file: %v\n%s", err, buf.String())
+	}
+}
+
+func TestExportCSVIsPlain(t *testing.T) {
+	var buf bytes.Buffer
+	chars := analysis.Analyze("a, b\u0430")
+	if err := NewExporter().Write(&buf, chars, FormatCSV); err != nil {
+		t.Fatal(err)
+	}
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != len(chars)+1 || records[0][0] != "Position" {
+		t.Errorf("CSV export has %d records starting with %q, want the header and %d rows", len(records), records[0], len(chars))
+	}
+}
+
+func TestExportDump(t *testing.T) {
+	chars := analysis.Analyze("ab\u00e9")
+	e := NewExporter()
+	for _, tt := range []struct {
+		opts DumpOptions
+		want string
+	}{
+		{DumpOptions{}, "0000  61 62 E9" + strings.Repeat(" ", 42) + "│ abé\n"},
+		{DumpOptions{Group: 2, Little: true}, "0000  6261 A9C3" + strings.Repeat(" ", 31) + "│ ab..\n"},
+	} {
+		var buf bytes.Buffer
+		e.Dump = tt.opts
+		if err := e.Write(&buf, chars, FormatDump); err != nil {
+			t.Fatal(err)
+		}
+		if got := buf.String(); got != tt.want {
+			t.Errorf("dump with %+v = %q, want %q", tt.opts, got, tt.want)
+		}
+	}
+}
+
+func TestExportTextColor(t *testing.T) {
+	chars := analysis.Analyze("a b")
+	write := func(r *lipgloss.Renderer) string {
+		var buf bytes.Buffer
+		e := NewExporter()
+		e.Renderer = r
+		if err := e.Write(&buf, chars, FormatText); err != nil {
+			t.Fatal(err)
+		}
+		return buf.String()
+	}
+	plain := write(nil)
+
+	// A renderer for something other than a terminal adds no color
+	if got := write(lipgloss.NewRenderer(&bytes.Buffer{})); got != plain {
+		t.Errorf("text export for a non-terminal = %q, want %q", got, plain)
+	}
+
+	r := lipgloss.NewRenderer(&bytes.Buffer{})
+	r.SetColorProfile(termenv.TrueColor)
+	colored := write(r)
+	if !strings.Contains(colored, "\x1b[38;2;0;226;199m1 ") {
+		t.Errorf("text export misses the whitespace color in %q", colored)
+	}
+	if ansi.Strip(colored) != plain {
+		t.Errorf("colored text export differs from plain text: %q", ansi.Strip(colored))
+	}
+}
+
+// FuzzWrite checks that every format writes any input, including NUL and
+// other control characters, that the text formats contain no control
+// characters but line breaks and tabs, and that JSON imports back unchanged.
+func FuzzWrite(f *testing.F) {
+	for _, seed := range []string{"a", "a\x00b", "\x1b[31mred\x1b[0m", "\r\n\t\x7f\u0085", "\xff\xfe\x00", "e\u0301👍🏽"} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		if s == "" {
+			return // Nothing to export
+		}
+		chars := analysis.Analyze(s)
+		e := NewExporter()
+		for _, format := range Formats {
+			if format == FormatTemplate {
+				continue // Needs a template file
+			}
+			var buf bytes.Buffer
+			if err := e.Write(&buf, chars, format); err != nil {
+				t.Fatalf("%v: %v", format, err)
+			}
+			switch format {
+			case FormatText, FormatGoBytes, FormatCArray, FormatEscaped, FormatDump:
+				out := buf.String()
+				if !utf8.ValidString(out) {
+					t.Fatalf("%v: invalid UTF-8 in %q", format, out)
+				}
+				if i := strings.IndexFunc(out, func(r rune) bool { return unicode.IsControl(r) && r != '\n' && r != '\t' }); i >= 0 {
+					t.Fatalf("%v: control character at %d in %q", format, i, out)
+				}
+			}
+		}
+
+		if !utf8.ValidString(s) {
+			return // Invalid bytes are analyzed as U+FFFD
+		}
+		var buf bytes.Buffer
+		if err := e.Write(&buf, chars, FormatJSON); err != nil {
+			t.Fatal(err)
+		}
+		if got, err := Import(&buf); err != nil || got != s {
+			t.Fatalf("Import() = %q, %v, want %q", got, err, s)
+		}
+	})
+}
+
+func TestExportXLSX(t *testing.T) {
+	var buf bytes.Buffer
+	chars := analysis.Analyze("<a&\x01é")
+	if err := NewExporter().Write(&buf, chars, FormatXLSX); err != nil {
+		t.Fatal(err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("workbook is not a zip archive: %v", err)
+	}
+	parts := make(map[string][]byte)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		parts[f.Name], _ = io.ReadAll(rc)
+		rc.Close()
+	}
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels", "xl/styles.xml"} {
+		if err := xml.Unmarshal(parts[name], new(struct{})); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+
+	// Cells hold inline strings, so there is no shared strings part
+	var sheet struct {
+		Rows []struct {
+			R     int `xml:"r,attr"`
+			Cells []struct {
+				Ref  string `xml:"r,attr"`
+				Type string `xml:"t,attr"`
+				Text string `xml:"is>t"`
+				V    string `xml:"v"`
+			} `xml:"c"`
+		} `xml:"sheetData>row"`
+	}
+	if err := xml.Unmarshal(parts["xl/worksheets/sheet1.xml"], &sheet); err != nil {
+		t.Fatalf("sheet1.xml does not parse: %v", err)
+	}
+	if _, ok := parts["xl/sharedStrings.xml"]; ok {
+		t.Error("workbook has a shared strings part, want inline strings")
+	}
+	if len(sheet.Rows) != len(chars)+1 {
+		t.Fatalf("sheet has %d rows, want %d", len(sheet.Rows), len(chars)+1)
+	}
+	if c := sheet.Rows[0].Cells[0]; c.Text != "Position" || c.Ref != "A1" {
+		t.Errorf("header cell = %+v, want Position at A1", c)
+	}
+
+	// Markup is escaped, and control characters keep their display form
+	want := []string{"<", "a", "&", "<01>", "é"}
+	for i, w := range want {
+		row := sheet.Rows[i+1]
+		if row.R != i+2 || row.Cells[1].Text != w || row.Cells[1].Type != "inlineStr" {
+			t.Errorf("row %d = %+v, want char %q", i+2, row, w)
+		}
+		if row.Cells[3].V != strconv.Itoa(int(chars[i].Rune)) {
+			t.Errorf("row %d decimal = %q, want %d", i+2, row.Cells[3].V, chars[i].Rune)
+		}
+	}
+}
+
+func TestXLSXRowLimit(t *testing.T) {
+	if err := checkXLSXRows(xlsxMaxRows - 1); err != nil {
+		t.Errorf("checkXLSXRows(%d) = %v, want nil", xlsxMaxRows-1, err)
+	}
+	if err := checkXLSXRows(xlsxMaxRows); err == nil {
+		t.Errorf("checkXLSXRows(%d) = nil, want an error", xlsxMaxRows)
+	}
+}
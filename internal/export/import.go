@@ -0,0 +1,74 @@
+package export
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Import reads a JSON export and reconstructs the analyzed string from the
+// characters' UTF-8 bytes, which hold the file's own bytes for exports made
+// in byte mode; the result is then not valid UTF-8. The "original" field is
+// not used because it holds display placeholders rather than the actual
+// characters. Exports of a filtered view cannot be imported, since the
+// characters filtered out are missing.
+func Import(r io.Reader) (string, error) {
+	var data JSONExport
+	if err := json.NewDecoder(r).Decode(&data); err != nil {
+		return "", fmt.Errorf("failed to parse JSON export: %w", err)
+	}
+
+	if len(data.Characters) == 0 {
+		return "", fmt.Errorf("export contains no characters")
+	}
+
+	chars := data.Characters
+	sort.SliceStable(chars, func(i, j int) bool {
+		return chars[i].Position < chars[j].Position
+	})
+
+	var b strings.Builder
+	for i, c := range chars {
+		switch {
+		case c.Position < i:
+			return "", fmt.Errorf("position %d appears more than once", c.Position)
+		case c.Position > i:
+			return "", fmt.Errorf("position %d is missing; the export is of a filtered view", i)
+		}
+		raw, err := characterBytes(c)
+		if err != nil {
+			return "", fmt.Errorf("position %d: %w", c.Position, err)
+		}
+		b.Write(raw)
+	}
+	return b.String(), nil
+}
+
+// characterBytes returns the bytes of an exported character from its
+// utf8_bytes, or its codepoint encoded as UTF-8 when they are missing.
+func characterBytes(c JSONCharacter) ([]byte, error) {
+	if c.UTF8Bytes == "" {
+		return utf8.AppendRune(nil, rune(c.Decimal)), nil
+	}
+	raw, err := hex.DecodeString(strings.ReplaceAll(c.UTF8Bytes, " ", ""))
+	if err != nil {
+		return nil, fmt.Errorf("bad utf8_bytes %q", c.UTF8Bytes)
+	}
+	return raw, nil
+}
+
+// ImportFile reads a JSON export from a file. See Import.
+func ImportFile(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	return Import(file)
+}
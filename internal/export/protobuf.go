@@ -0,0 +1,104 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"stringinspect/internal/analysis"
+	"stringinspect/internal/pb"
+)
+
+// NewProtoAnalysis converts characters to the protocol buffer Analysis message.
+func NewProtoAnalysis(chars []analysis.Character) *pb.Analysis {
+	pbChars := make([]*pb.Character, len(chars))
+	for i, c := range chars {
+		pbChars[i] = NewProtoCharacter(c)
+	}
+
+	return &pb.Analysis{
+		Original:   originalString(chars),
+		Count:      int64(len(chars)),
+		ExportedAt: time.Now().Format(time.RFC3339),
+		Summary:    NewProtoSummary(analysis.Summarize(chars)),
+		Characters: pbChars,
+	}
+}
+
+// NewProtoSummary converts a summary to the protocol buffer Summary message.
+func NewProtoSummary(s analysis.Summary) *pb.Summary {
+	summary := &pb.Summary{
+		Characters:  int64(s.Characters),
+		Bytes:       int64(s.Bytes),
+		Types:       make(map[string]int64),
+		Scripts:     make(map[string]int64),
+		ByteLengths: make(map[int32]int64),
+		LineEndings: &pb.LineEndings{
+			Lf:   int64(s.LineEndings.LF),
+			Crlf: int64(s.LineEndings.CRLF),
+			Cr:   int64(s.LineEndings.CR),
+		},
+		Warnings: make(map[string]int64),
+	}
+	for t, n := range s.Types {
+		summary.Types[t.String()] = int64(n)
+	}
+	for name, n := range s.Scripts {
+		summary.Scripts[name] = int64(n)
+	}
+	for l, n := range s.ByteLengths {
+		summary.ByteLengths[int32(l)] = int64(n)
+	}
+	for w, n := range s.Warnings {
+		summary.Warnings[w.String()] = int64(n)
+	}
+	return summary
+}
+
+// NewProtoCharacter converts a character to the protocol buffer Character
+// message.
+func NewProtoCharacter(c analysis.Character) *pb.Character {
+	return &pb.Character{
+		Position:   int64(c.RuneOffset),
+		Codepoint:  uint32(c.Rune),
+		Char:       c.Char,
+		Hex:        c.Hex,
+		Octal:      c.Oct,
+		Binary:     c.Bin,
+		Unicode:    c.Unicode,
+		Utf8Bytes:  c.UTF8Bytes,
+		Type:       pb.CharType(c.Type + 1),
+		ByteOffset: int64(c.ByteOffset),
+		RuneOffset: int64(c.RuneOffset),
+	}
+}
+
+// NewProtoCharacterInfo describes a character beyond its encodings as the
+// protocol buffer CharacterInfo message.
+func NewProtoCharacterInfo(c analysis.Character) *pb.CharacterInfo {
+	info := &pb.CharacterInfo{
+		Character:   NewProtoCharacter(c),
+		Name:        analysis.Name(c.Rune),
+		Category:    analysis.Category(c.Rune),
+		Script:      analysis.Script(c.Rune),
+		Block:       analysis.BlockName(c.Rune),
+		Explanation: analysis.Explain(c.Rune),
+	}
+	for _, w := range c.Warnings() {
+		info.Warnings = append(info.Warnings, w.String())
+	}
+	return info
+}
+
+// exportProtobuf exports characters as a binary protocol buffer Analysis message.
+func (e *Exporter) exportProtobuf(w io.Writer, chars []analysis.Character) error {
+	data, err := proto.Marshal(NewProtoAnalysis(chars))
+	if err != nil {
+		return fmt.Errorf("failed to marshal protobuf: %w", err)
+	}
+
+	_, err = w.Write(data)
+	return err
+}
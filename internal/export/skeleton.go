@@ -0,0 +1,24 @@
+package export
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	"stringinspect/internal/analysis"
+)
+
+// exportSkeleton writes each line of the text with its UTS #39 skeleton,
+// tab-separated, for deduplicating lookalike names. Tabs within a line
+// are written as spaces to keep the columns intact.
+func (e *Exporter) exportSkeleton(w io.Writer, chars []analysis.Character) error {
+	bw := bufio.NewWriter(w)
+	for _, line := range strings.Split(originalString(chars), "\n") {
+		line = strings.ReplaceAll(strings.TrimSuffix(line, "\r"), "\t", " ")
+		if line == "" {
+			continue
+		}
+		bw.WriteString(line + "\t" + analysis.Skeleton(line) + "\n")
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,63 @@
+package export
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"stringinspect/internal/analysis"
+)
+
+// bytesPerLine is the number of byte values emitted per line in source literals.
+const bytesPerLine = 12
+
+// exportSource exports the UTF-8 bytes of the characters as a Go or C literal.
+func (e *Exporter) exportSource(w io.Writer, chars []analysis.Character, format Format) error {
+	size := 0
+	for _, c := range chars {
+		size += len(c.UTF8Bytes)
+	}
+
+	b := bufio.NewWriter(w)
+	fmt.Fprintf(b, "// Generated by StringInspect: %d bytes, %d characters\n", size, len(chars))
+
+	switch format {
+	case FormatGoBytes:
+		// A package clause makes the .go file compile as it is
+		b.WriteString("\npackage main\n\n")
+		b.WriteString("var data = []byte{\n")
+	case FormatCArray:
+		fmt.Fprintf(b, "static const unsigned char data[%d] = {\n", size)
+	default:
+		return fmt.Errorf("unsupported source format: %v", format)
+	}
+
+	// Bytes are emitted as they are walked so the data is never copied
+	n := 0
+	for _, c := range chars {
+		for _, v := range c.UTF8Bytes {
+			switch {
+			case n%bytesPerLine == 0:
+				b.WriteString("\t")
+			default:
+				b.WriteString(" ")
+			}
+			fmt.Fprintf(b, "0x%02X,", v)
+			n++
+			if n%bytesPerLine == 0 {
+				b.WriteString("\n")
+			}
+		}
+	}
+	if n%bytesPerLine != 0 {
+		b.WriteString("\n")
+	}
+
+	if format == FormatCArray {
+		b.WriteString("};\n")
+	} else {
+		b.WriteString("}\n")
+	}
+
+	return b.Flush()
+}
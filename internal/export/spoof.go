@@ -0,0 +1,46 @@
+package export
+
+import (
+	"fmt"
+
+	"stringinspect/internal/analysis"
+)
+
+// JSONSpoofFinding is a spoofing finding in JSON.
+type JSONSpoofFinding struct {
+	Kind     string `json:"kind"`
+	Position int    `json:"position"` // -1 for the whole string
+	Char     string `json:"char,omitempty"`
+	Unicode  string `json:"unicode,omitempty"`
+	Detail   string `json:"detail"`
+}
+
+// JSONSpoofReport is the JSON result of a spoofing check of one input.
+type JSONSpoofReport struct {
+	Input      string             `json:"input"`
+	Suspicious bool               `json:"suspicious"`
+	Scripts    []string           `json:"scripts"`
+	Findings   []JSONSpoofFinding `json:"findings"`
+}
+
+// NewJSONSpoofReport converts the spoofing check of input to JSON.
+func NewJSONSpoofReport(input string, r analysis.SpoofReport) JSONSpoofReport {
+	out := JSONSpoofReport{
+		Input:      input,
+		Suspicious: r.Suspicious(),
+		Scripts:    r.Scripts,
+		Findings:   []JSONSpoofFinding{},
+	}
+	if out.Scripts == nil {
+		out.Scripts = []string{}
+	}
+	for _, f := range r.Findings {
+		jf := JSONSpoofFinding{Kind: f.Kind, Position: f.Offset, Detail: f.Detail}
+		if f.Offset >= 0 {
+			jf.Char = string(f.Rune)
+			jf.Unicode = fmt.Sprintf("U+%04X", f.Rune)
+		}
+		out.Findings = append(out.Findings, jf)
+	}
+	return out
+}
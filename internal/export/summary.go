@@ -0,0 +1,114 @@
+package export
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"stringinspect/internal/analysis"
+)
+
+// charTypes lists character types in reporting order.
+var charTypes = []analysis.CharType{
+	analysis.CharTypePrintable,
+	analysis.CharTypeWhitespace,
+	analysis.CharTypeControl,
+	analysis.CharTypeExtended,
+}
+
+// JSONSummary is the JSON representation of analysis summary statistics.
+type JSONSummary struct {
+	Characters  int            `json:"characters"`
+	Bytes       int            `json:"bytes"`
+	Types       map[string]int `json:"types"`
+	Scripts     map[string]int `json:"scripts"`
+	ByteLengths map[string]int `json:"byte_lengths"`
+	LineEndings map[string]int `json:"line_endings"`
+	Warnings    map[string]int `json:"warnings"`
+}
+
+// newJSONSummary converts a summary to its JSON representation.
+func newJSONSummary(s analysis.Summary) JSONSummary {
+	js := JSONSummary{
+		Characters:  s.Characters,
+		Bytes:       s.Bytes,
+		Types:       make(map[string]int),
+		Scripts:     s.Scripts,
+		ByteLengths: make(map[string]int),
+		LineEndings: map[string]int{
+			"lf":   s.LineEndings.LF,
+			"crlf": s.LineEndings.CRLF,
+			"cr":   s.LineEndings.CR,
+		},
+		Warnings: make(map[string]int),
+	}
+
+	for t, n := range s.Types {
+		js.Types[t.String()] = n
+	}
+	for l, n := range s.ByteLengths {
+		js.ByteLengths[strconv.Itoa(l)] = n
+	}
+	for w, n := range s.Warnings {
+		js.Warnings[w.String()] = n
+	}
+
+	return js
+}
+
+// summaryLines renders a summary as human-readable lines for text-based exports.
+func summaryLines(s analysis.Summary) []string {
+	var types []string
+	for _, t := range charTypes {
+		if n := s.Types[t]; n > 0 {
+			types = append(types, fmt.Sprintf("%s %d", t, n))
+		}
+	}
+
+	// Scripts by descending count, then name
+	scripts := make([]string, 0, len(s.Scripts))
+	for name := range s.Scripts {
+		scripts = append(scripts, name)
+	}
+	sort.Slice(scripts, func(i, j int) bool {
+		if s.Scripts[scripts[i]] != s.Scripts[scripts[j]] {
+			return s.Scripts[scripts[i]] > s.Scripts[scripts[j]]
+		}
+		return scripts[i] < scripts[j]
+	})
+	for i, name := range scripts {
+		scripts[i] = fmt.Sprintf("%s %d", name, s.Scripts[name])
+	}
+
+	var lengths []string
+	for l := 1; l <= 4; l++ {
+		if n := s.ByteLengths[l]; n > 0 {
+			lengths = append(lengths, fmt.Sprintf("%d-byte %d", l, n))
+		}
+	}
+
+	var warnings []string
+	for _, w := range analysis.AllWarnings {
+		if n := s.Warnings[w]; n > 0 {
+			warnings = append(warnings, fmt.Sprintf("%s %d", w, n))
+		}
+	}
+
+	return []string{
+		fmt.Sprintf("Characters:   %d (%d bytes)", s.Characters, s.Bytes),
+		"Types:        " + joinOrNone(types),
+		"Scripts:      " + joinOrNone(scripts),
+		"Byte lengths: " + joinOrNone(lengths),
+		fmt.Sprintf("Line endings: LF %d, CRLF %d, CR %d", s.LineEndings.LF, s.LineEndings.CRLF, s.LineEndings.CR),
+		"Warnings:     " + joinOrNone(warnings),
+	}
+}
+
+// joinOrNone joins items with commas, or returns "none" for an empty list.
+func joinOrNone(items []string) string {
+	if len(items) == 0 {
+		return "none"
+	}
+	return strings.Join(items, ", ")
+}
@@ -0,0 +1,108 @@
+package export
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"unicode/utf8"
+
+	"stringinspect/internal/analysis"
+)
+
+// SVG layout constants, in pixels.
+const (
+	svgFontSize   = 14
+	svgCellWidth  = 8.4 // Advance width of a 14px monospace glyph
+	svgLineHeight = 20
+	svgPadding    = 16
+	svgPerLine    = 16
+)
+
+// Colors of the SVG and colored text, matching the TUI's dark palette.
+const (
+	svgColorBackground = "#1a1a1a"
+	svgColorMuted      = "#929292"
+	svgColorText       = "#EEEEEE"
+	svgColorWhitespace = "#00E2C7"
+	svgColorControl    = "#FF7698"
+	svgColorExtended   = "#FDFF90"
+	svgColorPrimary    = "#7D56F4"
+)
+
+// exportSVG renders the characters as a colored hex dump, laid out like the
+// compact view, into a standalone SVG image.
+func (e *Exporter) exportSVG(w io.Writer, chars []analysis.Character) error {
+	b := bufio.NewWriter(w)
+
+	// Columns: "0000  " + 16 hex cells of 3 + middle gap + " │ " + 16 chars
+	hexStart := 6
+	asciiStart := hexStart + svgPerLine*3 + 1 + 3
+	columns := asciiStart + svgPerLine
+	lines := (len(chars) + svgPerLine - 1) / svgPerLine
+
+	width := float64(svgPadding*2) + float64(columns)*svgCellWidth
+	height := svgPadding*2 + (lines+2)*svgLineHeight
+
+	fmt.Fprintf(b, `<svg xmlns="http://www.w3.org/2000/svg" width="%.0f" height="%d" viewBox="0 0 %.0f %d">`+"\n",
+		width, height, width, height)
+	fmt.Fprintf(b, `<rect width="100%%" height="100%%" fill="%s"/>`+"\n", svgColorBackground)
+	fmt.Fprintf(b, `<g font-family="Menlo, Consolas, 'DejaVu Sans Mono', monospace" font-size="%d" xml:space="preserve">`+"\n", svgFontSize)
+
+	// Title
+	svgText(b, 0, 0, "Compact View (Hex Dump)", svgColorPrimary)
+
+	for line := 0; line < lines; line++ {
+		row := line + 2
+		svgText(b, 0, row, fmt.Sprintf("%04X", line*svgPerLine), svgColorMuted)
+		svgText(b, asciiStart-2, row, "│", svgColorMuted)
+
+		for j := 0; j < svgPerLine; j++ {
+			idx := line*svgPerLine + j
+			if idx >= len(chars) {
+				break
+			}
+			c := chars[idx]
+			color := typeColor(c.Type)
+
+			col := hexStart + j*3
+			if j > 7 {
+				col++ // Extra space in middle
+			}
+			svgText(b, col, row, c.Hex, color)
+
+			display := c.Char
+			if utf8.RuneCountInString(display) > 1 {
+				display = "."
+			}
+			svgText(b, asciiStart+j, row, display, color)
+		}
+	}
+
+	b.WriteString("</g>\n</svg>\n")
+	return b.Flush()
+}
+
+// svgText writes a text element at the given character column and line.
+func svgText(b *bufio.Writer, col, line int, text, color string) {
+	x := float64(svgPadding) + float64(col)*svgCellWidth
+	y := svgPadding + line*svgLineHeight + svgFontSize
+	fmt.Fprintf(b, `<text x="%.1f" y="%d" fill="%s">`, x, y, color)
+	_ = xml.EscapeText(b, []byte(text))
+	b.WriteString("</text>\n")
+}
+
+// typeColor returns the text color for a character type, in the SVG and
+// in colored text.
+func typeColor(t analysis.CharType) string {
+	switch t {
+	case analysis.CharTypeWhitespace:
+		return svgColorWhitespace
+	case analysis.CharTypeControl:
+		return svgColorControl
+	case analysis.CharTypeExtended:
+		return svgColorExtended
+	default:
+		return svgColorText
+	}
+}
@@ -0,0 +1,60 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"stringinspect/internal/analysis"
+)
+
+// TemplateData is the value passed to user-supplied export templates.
+type TemplateData struct {
+	Original   string
+	Count      int
+	ExportedAt string
+	Characters []analysis.Character
+	Summary    analysis.Summary
+}
+
+// exportTemplate renders the characters through the configured template file.
+func (e *Exporter) exportTemplate(w io.Writer, chars []analysis.Character) error {
+	if e.TemplatePath == "" {
+		return fmt.Errorf("no template file configured")
+	}
+
+	tmpl, err := template.ParseFiles(e.TemplatePath)
+	if err != nil {
+		return fmt.Errorf("failed to parse template: %w", err)
+	}
+
+	data := TemplateData{
+		Original:   originalString(chars),
+		Count:      len(chars),
+		ExportedAt: time.Now().Format(time.RFC3339),
+		Characters: chars,
+		Summary:    analysis.Summarize(chars),
+	}
+
+	if err := tmpl.Execute(w, data); err != nil {
+		return fmt.Errorf("failed to execute template: %w", err)
+	}
+
+	return nil
+}
+
+// templateExtension derives the output extension from the template file name,
+// so "report.md.tmpl" produces ".md" files.
+func templateExtension(path string) string {
+	name := filepath.Base(path)
+	for _, suffix := range []string{".tmpl", ".tpl", ".gotmpl"} {
+		name = strings.TrimSuffix(name, suffix)
+	}
+	if ext := filepath.Ext(name); ext != "" {
+		return ext[1:]
+	}
+	return "txt"
+}
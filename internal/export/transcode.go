@@ -0,0 +1,153 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/unicode"
+
+	"stringinspect/internal/analysis"
+)
+
+// Transcoding selects the encoding FormatRaw writes the text in.
+type Transcoding int
+
+const (
+	TranscodeUTF8 Transcoding = iota
+	TranscodeUTF8BOM
+	TranscodeUTF16LE
+	TranscodeUTF16LEBOM
+	TranscodeUTF16BE
+	TranscodeUTF16BEBOM
+	TranscodeLatin1
+	TranscodeWindows1252
+	TranscodeShiftJIS
+)
+
+// Transcodings lists every target encoding in cycling order.
+var Transcodings = []Transcoding{
+	TranscodeUTF8, TranscodeUTF8BOM,
+	TranscodeUTF16LE, TranscodeUTF16LEBOM, TranscodeUTF16BE, TranscodeUTF16BEBOM,
+	TranscodeLatin1, TranscodeWindows1252, TranscodeShiftJIS,
+}
+
+func (t Transcoding) String() string {
+	switch t {
+	case TranscodeUTF8:
+		return "UTF-8"
+	case TranscodeUTF8BOM:
+		return "UTF-8 BOM"
+	case TranscodeUTF16LE:
+		return "UTF-16LE"
+	case TranscodeUTF16LEBOM:
+		return "UTF-16LE BOM"
+	case TranscodeUTF16BE:
+		return "UTF-16BE"
+	case TranscodeUTF16BEBOM:
+		return "UTF-16BE BOM"
+	case TranscodeLatin1:
+		return "Latin-1"
+	case TranscodeWindows1252:
+		return "Windows-1252"
+	case TranscodeShiftJIS:
+		return "Shift-JIS"
+	default:
+		return "Unknown"
+	}
+}
+
+// Next returns the encoding following t, wrapping around at the end.
+func (t Transcoding) Next() Transcoding {
+	return Transcodings[(int(t)+1)%len(Transcodings)]
+}
+
+// ParseTranscoding returns the target encoding with the given name,
+// ignoring case and with "-" or "_" in place of spaces, e.g. "utf-16le-bom".
+func ParseTranscoding(name string) (Transcoding, error) {
+	norm := func(s string) string {
+		return strings.NewReplacer(" ", "-", "_", "-").Replace(strings.ToLower(s))
+	}
+	for _, t := range Transcodings {
+		if norm(t.String()) == norm(name) {
+			return t, nil
+		}
+	}
+	return TranscodeUTF8, fmt.Errorf("unknown target encoding %q", name)
+}
+
+// encoding returns the encoder for t.
+func (t Transcoding) encoding() encoding.Encoding {
+	switch t {
+	case TranscodeUTF8BOM:
+		return unicode.UTF8BOM
+	case TranscodeUTF16LE:
+		return unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM)
+	case TranscodeUTF16LEBOM:
+		return unicode.UTF16(unicode.LittleEndian, unicode.UseBOM)
+	case TranscodeUTF16BE:
+		return unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM)
+	case TranscodeUTF16BEBOM:
+		return unicode.UTF16(unicode.BigEndian, unicode.UseBOM)
+	case TranscodeLatin1:
+		return charmap.ISO8859_1
+	case TranscodeWindows1252:
+		return charmap.Windows1252
+	case TranscodeShiftJIS:
+		return japanese.ShiftJIS
+	default:
+		return unicode.UTF8
+	}
+}
+
+// Loss is a character a target encoding cannot represent.
+type Loss struct {
+	Position   int    // Rune index in the text
+	Char       rune   // The character
+	Suggestion string // What was written instead, "?" when nothing fits
+}
+
+// Transcode encodes s in the target encoding. Characters the encoding
+// cannot represent are replaced by the closest stand-in it has (see
+// analysis.Fallbacks), or "?", and reported as losses.
+func Transcode(s string, t Transcoding) ([]byte, []Loss) {
+	enc := t.encoding().NewEncoder()
+	if out, err := enc.String(s); err == nil {
+		return []byte(out), nil
+	}
+
+	// Only the legacy encodings fail, and they have no byte order mark,
+	// so characters can be encoded one at a time
+	var b strings.Builder
+	var losses []Loss
+	i := 0
+	for _, r := range s {
+		out, err := enc.String(string(r))
+		if err != nil {
+			loss := Loss{Position: i, Char: r, Suggestion: "?"}
+			for _, f := range analysis.Fallbacks(r) {
+				if fout, err := enc.String(f); err == nil {
+					loss.Suggestion, out = f, fout
+					break
+				}
+			}
+			if loss.Suggestion == "?" {
+				out = "?"
+			}
+			losses = append(losses, loss)
+		}
+		b.WriteString(out)
+		i++
+	}
+	return []byte(b.String()), losses
+}
+
+// exportRaw writes the text re-encoded in the target encoding.
+func (e *Exporter) exportRaw(w io.Writer, chars []analysis.Character) error {
+	data, _ := Transcode(originalString(chars), e.Transcoding)
+	_, err := w.Write(data)
+	return err
+}
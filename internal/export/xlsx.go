@@ -0,0 +1,209 @@
+package export
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+
+	"stringinspect/internal/analysis"
+)
+
+// XLSX cell style indices, matching the cellXfs order in xlsxStyles.
+const (
+	xlsxStyleDefault = iota
+	xlsxStyleHeader
+	xlsxStylePrintable
+	xlsxStyleWhitespace
+	xlsxStyleControl
+	xlsxStyleExtended
+)
+
+// xlsxMaxRows is the most rows a worksheet can have; Excel refuses to open
+// workbooks with more.
+const xlsxMaxRows = 1 << 20
+
+const xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">
+<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>
+<Default Extension="xml" ContentType="application/xml"/>
+<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>
+<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>
+<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>
+</Types>`
+
+const xlsxRootRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>
+</Relationships>`
+
+const xlsxWorkbook = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheets><sheet name="Characters" sheetId="1" r:id="rId1"/></sheets>
+</workbook>`
+
+const xlsxWorkbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>
+<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>
+</Relationships>`
+
+// xlsxStyles defines a bold header and one fill per character type,
+// using light tints of the TUI color palette.
+const xlsxStyles = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<fonts count="2">
+<font><sz val="11"/><name val="Calibri"/></font>
+<font><b/><sz val="11"/><color rgb="FFFFFFFF"/><name val="Calibri"/></font>
+</fonts>
+<fills count="6">
+<fill><patternFill patternType="none"/></fill>
+<fill><patternFill patternType="gray125"/></fill>
+<fill><patternFill patternType="solid"><fgColor rgb="FF7D56F4"/></patternFill></fill>
+<fill><patternFill patternType="solid"><fgColor rgb="FFCCF9F3"/></patternFill></fill>
+<fill><patternFill patternType="solid"><fgColor rgb="FFFFD6E0"/></patternFill></fill>
+<fill><patternFill patternType="solid"><fgColor rgb="FFFEFFD6"/></patternFill></fill>
+</fills>
+<borders count="1"><border/></borders>
+<cellStyleXfs count="1"><xf/></cellStyleXfs>
+<cellXfs count="6">
+<xf/>
+<xf fontId="1" fillId="2" applyFont="1" applyFill="1"/>
+<xf/>
+<xf fillId="3" applyFill="1"/>
+<xf fillId="4" applyFill="1"/>
+<xf fillId="5" applyFill="1"/>
+</cellXfs>
+</styleSheet>`
+
+// exportXLSX exports characters to an Excel workbook with a frozen header
+// row and rows colored by character type.
+func (e *Exporter) exportXLSX(w io.Writer, chars []analysis.Character) error {
+	if err := checkXLSXRows(len(chars)); err != nil {
+		return err
+	}
+	zw := zip.NewWriter(w)
+
+	parts := []struct {
+		name    string
+		content string
+	}{
+		{"[Content_Types].xml", xlsxContentTypes},
+		{"_rels/.rels", xlsxRootRels},
+		{"xl/workbook.xml", xlsxWorkbook},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels},
+		{"xl/styles.xml", xlsxStyles},
+	}
+
+	for _, p := range parts {
+		pw, err := zw.Create(p.name)
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %w", p.name, err)
+		}
+		if _, err := io.WriteString(pw, p.content); err != nil {
+			return fmt.Errorf("failed to write %s: %w", p.name, err)
+		}
+	}
+
+	// The worksheet is streamed row by row into the archive
+	sw, err := zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return fmt.Errorf("failed to create worksheet: %w", err)
+	}
+	if err := writeXLSXSheet(sw, chars); err != nil {
+		return fmt.Errorf("failed to write worksheet: %w", err)
+	}
+
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("failed to finalize workbook: %w", err)
+	}
+
+	return nil
+}
+
+// checkXLSXRows returns an error when n characters and the header row are
+// more rows than a worksheet holds.
+func checkXLSXRows(n int) error {
+	if n+1 > xlsxMaxRows {
+		return fmt.Errorf("%d characters do not fit the %d rows of an Excel worksheet; export CSV instead", n, xlsxMaxRows-1)
+	}
+	return nil
+}
+
+// writeXLSXSheet writes the worksheet XML for the characters to w.
+func writeXLSXSheet(w io.Writer, chars []analysis.Character) error {
+	b := bufio.NewWriter(w)
+
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>`)
+	b.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">`)
+	b.WriteString(`<sheetViews><sheetView workbookViewId="0">`)
+	b.WriteString(`<pane ySplit="1" topLeftCell="A2" activePane="bottomLeft" state="frozen"/>`)
+	b.WriteString(`</sheetView></sheetViews>`)
+	b.WriteString(`<cols><col min="1" max="9" width="14" customWidth="1"/></cols>`)
+	b.WriteString(`<sheetData>`)
+
+	header := []string{"Position", "Char", "Hex", "Decimal", "Octal", "Binary", "Unicode", "UTF8_Bytes", "Type"}
+	b.WriteString(`<row r="1">`)
+	for col, h := range header {
+		writeXLSXString(b, col, 1, h, xlsxStyleHeader)
+	}
+	b.WriteString(`</row>`)
+
+	for i, c := range chars {
+		row := i + 2
+		style := xlsxTypeStyle(c.Type)
+
+		fmt.Fprintf(b, `<row r="%d">`, row)
+		writeXLSXNumber(b, 0, row, c.RuneOffset, style)
+		writeXLSXString(b, 1, row, c.Char, style)
+		writeXLSXString(b, 2, row, c.Hex, style)
+		writeXLSXNumber(b, 3, row, c.Dec, style)
+		writeXLSXString(b, 4, row, c.Oct, style)
+		writeXLSXString(b, 5, row, c.Bin, style)
+		writeXLSXString(b, 6, row, c.Unicode, style)
+		writeXLSXString(b, 7, row, c.UTF8Hex, style)
+		writeXLSXString(b, 8, row, c.Type.String(), style)
+		b.WriteString(`</row>`)
+	}
+
+	b.WriteString(`</sheetData></worksheet>`)
+	return b.Flush()
+}
+
+// xlsxTypeStyle returns the cell style index for a character type.
+func xlsxTypeStyle(t analysis.CharType) int {
+	switch t {
+	case analysis.CharTypeWhitespace:
+		return xlsxStyleWhitespace
+	case analysis.CharTypeControl:
+		return xlsxStyleControl
+	case analysis.CharTypeExtended:
+		return xlsxStyleExtended
+	default:
+		return xlsxStylePrintable
+	}
+}
+
+// xlsxCellRef returns the A1-style reference for a zero-based column and one-based row.
+func xlsxCellRef(col, row int) string {
+	name := ""
+	for col >= 0 {
+		name = string(rune('A'+col%26)) + name
+		col = col/26 - 1
+	}
+	return fmt.Sprintf("%s%d", name, row)
+}
+
+// writeXLSXString writes an inline string cell.
+func writeXLSXString(b *bufio.Writer, col, row int, value string, style int) {
+	fmt.Fprintf(b, `<c r="%s" s="%d" t="inlineStr"><is><t xml:space="preserve">`, xlsxCellRef(col, row), style)
+	// EscapeText also replaces characters that are invalid in XML
+	_ = xml.EscapeText(b, []byte(value))
+	b.WriteString(`</t></is></c>`)
+}
+
+// writeXLSXNumber writes a numeric cell.
+func writeXLSXNumber(b *bufio.Writer, col, row, value, style int) {
+	fmt.Fprintf(b, `<c r="%s" s="%d"><v>%d</v></c>`, xlsxCellRef(col, row), style, value)
+}
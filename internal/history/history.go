@@ -1,11 +1,17 @@
 // Package history manages input history for the application.
 package history
 
+// entry is a single history item.
+type entry struct {
+	text   string
+	pinned bool // Pinned entries are never trimmed
+}
+
 // History stores previous input strings for navigation.
 type History struct {
-	entries []string
+	entries []entry
 	cursor  int    // Current position in history (-1 means not browsing)
-	limit   int    // Maximum entries to store
+	limit   int    // Maximum unpinned entries to store
 	current string // Temporarily stores current input while browsing
 }
 
@@ -15,37 +21,102 @@ func New(limit int) *History {
 		limit = 100
 	}
 	return &History{
-		entries: make([]string, 0, limit),
+		entries: make([]entry, 0, limit),
 		cursor:  -1,
 		limit:   limit,
 	}
 }
 
 // Add adds a new entry to the history.
-// Empty strings and duplicates of the last entry are ignored.
-func (h *History) Add(entry string) {
-	if entry == "" {
+// Empty strings are ignored. If the entry already exists anywhere in the
+// history it is moved to the newest position, keeping its pinned state.
+func (h *History) Add(text string) {
+	if text == "" {
 		return
 	}
 
-	// Don't add if it's the same as the last entry
-	if len(h.entries) > 0 && h.entries[len(h.entries)-1] == entry {
-		return
+	e := entry{text: text}
+	if i := h.index(text); i >= 0 {
+		e = h.entries[i]
+		h.entries = append(h.entries[:i], h.entries[i+1:]...)
 	}
 
 	// Add to history
-	h.entries = append(h.entries, entry)
+	h.entries = append(h.entries, e)
 
-	// Trim if over limit
-	if len(h.entries) > h.limit {
-		h.entries = h.entries[1:]
-	}
+	h.trim()
 
 	// Reset cursor
 	h.cursor = -1
 	h.current = ""
 }
 
+// trim removes the oldest unpinned entries until the limit is satisfied.
+// Pinned entries do not count towards the limit. While browsing, the
+// cursor stays on its entry, or moves to the next newer one when its
+// entry is removed.
+func (h *History) trim() {
+	unpinned := 0
+	for _, e := range h.entries {
+		if !e.pinned {
+			unpinned++
+		}
+	}
+
+	for i := 0; unpinned > h.limit && i < len(h.entries); {
+		if h.entries[i].pinned {
+			i++
+			continue
+		}
+		h.entries = append(h.entries[:i], h.entries[i+1:]...)
+		unpinned--
+		if h.cursor > i {
+			h.cursor--
+		}
+	}
+	if h.cursor >= len(h.entries) {
+		h.cursor = len(h.entries) - 1
+	}
+}
+
+// index returns the position of text in the history, or -1.
+func (h *History) index(text string) int {
+	for i, e := range h.entries {
+		if e.text == text {
+			return i
+		}
+	}
+	return -1
+}
+
+// TogglePin pins or unpins an entry, adding it to the history first if
+// needed. It returns the new pinned state.
+func (h *History) TogglePin(text string) bool {
+	if text == "" {
+		return false
+	}
+
+	i := h.index(text)
+	if i < 0 {
+		h.entries = append(h.entries, entry{text: text})
+		i = len(h.entries) - 1
+	}
+
+	h.entries[i].pinned = !h.entries[i].pinned
+	pinned := h.entries[i].pinned
+
+	// Unpinning may push the history over its limit
+	h.trim()
+
+	return pinned
+}
+
+// IsPinned returns true if text is a pinned history entry.
+func (h *History) IsPinned(text string) bool {
+	i := h.index(text)
+	return i >= 0 && h.entries[i].pinned
+}
+
 // Up moves up in history (to older entries).
 // Returns the entry at the new position, or empty string if at the beginning.
 // currentInput is saved on first Up press so it can be restored.
@@ -62,7 +133,7 @@ func (h *History) Up(currentInput string) string {
 		h.cursor--
 	}
 
-	return h.entries[h.cursor]
+	return h.entries[h.cursor].text
 }
 
 // Down moves down in history (to newer entries).
@@ -80,7 +151,7 @@ func (h *History) Down() string {
 		return h.current
 	}
 
-	return h.entries[h.cursor]
+	return h.entries[h.cursor].text
 }
 
 // Reset resets the history browsing state.
@@ -0,0 +1,83 @@
+package history
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestAddDeduplicatesGlobally(t *testing.T) {
+	h := New(10)
+	h.Add("a")
+	h.Add("b")
+	h.Add("c")
+	h.Add("a") // Moves "a" to the newest position
+
+	if h.Len() != 3 {
+		t.Fatalf("Len() = %d, want 3", h.Len())
+	}
+
+	want := []string{"a", "c", "b"}
+	for i, w := range want {
+		if got := h.Up(""); got != w {
+			t.Errorf("Up() #%d = %q, want %q", i+1, got, w)
+		}
+	}
+}
+
+func TestPinnedEntriesSurviveLimit(t *testing.T) {
+	h := New(3)
+	h.Add("keep")
+	if !h.TogglePin("keep") {
+		t.Fatal("TogglePin() = false, want true")
+	}
+
+	for i := 0; i < 10; i++ {
+		h.Add(fmt.Sprintf("entry-%d", i))
+	}
+
+	if !h.IsPinned("keep") {
+		t.Error("pinned entry was trimmed")
+	}
+	if h.Len() != 4 {
+		t.Errorf("Len() = %d, want 4 (3 unpinned + 1 pinned)", h.Len())
+	}
+
+	// Unpinning makes the entry subject to the limit again
+	if h.TogglePin("keep") {
+		t.Error("TogglePin() = true, want false")
+	}
+	if h.Len() != 3 || h.IsPinned("keep") {
+		t.Errorf("Len() = %d after unpin, want 3", h.Len())
+	}
+}
+
+func TestAddKeepsPinnedState(t *testing.T) {
+	h := New(5)
+	h.Add("x")
+	h.TogglePin("x")
+	h.Add("y")
+	h.Add("x")
+
+	if !h.IsPinned("x") {
+		t.Error("re-adding a pinned entry cleared its pin")
+	}
+}
+
+func TestTrimKeepsBrowsePosition(t *testing.T) {
+	h := New(2)
+	h.Add("a")
+	h.TogglePin("a")
+	h.Add("b")
+	h.Add("c")
+
+	h.Up("")
+	if got := h.Up(""); got != "b" {
+		t.Fatalf("Up() = %q, want b", got)
+	}
+
+	// Unpinning trims "a", shifting the entries under the cursor
+	h.TogglePin("a")
+	if got := h.Down(); got != "c" {
+		t.Errorf("Down() after trim = %q, want c", got)
+	}
+}
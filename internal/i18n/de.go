@@ -0,0 +1,587 @@
+package i18n
+
+// german is the German translation.
+var german = map[string]string{
+	// Header, input, and status bar
+	"Character Encoding Analyzer":                                                     "Zeichenkodierungs-Analyse",
+	"Type or paste text to analyze...":                                                "Text zum Analysieren eingeben oder einfügen...",
+	"Binary file, %d bytes • E to decode as text":                                     "Binärdatei, %d Bytes • E dekodiert als Text",
+	"Input line shows %d of %d characters, read-only • x/r/i edit in navigation mode": "Eingabezeile zeigt %d von %d Zeichen, schreibgeschützt • x/r/i bearbeiten im Navigationsmodus",
+	"No characters match filter %q (F to clear)":                                      "Keine Zeichen passen zum Filter %q (F hebt ihn auf)",
+	"Input":         "Eingabe",
+	"Table":         "Tabelle",
+	"Detail":        "Detail",
+	"Compact":       "Kompakt",
+	"Bytes":         "Bytes",
+	"Bits":          "Bits",
+	"Unique":        "Eindeutig",
+	"Pane %s":       "Bereich %s",
+	"Filter: %s":    "Filter: %s",
+	"%d matches":    "%d Treffer",
+	"recording @%c": "Aufnahme @%c",
+	"bytes":         "Bytes",
+	"F1 help":       "F1 Hilfe",
+	"q quit":        "q beenden",
+
+	// Detail view
+	"Character Details":       "Zeichendetails",
+	"Unicode":                 "Unicode",
+	"Hexadecimal":             "Hexadezimal",
+	"Decimal":                 "Dezimal",
+	"Octal":                   "Oktal",
+	"Binary":                  "Binär",
+	"UTF-8 Bytes":             "UTF-8-Bytes",
+	"Position":                "Position",
+	"%d (byte: %d)":           "%d (Byte: %d)",
+	"In %s":                   "In %s",
+	"Search":                  "Suche",
+	"match %d/%d":             "Treffer %d/%d",
+	"About":                   "Beschreibung",
+	"← → to navigate (%d/%d)": "← → navigieren (%d/%d)",
+
+	// Help screen
+	"Help":                        "Hilfe",
+	"↑/↓ scroll • esc close":      "↑/↓ blättern • esc schließen",
+	"%d-%d of %d • %s":            "%d-%d von %d • %s",
+	"Global":                      "Überall",
+	"Input mode":                  "Eingabemodus",
+	"Navigation":                  "Navigation",
+	"Search & filter":             "Suchen & filtern",
+	"Editing":                     "Bearbeiten",
+	"Views":                       "Ansichten",
+	"Split view":                  "Geteilte Ansicht",
+	"Tabs":                        "Tabs",
+	"Clipboard & files":           "Zwischenablage & Dateien",
+	"Colors":                      "Farben",
+	"printable ASCII":             "druckbares ASCII",
+	"whitespace":                  "Leerraum",
+	"control character":           "Steuerzeichen",
+	"extended (non-ASCII)":        "erweitert (nicht ASCII)",
+	"search match":                "Suchtreffer",
+	"cursor":                      "Cursor",
+	"flagged character (minimap)": "markiertes Zeichen (Minimap)",
+
+	// Key bindings
+	"quit":              "beenden",
+	"help":              "Hilfe",
+	"left":              "links",
+	"right":             "rechts",
+	"up":                "hoch",
+	"down":              "runter",
+	"switch view":       "Ansicht wechseln",
+	"confirm":           "bestätigen",
+	"cancel":            "abbrechen",
+	"search":            "suchen",
+	"export":            "exportieren",
+	"import JSON":       "JSON importieren",
+	"pin history entry": "Verlaufseintrag anheften",
+	"copy":              "kopieren",
+	"copy escaped":      "maskiert kopieren",
+	"paste":             "einfügen",
+	"first":             "erstes",
+	"last":              "letztes",
+	"page up":           "Seite hoch",
+	"page down":         "Seite runter",
+	"go to offset":      "zu Position springen",
+	"next control/whitespace/non-ASCII/flagged": "nächstes Steuer-/Leer-/Nicht-ASCII-/markiertes Zeichen",
+	"previous":            "vorheriges",
+	"set mark":            "Marke setzen",
+	"jump to mark / back": "zur Marke springen / zurück",
+	"cycle filter":        "Filter wechseln",
+	"script/block filter": "Schrift-/Blockfilter",
+	"clear filter":        "Filter aufheben",
+	"next match":          "nächster Treffer",
+	"prev match":          "vorheriger Treffer",
+	"search & replace":    "suchen & ersetzen",
+	"delete char":         "Zeichen löschen",
+	"replace char (hex edit in binary files)": "Zeichen ersetzen (Hex-Bearbeitung in Binärdateien)",
+	"insert before":                          "davor einfügen",
+	"insert by name":                         "nach Name einfügen",
+	"block browser":                          "Block-Browser",
+	"undo":                                   "rückgängig",
+	"redo":                                   "wiederholen",
+	"record macro / stop":                    "Makro aufnehmen / beenden",
+	"replay macro n times / last":            "Makro n-mal abspielen / letztes",
+	"split view":                             "Ansicht teilen",
+	"switch pane":                            "Bereich wechseln",
+	"sync scroll":                            "gemeinsam blättern",
+	"rune/byte toggle":                       "Rune/Byte umschalten",
+	"vertical table":                         "vertikale Tabelle",
+	"wrap table into rows":                   "Tabelle umbrechen",
+	"table columns":                          "Tabellenspalten",
+	"sort unique":                            "Eindeutige sortieren",
+	"hex dump group size":                    "Gruppengröße im Hexdump",
+	"hex dump byte order":                    "Bytereihenfolge im Hexdump",
+	"interpret bytes as codepage":            "Bytes als Codepage lesen",
+	"check ASCII/Latin-1/SMS compatibility":  "ASCII-/Latin-1-/SMS-Verträglichkeit prüfen",
+	"bidi levels and display order":          "Bidi-Ebenen und Anzeigereihenfolge",
+	"line break opportunities":               "mögliche Zeilenumbrüche",
+	"version and Unicode data sources":       "Version und Unicode-Datenquellen",
+	"show the bytes keys send (key capture)": "Bytes der Tasten zeigen (Tastenaufzeichnung)",
+	"scroll table left":                      "Tabelle nach links",
+	"scroll table right":                     "Tabelle nach rechts",
+	"statistics & checksums":                 "Statistik & Prüfsummen",
+	"UTF-8 tutorial":                         "UTF-8-Einführung",
+	"control placeholders":                   "Platzhalter für Steuerzeichen",
+	"transform input":                        "Eingabe umwandeln",
+	"decode tokens & encoded runs":           "Tokens & kodierte Abschnitte dekodieren",
+	"interpret backslash escapes":            "Backslash-Escapes auswerten",
+	"switch file encoding":                   "Dateikodierung wechseln",
+	"open file":                              "Datei öffnen",
+	"save byte edits":                        "Byte-Änderungen speichern",
+	"recent files":                           "zuletzt geöffnet",
+	"new tab":                                "neuer Tab",
+	"close tab":                              "Tab schließen",
+	"next tab":                               "nächster Tab",
+	"previous tab":                           "vorheriger Tab",
+	"browse history":                         "Verlauf durchsuchen",
+
+	// Export menu
+	"Export Format":                 "Exportformat",
+	"Export %s":                     "%s exportieren",
+	"Export Diff as %s":             "Unterschiede als %s exportieren",
+	"File exists. Overwrite? (y/n)": "Datei existiert. Überschreiben? (y/n)",
+	"enter save • esc back":         "enter speichern • esc zurück",
+	"Differences between panes A and B, as Text or JSON":                                          "Unterschiede zwischen Bereich A und B, als Text oder JSON",
+	"↑/↓ select • enter confirm • s escape style • e raw encoding • esc cancel":                   "↑/↓ wählen • enter bestätigen • s Escape-Stil • e Rohkodierung • esc abbrechen",
+	"↑/↓ select • enter confirm • s escape style • e raw encoding • d diff of panes • esc cancel": "↑/↓ wählen • enter bestätigen • s Escape-Stil • e Rohkodierung • d Unterschiede der Bereiche • esc abbrechen",
+	"Export failed: %v":                           "Export fehlgeschlagen: %v",
+	"Exported to %s":                              "Exportiert nach %s",
+	"Plain text table":                            "Tabelle als Text",
+	"Structured JSON":                             "Strukturiertes JSON",
+	"Comma-separated values":                      "Kommagetrennte Werte",
+	"Go []byte literal":                           "Go-[]byte-Literal",
+	"C unsigned char[] initializer":               "C-unsigned-char[]-Initialisierer",
+	"String with Unicode escapes":                 "Zeichenkette mit Unicode-Escapes",
+	"Excel workbook":                              "Excel-Arbeitsmappe",
+	"Custom text/template file":                   "Eigene text/template-Datei",
+	"Colored hex dump image":                      "Farbiger Hexdump als Bild",
+	"Binary stringinspect.v1.Analysis message":    "Binäre stringinspect.v1.Analysis-Nachricht",
+	"Text re-encoded as raw bytes":                "Text neu kodiert als rohe Bytes",
+	"Lines with their UTS #39 lookalike skeleton": "Zeilen mit ihrem UTS-#39-Skelett",
+	"Hex dump as the compact view shows it":       "Hexdump wie in der Kompaktansicht",
+
+	// About screen
+	"About StringInspect":    "Über StringInspect",
+	"Version":                "Version",
+	"Built with":             "Erstellt mit",
+	"Character Data":         "Zeichendaten",
+	"Block names":            "Blocknamen",
+	"Character names":        "Zeichennamen",
+	"Lookalikes":             "Doppelgänger",
+	"Categories and scripts": "Kategorien und Schriften",
+	"Normalization":          "Normalisierung",
+	"Bidi classes":           "Bidi-Klassen",
+	"Segmentation":           "Segmentierung",
+	"update-data downloads newer block, name, and lookalike data": "update-data lädt neuere Block-, Namens- und Doppelgängerdaten",
+	"c copy • esc close": "c kopieren • esc schließen",
+
+	// Session restore
+	"Restore Session?":                           "Sitzung wiederherstellen?",
+	"StringInspect did not exit normally on %s.": "StringInspect wurde am %s nicht normal beendet.",
+	"Its last autosave has %d tab(s):":           "Die letzte automatische Sicherung hat %d Tab(s):",
+	"… %d more":                                  "… %d weitere",
+	"untitled":                                   "unbenannt",
+	"%d characters":                              "%d Zeichen",
+	"%d bytes":                                   "%d Bytes",
+	"unsaved edits":                              "ungespeicherte Änderungen",
+	"y restore • n discard":                      "y wiederherstellen • n verwerfen",
+
+	// Views
+	"Pos":          "Pos",
+	"Hex":          "Hex",
+	"Bin":          "Bin",
+	"UTF-8":        "UTF-8",
+	"UTF-16":       "UTF-16",
+	"Char":         "Zeichen",
+	"Dec":          "Dez",
+	"Oct":          "Okt",
+	"Type":         "Typ",
+	"Cat":          "Kat",
+	"Script":       "Schrift",
+	"Word":         "Wort",
+	"Count":        "Anzahl",
+	"First":        "Erstes",
+	"printable":    "druckbar",
+	"control":      "Steuer",
+	"extended":     "erweitert",
+	"unknown":      "unbekannt",
+	"1 rune":       "1 Rune",
+	"%d runes":     "%d Runen",
+	"1 byte":       "1 Byte",
+	"1 grapheme":   "1 Graphem",
+	"%d graphemes": "%d Grapheme",
+	"1 line":       "1 Zeile",
+	"%d lines":     "%d Zeilen",
+	"page %d/%d":   "Seite %d/%d",
+	"« %d more":    "« %d weitere",
+	"%d more »":    "%d weitere »",
+	"↑/↓ scroll (%d/%d) • v horizontal layout": "↑/↓ blättern (%d/%d) • v waagrechtes Layout",
+	"Pane %s • %d chars":                       "Bereich %s • %d Zeichen",
+	"synced":                                   "synchron",
+	"Synchronized scrolling on":                "Synchrones Blättern an",
+	"Synchronized scrolling off":               "Synchrones Blättern aus",
+	"UTF-8 Structure":                          "UTF-8-Struktur",
+	"continuation":                             "Folgebyte",
+	"invalid":                                  "ungültig",
+	"single byte":                              "Einzelbyte",
+	"lead of %d":                               "Startbyte von %d",
+	"Byte %d (%02X):":                          "Byte %d (%02X):",
+	"Codepoint:":                               "Codepunkt:",
+	"Regexp Classes (Go)":                      "Regexp-Klassen (Go)",
+	"Matches:":                                 "Passt:",
+	"No match:":                                "Passt nicht:",
+	"none":                                     "keine",
+	"Compact View (%s)":                        "Kompaktansicht (%s)",
+	"Hex Dump":                                 "Hexdump",
+	"%d-byte groups":                           "%d-Byte-Gruppen",
+	"editing byte %04X: %s":                    "Byte %04X wird bearbeitet: %s",
+	"Group at %04X: ":                          "Gruppe bei %04X: ",
+	"W group size • B byte order • K codepage": "W Gruppengröße • B Bytereihenfolge • K Codepage",
+	"Runes":       "Runen",
+	"UTF-8 bytes": "UTF-8-Bytes",
+	"%s at char %d • %d byte(s) at offset %d • b switch pane": "%s bei Zeichen %d • %d Byte(s) bei Offset %d • b wechselt den Bereich",
+	"Bit Grid (one row per rune)":                             "Bitraster (eine Zeile pro Rune)",
+	"Bit Grid (one row per byte)":                             "Bitraster (eine Zeile pro Byte)",
+	"↑/↓ move • b rune/byte rows":                             "↑/↓ bewegen • b Runen-/Bytezeilen",
+	"Unique Characters (%d distinct, by %s)":                  "Eindeutige Zeichen (%d verschiedene, nach %s)",
+	"↑/↓ select • S sort by first/count/codepoint":            "↑/↓ wählen • S sortiert nach Position/Anzahl/Codepunkt",
+
+	// Status messages
+	"Copied version and data sources to %s":                             "Version und Datenquellen kopiert (%s)",
+	"%d file(s) with unsaved byte edits • ctrl+s saves • q again quits": "%d Datei(en) mit ungespeicherten Byte-Änderungen • ctrl+s speichert • q erneut beendet",
+	"Pinned to history":                                     "Im Verlauf angeheftet",
+	"Unpinned from history":                                 "Vom Verlauf gelöst",
+	"The text %s • tab, then x/r/i edit in place":           "Der Text %s • tab, dann x/r/i bearbeiten direkt",
+	"is longer than the %d characters the input line edits": "ist länger als die %d Zeichen, die die Eingabezeile bearbeitet",
+	"has control characters the input line cannot edit":     "hat Steuerzeichen, die die Eingabezeile nicht bearbeiten kann",
+	"system clipboard":                                      "Systemzwischenablage",
+	"internal register":                                     "internes Register",
+	"Copied to %s: %s":                                      "Kopiert (%s): %s",
+	"Copied %s-escaped string (%d chars) to %s":             "%s-maskierte Zeichenkette (%d Zeichen) kopiert (%s)",
+	"Copied %s (%s) to %s":                                  "%s (%s) kopiert (%s)",
+	"Pasted %d chars from %s":                               "%d Zeichen eingefügt (%s)",
+	"Nothing to paste (%s is empty)":                        "Nichts einzufügen (%s leer)",
+	"Pasted bytes • E to decode as text":                    "Bytes eingefügt • E dekodiert als Text",
+	"Paste of %d bytes: %d changed on the way in":           "Einfügen von %d Bytes: %d unterwegs verändert",
+	"Nothing to export":                                     "Nichts zu exportieren",
+	"Nothing to search":                                     "Nichts zu durchsuchen",
+	"Nothing to check":                                      "Nichts zu prüfen",
+	"Nothing to reorder":                                    "Nichts umzuordnen",
+	"Nothing to wrap":                                       "Nichts umzubrechen",
+	"Nothing to edit":                                       "Nichts zu bearbeiten",
+	"Nothing to mark":                                       "Nichts zu markieren",
+	"Table: one character per row":                          "Tabelle: ein Zeichen pro Zeile",
+	"Table: one character per column":                       "Tabelle: ein Zeichen pro Spalte",
+	"Table: wrapped into rows":                              "Tabelle: in Zeilen umbrochen",
+	"Table: one row, scrolled sideways":                     "Tabelle: eine Zeile, seitlich geblättert",
+	"Synchronized scrolling: %v":                            "Synchrones Blättern: %v",
+	"Search cleared":                                        "Suche aufgehoben",
+	"Match %d/%d":                                           "Treffer %d/%d",
+	"No search matches":                                     "Keine Suchtreffer",
+	"No matches":                                            "Keine Treffer",
+	"Import failed: %v":                                     "Import fehlgeschlagen: %v",
+	"Imported %d chars from %s":                             "%d Zeichen importiert aus %s",
+	"Surrogate codepoints cannot be inserted":               "Surrogat-Codepunkte können nicht eingefügt werden",
+	"Inserted U+%04X %s":                                    "U+%04X %s eingefügt",
+	"Bytes shown as ASCII":                                  "Bytes als ASCII angezeigt",
+	"Bytes shown as %s":                                     "Bytes als %s angezeigt",
+	"Hex dump in %d-byte groups":                            "Hexdump in %d-Byte-Gruppen",
+	"Groups read as %s":                                     "Gruppen gelesen als %s",
+	"little-endian":                                         "Little-Endian",
+	"big-endian":                                            "Big-Endian",
+	" (W groups bytes to see the difference)":               " (W gruppiert Bytes, um den Unterschied zu sehen)",
+	"At least one column must stay visible":                 "Mindestens eine Spalte muss sichtbar bleiben",
+	"Columns updated, save failed: %v":                      "Spalten geändert, Speichern fehlgeschlagen: %v",
+	"Columns updated (no config file)":                      "Spalten geändert (keine Konfigurationsdatei)",
+	"Columns saved to %s":                                   "Spalten gespeichert in %s",
+	"Status bar updated, save failed: %v":                   "Statusleiste geändert, Speichern fehlgeschlagen: %v",
+	"Placeholders: %s":                                      "Platzhalter: %s",
+	" (save failed: %v)":                                    " (Speichern fehlgeschlagen: %v)",
+	"Files are identical":                                   "Die Dateien sind identisch",
+	"%s with BOM":                                           "%s mit BOM",
+	"Same text, different encoding: %s vs %s (first byte difference at %d)": "Gleicher Text, andere Kodierung: %s und %s (erster Byte-Unterschied bei %d)",
+	"Same text apart from line endings, first at character %d (byte %d)":    "Gleicher Text bis auf Zeilenenden, zuerst bei Zeichen %d (Byte %d)",
+	"Content differs at character %d (byte %d)":                             "Inhalt unterscheidet sich bei Zeichen %d (Byte %d)",
+	"Edit failed: %v":         "Bearbeiten fehlgeschlagen: %v",
+	"Replaced with %q":        "Ersetzt durch %q",
+	"Inserted %q":             "%q eingefügt",
+	"Deleted %s":              "%s gelöscht",
+	"Replace failed: %v":      "Ersetzen fehlgeschlagen: %v",
+	"Replaced 1 occurrence":   "1 Vorkommen ersetzt",
+	"Replaced %d occurrences": "%d Vorkommen ersetzt",
+	"Nothing to undo":         "Nichts rückgängig zu machen",
+	"Nothing to redo":         "Nichts wiederherzustellen",
+	"Undo (%d more)":          "Rückgängig (%d weitere)",
+	"Redo (%d more)":          "Wiederhergestellt (%d weitere)",
+	"%s failed: %v":           "%s fehlgeschlagen: %v",
+	"%s: no changes":          "%s: keine Änderungen",
+	"%s: %s (u to undo)":      "%s: %s (u macht rückgängig)",
+	"Decoded %s in pane B (w to switch back, u to undo)":           "%s in Bereich B dekodiert (w wechselt zurück, u macht rückgängig)",
+	"No identifiers, tokens, or encoded runs found":                "Keine Bezeichner, Tokens oder kodierten Abschnitte gefunden",
+	"Close the split view (|) to open files":                       "Geteilte Ansicht schließen (|), um Dateien zu öffnen",
+	"Close the split view (|) to use tabs":                         "Geteilte Ansicht schließen (|), um Tabs zu nutzen",
+	"Open failed: %v":                                              "Öffnen fehlgeschlagen: %v",
+	"Opened %s (%d bytes)":                                         "%s geöffnet (%d Bytes)",
+	"Saving recent files failed: %v":                               "Speichern der zuletzt geöffneten Dateien fehlgeschlagen: %v",
+	"No recent files (ctrl+o to open one)":                         "Keine zuletzt geöffneten Dateien (ctrl+o öffnet eine)",
+	"Binary file (%s): showing bytes • E to decode as text":        "Binärdatei (%s): Bytes werden angezeigt • E dekodiert als Text",
+	"Decoded as %s (%s) • E to switch encoding":                    "Dekodiert als %s (%s) • E wechselt die Kodierung",
+	"Showing bytes of a binary file • E to decode as text first":   "Bytes einer Binärdatei werden angezeigt • E dekodiert erst als Text",
+	"Encoding can only be switched for files (ctrl+o to open one)": "Die Kodierung lässt sich nur bei Dateien wechseln (ctrl+o öffnet eine)",
+	"Showing %d raw bytes":                                         "%d rohe Bytes werden angezeigt",
+	"Decoding as %s":                                               "Dekodiert als %s",
+	" (detected by %s)":                                            " (erkannt an: %s)",
+	"Bytes of %s • E to decode as text":                            "Bytes von %s • E dekodiert als Text",
+	" • %d characters not in %s replaced":                          " • %d Zeichen, die es in %s nicht gibt, ersetzt",
+	"Unknown script or block %q":                                   "Unbekannte Schrift oder unbekannter Block %q",
+	"Unknown filter kind %q (use script: or block:)":               "Unbekannte Filterart %q (script: oder block: verwenden)",
+	"Filter: %s (%d of %d chars)":                                  "Filter: %s (%d von %d Zeichen)",
+	"Filter cleared":                                               "Filter aufgehoben",
+	"Goto failed: %v":                                              "Springen fehlgeschlagen: %v",
+	"At char %d (byte %d)":                                         "Bei Zeichen %d (Byte %d)",
+	"Unknown jump class %q (c, w, x, f)":                           "Unbekannte Sprungklasse %q (c, w, x, f)",
+	"%s at char %d":                                                "%s bei Zeichen %d",
+	"No previous %s":                                               "Kein vorheriges %s",
+	"No next %s":                                                   "Kein nächstes %s",
+	"unusual whitespace":                                           "ungewöhnliches Leerzeichen",
+	"non-ASCII character":                                          "Nicht-ASCII-Zeichen",
+	"flagged character":                                            "markiertes Zeichen",
+	"Hex edit: type two hex digits per byte • u undo • ctrl+s save • esc done": "Hex-Bearbeitung: zwei Hexziffern pro Byte • u rückgängig • ctrl+s speichern • esc fertig",
+	"No byte edits to undo":                                  "Keine Byte-Änderungen rückgängig zu machen",
+	"Restored byte %04X to %02X":                             "Byte %04X wieder auf %02X gesetzt",
+	"Only byte edits of binary files can be saved":           "Nur Byte-Änderungen an Binärdateien lassen sich speichern",
+	"Nothing to save to: the file was read from stdin":       "Kein Speicherziel: die Datei kam von stdin",
+	"No unsaved byte edits":                                  "Keine ungespeicherten Byte-Änderungen",
+	"Save failed: %v":                                        "Speichern fehlgeschlagen: %v",
+	"Saved %d bytes to %s":                                   "%d Bytes gespeichert in %s",
+	"Macro registers are a-z, not %q":                        "Makroregister sind a-z, nicht %q",
+	"Recording @%c • Q stops":                                "Aufnahme @%c • Q beendet",
+	"Recorded @%c: %d keys":                                  "@%c aufgenommen: %d Tasten",
+	"No macro replayed yet":                                  "Noch kein Makro abgespielt",
+	"A macro cannot replay a macro":                          "Ein Makro kann kein Makro abspielen",
+	"@%c is being recorded":                                  "@%c wird gerade aufgenommen",
+	"Macro @%c is empty (Q%c records it)":                    "Makro @%c ist leer (Q%c nimmt es auf)",
+	"Replayed @%c %d of %d times, stopped at %d keys":        "@%c %d von %d Mal abgespielt, bei %d Tasten angehalten",
+	"Replayed @%c %d time(s)":                                "@%c %d Mal abgespielt",
+	"Marks are a-z, not %q":                                  "Marken sind a-z, nicht %q",
+	"Marks are a-z or `, not %q":                             "Marken sind a-z oder `, nicht %q",
+	"Mark %s at char %d":                                     "Marke %s bei Zeichen %d",
+	"No jump to go back from":                                "Kein Sprung, von dem zurückzukehren wäre",
+	"Mark %s not set (m%s sets it)":                          "Marke %s nicht gesetzt (m%s setzt sie)",
+	"Mark %s is past the end of the input":                   "Marke %s liegt hinter dem Ende der Eingabe",
+	"Mark %s is hidden by the filter; at the next character": "Marke %s ist vom Filter verborgen; beim nächsten Zeichen",
+	"Back at char %d":                                        "Zurück bei Zeichen %d",
+	"Autosave failed: %v":                                    "Automatische Sicherung fehlgeschlagen: %v",
+	"Previous session discarded":                             "Vorherige Sitzung verworfen",
+	"Removing the previous session failed: %v":               "Entfernen der vorherigen Sitzung fehlgeschlagen: %v",
+	"Restored %d tab(s) from %s":                             "%d Tab(s) wiederhergestellt vom %s",
+	"Split view closed":                                      "Geteilte Ansicht geschlossen",
+	"Split view: w switch pane • = sync scroll • | close":    "Geteilte Ansicht: w Bereich wechseln • = synchron blättern • | schließen",
+	"Tab %d of %d":                                           "Tab %d von %d",
+	"Tab %d of %d: %s":                                       "Tab %d von %d: %s",
+	"The last tab cannot be closed":                          "Der letzte Tab lässt sich nicht schließen",
+	"Closed %s":                                              "%s geschlossen",
+	"Only one tab open (ctrl+t opens another)":               "Nur ein Tab offen (ctrl+t öffnet einen weiteren)",
+	"Sorted by %s":                                           "Sortiert nach %s",
+	"count":                                                  "Anzahl",
+	"codepoint":                                              "Codepunkt",
+	"first position":                                         "erster Position",
+
+	// Overlays
+	"hex, dec, char, name:, cat:, script:": "Hex, Dez, Zeichen, name:, cat:, script:",
+	"File: ":                               "Datei: ",
+	"123, b4096, or 12:5":                  "123, b4096 oder 12:5",
+	"text or \\u200B":                      "Text oder \\u200B",
+	"Find:    ":                            "Suchen:   ",
+	"Replace: ":                            "Ersetzen: ",
+	"A, U+00A0, or \\u200B":                "A, U+00A0 oder \\u200B",
+	"U+00A0 or no-break space":             "U+00A0 oder no-break space",
+	"Import JSON Export":                   "JSON-Export importieren",
+	"enter import • esc cancel":            "enter importieren • esc abbrechen",
+	"Found %d match(es) - Tab to cycle, Enter to confirm":  "%d Treffer gefunden - Tab wechselt, Enter bestätigt",
+	"Type hex (0x41), decimal (65), or character (A)":      "Hex (0x41), dezimal (65) oder Zeichen (A) eingeben",
+	"or name:EM DASH, cat:Cf, script:Arabic, block:Arrows": "oder name:EM DASH, cat:Cf, script:Arabic, block:Arrows",
+	"enter confirm • esc cancel • tab next match":          "enter bestätigen • esc abbrechen • tab nächster Treffer",
+	"Fix for %s":             "Korrektur für %s",
+	"%d characters replaced": "%d Zeichen ersetzt",
+	"Compatibility Check":    "Verträglichkeitsprüfung",
+	"✓ All %d characters render alike in any terminal":         "✓ Alle %d Zeichen sehen in jedem Terminal gleich aus",
+	"✗ %d of %d characters may render wrong in some terminals": "✗ %d von %d Zeichen werden in manchen Terminals womöglich falsch dargestellt",
+	"✓ All %d characters survive in %s":                        "✓ Alle %d Zeichen bleiben in %s erhalten",
+	"✗ %d of %d characters do not survive in %s":               "✗ %d von %d Zeichen gehen in %s verloren",
+	"tab/←/→ target • ↑/↓ select • enter jump to character":    "tab/←/→ Ziel • ↑/↓ wählen • enter springt zum Zeichen",
+	" • f apply %d suggestions":                                " • f übernimmt %d Vorschläge",
+	" • esc close":                                             " • esc schließen",
+	"left to right":                                            "von links nach rechts",
+	"right to left":                                            "von rechts nach links",
+	"Bidirectional Text":                                       "Bidirektionaler Text",
+	"Paragraph %d of %d • base direction %s":                   "Absatz %d von %d • Grundrichtung %s",
+	"Logical":                "Logisch",
+	"Level":                  "Ebene",
+	"Visual":                 "Visuell",
+	"Class %s, %s":           "Klasse %s, %s",
+	"Level %d, displayed %s": "Ebene %d, dargestellt %s",
+	"Odd levels run right to left; each level reverses the runs at or above it": "Ungerade Ebenen laufen von rechts nach links; jede Ebene kehrt die Abschnitte ab ihrer Höhe um",
+	"←/→ select • ↑/↓ paragraph • enter jump to character • esc close":          "←/→ wählen • ↑/↓ Absatz • enter springt zum Zeichen • esc schließen",
+	"left-to-right letter":                                  "Buchstabe von links nach rechts",
+	"right-to-left letter":                                  "Buchstabe von rechts nach links",
+	"Arabic letter":                                         "arabischer Buchstabe",
+	"European digit":                                        "europäische Ziffer",
+	"European number separator":                             "europäisches Zahlentrennzeichen",
+	"European number terminator":                            "europäisches Zahlenendzeichen",
+	"Arabic digit":                                          "arabische Ziffer",
+	"number separator":                                      "Zahlentrennzeichen",
+	"nonspacing mark, takes the direction before it":        "nicht raumgreifendes Zeichen, übernimmt die Richtung davor",
+	"ignored by the algorithm":                              "vom Algorithmus übergangen",
+	"paragraph separator":                                   "Absatztrennzeichen",
+	"segment separator":                                     "Segmenttrennzeichen",
+	"neutral, takes the direction around it":                "neutral, übernimmt die umgebende Richtung",
+	"opens a left-to-right embedding":                       "öffnet eine Einbettung von links nach rechts",
+	"opens a right-to-left embedding":                       "öffnet eine Einbettung von rechts nach links",
+	"forces left to right":                                  "erzwingt links nach rechts",
+	"forces right to left":                                  "erzwingt rechts nach links",
+	"closes an embedding or override":                       "schließt eine Einbettung oder Überschreibung",
+	"opens a left-to-right isolate":                         "öffnet eine Isolierung von links nach rechts",
+	"opens a right-to-left isolate":                         "öffnet eine Isolierung von rechts nach links",
+	"opens an isolate in the direction of its first letter": "öffnet eine Isolierung in der Richtung ihres ersten Buchstabens",
+	"closes an isolate":                                     "schließt eine Isolierung",
+	"block %d/%d":                                           "Block %d/%d",
+	"<unassigned>":                                          "<nicht vergeben>",
+	"invalid (surrogate)":                                   "ungültig (Surrogat)",
+	"arrows move • pgup/pgdn block • enter insert • esc close": "Pfeile bewegen • pgup/pgdn Block • enter einfügen • esc schließen",
+	"horizontal":         "waagrecht",
+	"vertical":           "senkrecht",
+	"Table Columns (%s)": "Tabellenspalten (%s)",
+	"space toggle • K/J move • d defaults • enter save • esc cancel": "space umschalten • K/J verschieben • d Standard • enter speichern • esc abbrechen",
+	"Name":                  "Name",
+	"All":                   "Alle",
+	"Non-printable":         "Nicht druckbar",
+	"Control":               "Steuerzeichen",
+	"Extended":              "Erweitert",
+	"Flagged":               "Markiert",
+	"Script / Block Filter": "Schrift-/Blockfilter",
+	"Cyrillic • !Latin • block:Arrows • empty to clear": "Cyrillic • !Latin • block:Arrows • leer hebt auf",
+	"enter apply • esc cancel":                          "enter anwenden • esc abbrechen",
+	"Go To":                                             "Springen",
+	"123 char index • b123 byte offset • 12:5 line:col • 0x hex": "123 Zeichenindex • b123 Byte-Offset • 12:5 Zeile:Spalte • 0x hex",
+	"enter go • esc cancel":                 "enter springen • esc abbrechen",
+	"bracketed paste":                       "Einfügen in Klammern (bracketed paste)",
+	"CSI sequence, final byte %q":           "CSI-Sequenz, Endbyte %q",
+	", parameters %s":                       ", Parameter %s",
+	"SS3 sequence, final byte %q":           "SS3-Sequenz, Endbyte %q",
+	"escape":                                "Escape",
+	"escape prefix (alt) + ":                "Escape-Präfix (Alt) + ",
+	"not valid UTF-8":                       "kein gültiges UTF-8",
+	"character U+%04X":                      "Zeichen U+%04X",
+	"Key Capture":                           "Tastenaufzeichnung",
+	"● Recording: press keys or paste text": "● Aufnahme: Tasten drücken oder Text einfügen",
+	"Stopped":                               "Angehalten",
+	" • raw bytes unavailable, only text and control keys are rebuilt": " • rohe Bytes nicht verfügbar, nur Text und Steuertasten werden nachgebildet",
+	"No keys yet":               "Noch keine Tasten",
+	"(read with the key above)": "(mit der Taste darüber gelesen)",
+	"(unknown)":                 "(unbekannt)",
+	"esc twice stops recording": "esc zweimal beendet die Aufnahme",
+	"↑/↓ select • enter analyze bytes in a new tab • r record again • esc close": "↑/↓ wählen • enter analysiert die Bytes in neuem Tab • r erneut aufnehmen • esc schließen",
+	"Line Breaks": "Zeilenumbrüche",
+	"%d break opportunities, %d required • wrapped at %d columns into %d lines":        "%d mögliche Umbrüche, %d erzwungen • bei %d Spalten in %d Zeilen umbrochen",
+	"╵ a line may start here • ┊ line width • red runs past it, with nowhere to break": "╵ hier darf eine Zeile beginnen • ┊ Zeilenbreite • rot ragt darüber hinaus, ohne Umbruchmöglichkeit",
+	"←/→ width • ↑/↓ scroll • esc close":                                               "←/→ Breite • ↑/↓ blättern • esc schließen",
+	"Paste Report":                                   "Einfügebericht",
+	"as sent by the terminal":                        "wie vom Terminal gesendet",
+	"as decoded, invalid UTF-8 already gone":         "wie dekodiert, ungültiges UTF-8 bereits entfernt",
+	"Pasted %d bytes (%s), input kept %d characters": "%d Bytes eingefügt (%s), Eingabe behielt %d Zeichen",
+	"not UTF-8, dropped":                             "kein UTF-8, verworfen",
+	"replaced by a space":                            "durch ein Leerzeichen ersetzt",
+	"dropped":                                        "verworfen",
+	"cut off at the input limit":                     "an der Eingabegrenze abgeschnitten",
+	"↑/↓ select • enter analyze the pasted bytes in a new tab • esc close": "↑/↓ wählen • enter analysiert die eingefügten Bytes in neuem Tab • esc schließen",
+	"Insert Character": "Zeichen einfügen",
+	"Type a codepoint (U+00A0) or name (NO-BREAK SPACE)":   "Codepunkt (U+00A0) oder Name (NO-BREAK SPACE) eingeben",
+	"No matching characters":                               "Keine passenden Zeichen",
+	"↑/↓ select • enter insert before cursor • esc cancel": "↑/↓ wählen • enter fügt vor dem Cursor ein • esc abbrechen",
+	"Search & Replace":                                     "Suchen & Ersetzen",
+	"literal (\\u escapes allowed)":                        "wörtlich (\\u-Escapes erlaubt)",
+	"regex (Go syntax, $1 in replacement)":                 "Regex (Go-Syntax, $1 im Ersatz)",
+	"Mode: %s":                                             "Modus: %s",
+	"enter start • tab switch field • ctrl+r toggle regex • esc cancel": "enter starten • tab Feld wechseln • ctrl+r Regex umschalten • esc abbrechen",
+	"Replace?":           "Ersetzen?",
+	"%q → %q at char %d": "%q → %q bei Zeichen %d",
+	"y replace • n skip • a replace all • q stop": "y ersetzen • n überspringen • a alle ersetzen • q aufhören",
+	"Statistics":                       "Statistik",
+	"Status bar":                       "Statusleiste",
+	"runes":                            "Runen",
+	"graphemes":                        "Grapheme",
+	"lines":                            "Zeilen",
+	"warnings":                         "Warnungen",
+	"Checksums":                        "Prüfsummen",
+	"File":                             "Datei",
+	"The input is already in NFC form": "Die Eingabe ist bereits in NFC",
+	"1-5 toggle • ↑/↓ select • c copy checksum • enter close": "1-5 umschalten • ↑/↓ wählen • c Prüfsumme kopieren • enter schließen",
+	"done":            "erledigt",
+	"Transform Input": "Eingabe umwandeln",
+	"Result: ":        "Ergebnis: ",
+	"No changes":      "Keine Änderungen",
+	"arrows select • enter or key apply • esc cancel": "Pfeile wählen • enter oder Taste anwenden • esc abbrechen",
+	"Upper case":                  "Großbuchstaben",
+	"Lower case":                  "Kleinbuchstaben",
+	"Title case":                  "Wortanfänge groß",
+	"Reverse":                     "Umkehren",
+	"Trim surrounding whitespace": "Umgebenden Leerraum entfernen",
+	"Strip diacritics":            "Diakritika entfernen",
+	"Remove invisible characters": "Unsichtbare Zeichen entfernen",
+	"ASCII punctuation":           "ASCII-Satzzeichen",
+	"Normalize whitespace":        "Leerraum vereinheitlichen",
+	"ROT13":                       "ROT13",
+	"ROT47":                       "ROT47",
+	"Caesar decode (guess shift)": "Caesar entschlüsseln (Verschiebung raten)",
+	"To base64":                   "Nach Base64",
+	"From base64":                 "Aus Base64",
+	"To hex":                      "Nach Hex",
+	"From hex":                    "Aus Hex",
+	"To percent-encoding":         "Nach Prozentkodierung",
+	"From percent-encoding":       "Aus Prozentkodierung",
+	"Unescape HTML entities":      "HTML-Entitäten auflösen",
+	"Interpret backslash escapes": "Backslash-Escapes auswerten",
+	" and %d more":                " und %d weitere",
+	"Found %s • D to decode":      "%s gefunden • D dekodiert",
+	" • \\ to interpret escapes":  " • \\ wertet Escapes aus",
+	"Decoded Tokens (%d)":         "Dekodierte Tokens (%d)",
+	"Invalid: %s":                 "Ungültig: %s",
+	"↑/↓ select • enter jump to token • a analyze decoded in split view":           "↑/↓ wählen • enter springt zum Token • a analysiert Dekodiertes in geteilter Ansicht",
+	"How %s is encoded in UTF-8 (step %d/%d)":                                      "Wie %s in UTF-8 kodiert wird (Schritt %d/%d)",
+	"Byte %02X is not valid UTF-8 on its own, so there is no codepoint to encode.": "Byte %02X ist allein kein gültiges UTF-8, es gibt also keinen Codepunkt zu kodieren.",
+	"It was probably produced by a different encoding or a truncated sequence.":    "Es stammt wohl aus einer anderen Kodierung oder einer abgeschnittenen Sequenz.",
+	"The character %s is %s.":                                                          "Das Zeichen %s ist %s.",
+	"Unicode gives it the number (codepoint) %s,":                                      "Unicode gibt ihm die Nummer (den Codepunkt) %s,",
+	"which is %d in decimal and 0x%X in hexadecimal.":                                  "das ist %d dezimal und 0x%X hexadezimal.",
+	"UTF-8 stores this number in 1 to 4 bytes. Let's see how.":                         "UTF-8 speichert diese Nummer in 1 bis 4 Bytes. Sehen wir uns an, wie.",
+	"First write the codepoint in binary:":                                             "Zuerst den Codepunkt binär schreiben:",
+	"That is %d significant bits.":                                                     "Das sind %d signifikante Bits.",
+	"The number of bits decides how many bytes are needed:":                            "Die Anzahl der Bits bestimmt, wie viele Bytes nötig sind:",
+	"%d byte(s)  %2d bits":                                                             "%d Byte(s)  %2d Bits",
+	"%s falls in the highlighted range, so it takes %d byte(s) with room for %d bits.": "%s liegt im hervorgehobenen Bereich, belegt also %d Byte(s) mit Platz für %d Bits.",
+	"Pad the binary to %d bits and cut it into the byte slots (x in the pattern):":     "Die Binärzahl auf %d Bits auffüllen und auf die Byte-Plätze (x im Muster) verteilen:",
+	"A single byte carries all 7 bits.":                                                "Ein einzelnes Byte trägt alle 7 Bits.",
+	"Continuation bytes each carry 6 bits; the lead byte gets what is left.":           "Folgebytes tragen je 6 Bits; das Startbyte bekommt den Rest.",
+	"Prefix each slot with its marker bits and read the bytes off:":                    "Jedem Platz seine Markierungsbits voranstellen und die Bytes ablesen:",
+	"continuation (10)":                                                                "Folgebyte (10)",
+	"lead (%s)":                                                                        "Startbyte (%s)",
+	"So %s is stored as the bytes %s.":                                                 "%s wird also als die Bytes %s gespeichert.",
+	"A decoder counts the 1s of the lead byte to know how many bytes follow.":          "Ein Decoder zählt die Einsen des Startbytes, um zu wissen, wie viele Bytes folgen.",
+	"→/enter next • ← back • esc close":                                                "→/enter weiter • ← zurück • esc schließen",
+	"Replace Character":                                                                "Zeichen ersetzen",
+	"Insert Before Cursor":                                                             "Vor dem Cursor einfügen",
+	"Recent Files":                                                                     "Zuletzt geöffnet",
+	" (missing)":                                                                       " (fehlt)",
+	"↑/↓ select • 1-9/enter open • x forget • esc close":                               "↑/↓ wählen • 1-9/enter öffnen • x vergessen • esc schließen",
+	"Interpret Bytes As":                                                               "Bytes lesen als",
+	"→ is what the input meant if it is UTF-8 misread in that codepage":                "→ ist, was die Eingabe meinte, falls sie UTF-8 ist, das in dieser Codepage falsch gelesen wurde",
+	"↑/↓ select • enter show hex dump and details in it • esc close":                   "↑/↓ wählen • enter zeigt Hexdump und Details darin • esc schließen",
+	"No files here.":                                                                   "Keine Dateien hier.",
+	"Open File":                                                                        "Datei öffnen",
+	"↑/↓ select • → enter folder • ← parent • . hidden files • enter open • esc cancel": "↑/↓ wählen • → Ordner öffnen • ← übergeordnet • . versteckte Dateien • enter öffnen • esc abbrechen",
+	"Not in %s (%d)": "Nicht in %s (%d)",
+	"→ is what was written instead • ↑/↓ select • enter jump to character • esc close": "→ ist, was stattdessen geschrieben wurde • ↑/↓ wählen • enter springt zum Zeichen • esc schließen",
+	"↑/↓ rows %d–%d of %d • z one row":                                                 "↑/↓ Zeilen %d–%d von %d • z eine Zeile",
+}
@@ -0,0 +1,95 @@
+// Package i18n translates the strings of the user interface. Messages are
+// looked up by their English text, so a string without a translation shows
+// in English and code reads the same with or without one.
+package i18n
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// catalogs maps each locale to its translations, keyed by English text.
+var catalogs = map[string]map[string]string{
+	"de": german,
+}
+
+// current is the catalog in use, nil for English.
+var current map[string]string
+
+// Locales returns the locales there are translations for, and "en".
+func Locales() []string {
+	locales := []string{"en"}
+	for l := range catalogs {
+		locales = append(locales, l)
+	}
+	sort.Strings(locales[1:])
+	return locales
+}
+
+// Detect picks the locale to use: the config setting if it names one with
+// translations, else the first of $LC_ALL, $LC_MESSAGES, and $LANG that
+// is set, as POSIX orders them. Settings like "de_AT.UTF-8" select "de".
+// It returns "en" when nothing matches.
+func Detect(setting string) string {
+	if l := match(setting); l != "" {
+		return l
+	}
+	for _, env := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		if v := os.Getenv(env); v != "" {
+			if l := match(v); l != "" {
+				return l
+			}
+			break
+		}
+	}
+	return "en"
+}
+
+// match returns the locale a setting like "de", "de_DE", or
+// "de_DE.UTF-8@euro" selects, "" if there is none.
+func match(setting string) string {
+	lang := strings.ToLower(setting)
+	if i := strings.IndexAny(lang, "_-.@"); i >= 0 {
+		lang = lang[:i]
+	}
+	if _, ok := catalogs[lang]; ok || lang == "en" {
+		return lang
+	}
+	return ""
+}
+
+// SetLocale switches the translations to locale, one of Locales.
+func SetLocale(locale string) error {
+	if locale == "en" {
+		current = nil
+		return nil
+	}
+	catalog, ok := catalogs[locale]
+	if !ok {
+		return fmt.Errorf("no translations for %q (have %s)", locale, strings.Join(Locales(), ", "))
+	}
+	current = catalog
+	return nil
+}
+
+// T returns the translation of s, or s when it has none.
+func T(s string) string {
+	if t, ok := current[s]; ok {
+		return t
+	}
+	return s
+}
+
+// Tf formats the translation of format with args, as fmt.Sprintf.
+func Tf(format string, args ...any) string {
+	return fmt.Sprintf(T(format), args...)
+}
+
+// Translated reports whether s has a translation in the current locale.
+// English has none, as messages are already English.
+func Translated(s string) bool {
+	_, ok := current[s]
+	return ok
+}
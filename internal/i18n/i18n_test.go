@@ -0,0 +1,71 @@
+package i18n
+
+import (
+	"regexp"
+	"slices"
+	"testing"
+)
+
+// verbs matches the fmt verbs of a message.
+var verbs = regexp.MustCompile(`%[-+# 0]*[0-9]*(\.[0-9]+)?[a-zA-Z%]`)
+
+func TestCatalogVerbs(t *testing.T) {
+	for locale, catalog := range catalogs {
+		for en, tr := range catalog {
+			if got, want := verbs.FindAllString(tr, -1), verbs.FindAllString(en, -1); !slices.Equal(got, want) {
+				t.Errorf("%s: %q has verbs %v, want %v as in %q", locale, tr, got, want, en)
+			}
+		}
+	}
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		setting, lcAll, lang string
+		want                 string
+	}{
+		{"", "", "", "en"},
+		{"de", "", "", "de"},
+		{"", "", "de_DE.UTF-8", "de"},
+		{"", "de_AT", "en_US.UTF-8", "de"},
+		{"", "en_US.UTF-8", "de_DE.UTF-8", "en"}, // LC_ALL wins over LANG
+		{"en", "", "de_DE.UTF-8", "en"},
+		{"xx", "", "de_DE", "de"}, // Unknown settings are ignored
+		{"", "", "fr_FR.UTF-8", "en"},
+	}
+	for _, tt := range tests {
+		t.Setenv("LC_ALL", tt.lcAll)
+		t.Setenv("LC_MESSAGES", "")
+		t.Setenv("LANG", tt.lang)
+		if got := Detect(tt.setting); got != tt.want {
+			t.Errorf("Detect(%q) with LC_ALL=%q LANG=%q = %q, want %q", tt.setting, tt.lcAll, tt.lang, got, tt.want)
+		}
+	}
+}
+
+func TestSetLocale(t *testing.T) {
+	defer SetLocale("en")
+
+	if err := SetLocale("de"); err != nil {
+		t.Fatalf("SetLocale(de) error = %v", err)
+	}
+	if got := T("Export Format"); got != "Exportformat" {
+		t.Errorf("T(Export Format) = %q", got)
+	}
+	if got := Tf("%d matches", 3); got != "3 Treffer" {
+		t.Errorf("Tf(%%d matches) = %q", got)
+	}
+	if got := T("no such message"); got != "no such message" {
+		t.Errorf("T of an untranslated message = %q, want it unchanged", got)
+	}
+
+	if err := SetLocale("en"); err != nil {
+		t.Fatalf("SetLocale(en) error = %v", err)
+	}
+	if got := T("Export Format"); got != "Export Format" {
+		t.Errorf("T(Export Format) in English = %q", got)
+	}
+	if err := SetLocale("xx"); err == nil {
+		t.Error("SetLocale(xx) succeeded")
+	}
+}
@@ -0,0 +1,576 @@
+// Protocol buffer schema for StringInspect analysis results.
+//
+// Regenerate the Go types with `make proto` after editing this file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: stringinspect/v1/analysis.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CharType is the category of a character.
+type CharType int32
+
+const (
+	CharType_CHAR_TYPE_UNSPECIFIED CharType = 0
+	CharType_CHAR_TYPE_PRINTABLE   CharType = 1
+	CharType_CHAR_TYPE_WHITESPACE  CharType = 2
+	CharType_CHAR_TYPE_CONTROL     CharType = 3
+	CharType_CHAR_TYPE_EXTENDED    CharType = 4
+)
+
+// Enum value maps for CharType.
+var (
+	CharType_name = map[int32]string{
+		0: "CHAR_TYPE_UNSPECIFIED",
+		1: "CHAR_TYPE_PRINTABLE",
+		2: "CHAR_TYPE_WHITESPACE",
+		3: "CHAR_TYPE_CONTROL",
+		4: "CHAR_TYPE_EXTENDED",
+	}
+	CharType_value = map[string]int32{
+		"CHAR_TYPE_UNSPECIFIED": 0,
+		"CHAR_TYPE_PRINTABLE":   1,
+		"CHAR_TYPE_WHITESPACE":  2,
+		"CHAR_TYPE_CONTROL":     3,
+		"CHAR_TYPE_EXTENDED":    4,
+	}
+)
+
+func (x CharType) Enum() *CharType {
+	p := new(CharType)
+	*p = x
+	return p
+}
+
+func (x CharType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CharType) Descriptor() protoreflect.EnumDescriptor {
+	return file_stringinspect_v1_analysis_proto_enumTypes[0].Descriptor()
+}
+
+func (CharType) Type() protoreflect.EnumType {
+	return &file_stringinspect_v1_analysis_proto_enumTypes[0]
+}
+
+func (x CharType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CharType.Descriptor instead.
+func (CharType) EnumDescriptor() ([]byte, []int) {
+	return file_stringinspect_v1_analysis_proto_rawDescGZIP(), []int{0}
+}
+
+// Character holds all encoding representations of a single character.
+type Character struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Position  int64                  `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
+	Codepoint uint32                 `protobuf:"varint,2,opt,name=codepoint,proto3" json:"codepoint,omitempty"`
+	// Display string, with placeholders for non-printable characters.
+	Char   string `protobuf:"bytes,3,opt,name=char,proto3" json:"char,omitempty"`
+	Hex    string `protobuf:"bytes,4,opt,name=hex,proto3" json:"hex,omitempty"`
+	Octal  string `protobuf:"bytes,5,opt,name=octal,proto3" json:"octal,omitempty"`
+	Binary string `protobuf:"bytes,6,opt,name=binary,proto3" json:"binary,omitempty"`
+	// Codepoint in U+XXXX notation.
+	Unicode       string   `protobuf:"bytes,7,opt,name=unicode,proto3" json:"unicode,omitempty"`
+	Utf8Bytes     []byte   `protobuf:"bytes,8,opt,name=utf8_bytes,json=utf8Bytes,proto3" json:"utf8_bytes,omitempty"`
+	Type          CharType `protobuf:"varint,9,opt,name=type,proto3,enum=stringinspect.v1.CharType" json:"type,omitempty"`
+	ByteOffset    int64    `protobuf:"varint,10,opt,name=byte_offset,json=byteOffset,proto3" json:"byte_offset,omitempty"`
+	RuneOffset    int64    `protobuf:"varint,11,opt,name=rune_offset,json=runeOffset,proto3" json:"rune_offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Character) Reset() {
+	*x = Character{}
+	mi := &file_stringinspect_v1_analysis_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Character) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Character) ProtoMessage() {}
+
+func (x *Character) ProtoReflect() protoreflect.Message {
+	mi := &file_stringinspect_v1_analysis_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Character.ProtoReflect.Descriptor instead.
+func (*Character) Descriptor() ([]byte, []int) {
+	return file_stringinspect_v1_analysis_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Character) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Character) GetCodepoint() uint32 {
+	if x != nil {
+		return x.Codepoint
+	}
+	return 0
+}
+
+func (x *Character) GetChar() string {
+	if x != nil {
+		return x.Char
+	}
+	return ""
+}
+
+func (x *Character) GetHex() string {
+	if x != nil {
+		return x.Hex
+	}
+	return ""
+}
+
+func (x *Character) GetOctal() string {
+	if x != nil {
+		return x.Octal
+	}
+	return ""
+}
+
+func (x *Character) GetBinary() string {
+	if x != nil {
+		return x.Binary
+	}
+	return ""
+}
+
+func (x *Character) GetUnicode() string {
+	if x != nil {
+		return x.Unicode
+	}
+	return ""
+}
+
+func (x *Character) GetUtf8Bytes() []byte {
+	if x != nil {
+		return x.Utf8Bytes
+	}
+	return nil
+}
+
+func (x *Character) GetType() CharType {
+	if x != nil {
+		return x.Type
+	}
+	return CharType_CHAR_TYPE_UNSPECIFIED
+}
+
+func (x *Character) GetByteOffset() int64 {
+	if x != nil {
+		return x.ByteOffset
+	}
+	return 0
+}
+
+func (x *Character) GetRuneOffset() int64 {
+	if x != nil {
+		return x.RuneOffset
+	}
+	return 0
+}
+
+// LineEndings counts line terminators by style.
+type LineEndings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lf            int64                  `protobuf:"varint,1,opt,name=lf,proto3" json:"lf,omitempty"`
+	Crlf          int64                  `protobuf:"varint,2,opt,name=crlf,proto3" json:"crlf,omitempty"`
+	Cr            int64                  `protobuf:"varint,3,opt,name=cr,proto3" json:"cr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LineEndings) Reset() {
+	*x = LineEndings{}
+	mi := &file_stringinspect_v1_analysis_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LineEndings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LineEndings) ProtoMessage() {}
+
+func (x *LineEndings) ProtoReflect() protoreflect.Message {
+	mi := &file_stringinspect_v1_analysis_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LineEndings.ProtoReflect.Descriptor instead.
+func (*LineEndings) Descriptor() ([]byte, []int) {
+	return file_stringinspect_v1_analysis_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LineEndings) GetLf() int64 {
+	if x != nil {
+		return x.Lf
+	}
+	return 0
+}
+
+func (x *LineEndings) GetCrlf() int64 {
+	if x != nil {
+		return x.Crlf
+	}
+	return 0
+}
+
+func (x *LineEndings) GetCr() int64 {
+	if x != nil {
+		return x.Cr
+	}
+	return 0
+}
+
+// Summary holds aggregate statistics for an analysis.
+type Summary struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Characters int64                  `protobuf:"varint,1,opt,name=characters,proto3" json:"characters,omitempty"`
+	Bytes      int64                  `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	// Keyed by type name ("printable", "control", ...).
+	Types map[string]int64 `protobuf:"bytes,3,rep,name=types,proto3" json:"types,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Keyed by Unicode script name ("Latin", "Cyrillic", ...).
+	Scripts map[string]int64 `protobuf:"bytes,4,rep,name=scripts,proto3" json:"scripts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Keyed by UTF-8 sequence length (1-4).
+	ByteLengths map[int32]int64 `protobuf:"bytes,5,rep,name=byte_lengths,json=byteLengths,proto3" json:"byte_lengths,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	LineEndings *LineEndings    `protobuf:"bytes,6,opt,name=line_endings,json=lineEndings,proto3" json:"line_endings,omitempty"`
+	// Keyed by warning name ("invisible", "bidi-control", ...).
+	Warnings      map[string]int64 `protobuf:"bytes,7,rep,name=warnings,proto3" json:"warnings,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Summary) Reset() {
+	*x = Summary{}
+	mi := &file_stringinspect_v1_analysis_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Summary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Summary) ProtoMessage() {}
+
+func (x *Summary) ProtoReflect() protoreflect.Message {
+	mi := &file_stringinspect_v1_analysis_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Summary.ProtoReflect.Descriptor instead.
+func (*Summary) Descriptor() ([]byte, []int) {
+	return file_stringinspect_v1_analysis_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Summary) GetCharacters() int64 {
+	if x != nil {
+		return x.Characters
+	}
+	return 0
+}
+
+func (x *Summary) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *Summary) GetTypes() map[string]int64 {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *Summary) GetScripts() map[string]int64 {
+	if x != nil {
+		return x.Scripts
+	}
+	return nil
+}
+
+func (x *Summary) GetByteLengths() map[int32]int64 {
+	if x != nil {
+		return x.ByteLengths
+	}
+	return nil
+}
+
+func (x *Summary) GetLineEndings() *LineEndings {
+	if x != nil {
+		return x.LineEndings
+	}
+	return nil
+}
+
+func (x *Summary) GetWarnings() map[string]int64 {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+// Analysis is the complete result of analyzing a string.
+type Analysis struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The analyzed string itself (not the display placeholders).
+	Original string `protobuf:"bytes,1,opt,name=original,proto3" json:"original,omitempty"`
+	Count    int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// RFC 3339 timestamp of the export.
+	ExportedAt    string       `protobuf:"bytes,3,opt,name=exported_at,json=exportedAt,proto3" json:"exported_at,omitempty"`
+	Summary       *Summary     `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`
+	Characters    []*Character `protobuf:"bytes,5,rep,name=characters,proto3" json:"characters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Analysis) Reset() {
+	*x = Analysis{}
+	mi := &file_stringinspect_v1_analysis_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Analysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Analysis) ProtoMessage() {}
+
+func (x *Analysis) ProtoReflect() protoreflect.Message {
+	mi := &file_stringinspect_v1_analysis_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Analysis.ProtoReflect.Descriptor instead.
+func (*Analysis) Descriptor() ([]byte, []int) {
+	return file_stringinspect_v1_analysis_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Analysis) GetOriginal() string {
+	if x != nil {
+		return x.Original
+	}
+	return ""
+}
+
+func (x *Analysis) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Analysis) GetExportedAt() string {
+	if x != nil {
+		return x.ExportedAt
+	}
+	return ""
+}
+
+func (x *Analysis) GetSummary() *Summary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *Analysis) GetCharacters() []*Character {
+	if x != nil {
+		return x.Characters
+	}
+	return nil
+}
+
+var File_stringinspect_v1_analysis_proto protoreflect.FileDescriptor
+
+const file_stringinspect_v1_analysis_proto_rawDesc = "" +
+	"\n" +
+	"\x1fstringinspect/v1/analysis.proto\x12\x10stringinspect.v1\"\xc4\x02\n" +
+	"\tCharacter\x12\x1a\n" +
+	"\bposition\x18\x01 \x01(\x03R\bposition\x12\x1c\n" +
+	"\tcodepoint\x18\x02 \x01(\rR\tcodepoint\x12\x12\n" +
+	"\x04char\x18\x03 \x01(\tR\x04char\x12\x10\n" +
+	"\x03hex\x18\x04 \x01(\tR\x03hex\x12\x14\n" +
+	"\x05octal\x18\x05 \x01(\tR\x05octal\x12\x16\n" +
+	"\x06binary\x18\x06 \x01(\tR\x06binary\x12\x18\n" +
+	"\aunicode\x18\a \x01(\tR\aunicode\x12\x1d\n" +
+	"\n" +
+	"utf8_bytes\x18\b \x01(\fR\tutf8Bytes\x12.\n" +
+	"\x04type\x18\t \x01(\x0e2\x1a.stringinspect.v1.CharTypeR\x04type\x12\x1f\n" +
+	"\vbyte_offset\x18\n" +
+	" \x01(\x03R\n" +
+	"byteOffset\x12\x1f\n" +
+	"\vrune_offset\x18\v \x01(\x03R\n" +
+	"runeOffset\"A\n" +
+	"\vLineEndings\x12\x0e\n" +
+	"\x02lf\x18\x01 \x01(\x03R\x02lf\x12\x12\n" +
+	"\x04crlf\x18\x02 \x01(\x03R\x04crlf\x12\x0e\n" +
+	"\x02cr\x18\x03 \x01(\x03R\x02cr\"\x86\x05\n" +
+	"\aSummary\x12\x1e\n" +
+	"\n" +
+	"characters\x18\x01 \x01(\x03R\n" +
+	"characters\x12\x14\n" +
+	"\x05bytes\x18\x02 \x01(\x03R\x05bytes\x12:\n" +
+	"\x05types\x18\x03 \x03(\v2$.stringinspect.v1.Summary.TypesEntryR\x05types\x12@\n" +
+	"\ascripts\x18\x04 \x03(\v2&.stringinspect.v1.Summary.ScriptsEntryR\ascripts\x12M\n" +
+	"\fbyte_lengths\x18\x05 \x03(\v2*.stringinspect.v1.Summary.ByteLengthsEntryR\vbyteLengths\x12@\n" +
+	"\fline_endings\x18\x06 \x01(\v2\x1d.stringinspect.v1.LineEndingsR\vlineEndings\x12C\n" +
+	"\bwarnings\x18\a \x03(\v2'.stringinspect.v1.Summary.WarningsEntryR\bwarnings\x1a8\n" +
+	"\n" +
+	"TypesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1a:\n" +
+	"\fScriptsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1a>\n" +
+	"\x10ByteLengthsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1a;\n" +
+	"\rWarningsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\xcf\x01\n" +
+	"\bAnalysis\x12\x1a\n" +
+	"\boriginal\x18\x01 \x01(\tR\boriginal\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x12\x1f\n" +
+	"\vexported_at\x18\x03 \x01(\tR\n" +
+	"exportedAt\x123\n" +
+	"\asummary\x18\x04 \x01(\v2\x19.stringinspect.v1.SummaryR\asummary\x12;\n" +
+	"\n" +
+	"characters\x18\x05 \x03(\v2\x1b.stringinspect.v1.CharacterR\n" +
+	"characters*\x87\x01\n" +
+	"\bCharType\x12\x19\n" +
+	"\x15CHAR_TYPE_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13CHAR_TYPE_PRINTABLE\x10\x01\x12\x18\n" +
+	"\x14CHAR_TYPE_WHITESPACE\x10\x02\x12\x15\n" +
+	"\x11CHAR_TYPE_CONTROL\x10\x03\x12\x16\n" +
+	"\x12CHAR_TYPE_EXTENDED\x10\x04B\x1bZ\x19stringinspect/internal/pbb\x06proto3"
+
+var (
+	file_stringinspect_v1_analysis_proto_rawDescOnce sync.Once
+	file_stringinspect_v1_analysis_proto_rawDescData []byte
+)
+
+func file_stringinspect_v1_analysis_proto_rawDescGZIP() []byte {
+	file_stringinspect_v1_analysis_proto_rawDescOnce.Do(func() {
+		file_stringinspect_v1_analysis_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_stringinspect_v1_analysis_proto_rawDesc), len(file_stringinspect_v1_analysis_proto_rawDesc)))
+	})
+	return file_stringinspect_v1_analysis_proto_rawDescData
+}
+
+var file_stringinspect_v1_analysis_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_stringinspect_v1_analysis_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_stringinspect_v1_analysis_proto_goTypes = []any{
+	(CharType)(0),       // 0: stringinspect.v1.CharType
+	(*Character)(nil),   // 1: stringinspect.v1.Character
+	(*LineEndings)(nil), // 2: stringinspect.v1.LineEndings
+	(*Summary)(nil),     // 3: stringinspect.v1.Summary
+	(*Analysis)(nil),    // 4: stringinspect.v1.Analysis
+	nil,                 // 5: stringinspect.v1.Summary.TypesEntry
+	nil,                 // 6: stringinspect.v1.Summary.ScriptsEntry
+	nil,                 // 7: stringinspect.v1.Summary.ByteLengthsEntry
+	nil,                 // 8: stringinspect.v1.Summary.WarningsEntry
+}
+var file_stringinspect_v1_analysis_proto_depIdxs = []int32{
+	0, // 0: stringinspect.v1.Character.type:type_name -> stringinspect.v1.CharType
+	5, // 1: stringinspect.v1.Summary.types:type_name -> stringinspect.v1.Summary.TypesEntry
+	6, // 2: stringinspect.v1.Summary.scripts:type_name -> stringinspect.v1.Summary.ScriptsEntry
+	7, // 3: stringinspect.v1.Summary.byte_lengths:type_name -> stringinspect.v1.Summary.ByteLengthsEntry
+	2, // 4: stringinspect.v1.Summary.line_endings:type_name -> stringinspect.v1.LineEndings
+	8, // 5: stringinspect.v1.Summary.warnings:type_name -> stringinspect.v1.Summary.WarningsEntry
+	3, // 6: stringinspect.v1.Analysis.summary:type_name -> stringinspect.v1.Summary
+	1, // 7: stringinspect.v1.Analysis.characters:type_name -> stringinspect.v1.Character
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_stringinspect_v1_analysis_proto_init() }
+func file_stringinspect_v1_analysis_proto_init() {
+	if File_stringinspect_v1_analysis_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_stringinspect_v1_analysis_proto_rawDesc), len(file_stringinspect_v1_analysis_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_stringinspect_v1_analysis_proto_goTypes,
+		DependencyIndexes: file_stringinspect_v1_analysis_proto_depIdxs,
+		EnumInfos:         file_stringinspect_v1_analysis_proto_enumTypes,
+		MessageInfos:      file_stringinspect_v1_analysis_proto_msgTypes,
+	}.Build()
+	File_stringinspect_v1_analysis_proto = out.File
+	file_stringinspect_v1_analysis_proto_goTypes = nil
+	file_stringinspect_v1_analysis_proto_depIdxs = nil
+}
@@ -0,0 +1,6 @@
+// Package pb contains the protocol buffer types for StringInspect analysis
+// results and the gRPC analysis service, generated from the files in
+// proto/stringinspect/v1.
+package pb
+
+//go:generate protoc -I ../../proto --go_out=../.. --go_opt=module=stringinspect --go-grpc_out=../.. --go-grpc_opt=module=stringinspect stringinspect/v1/analysis.proto stringinspect/v1/service.proto
@@ -0,0 +1,849 @@
+// gRPC service for analyzing text with StringInspect from other programs.
+//
+// Regenerate the Go types with `make proto` after editing this file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: stringinspect/v1/service.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// NormalizationForm is a Unicode normalization form.
+type NormalizationForm int32
+
+const (
+	NormalizationForm_NORMALIZATION_FORM_UNSPECIFIED NormalizationForm = 0
+	NormalizationForm_NORMALIZATION_FORM_NFC         NormalizationForm = 1
+	NormalizationForm_NORMALIZATION_FORM_NFD         NormalizationForm = 2
+	NormalizationForm_NORMALIZATION_FORM_NFKC        NormalizationForm = 3
+	NormalizationForm_NORMALIZATION_FORM_NFKD        NormalizationForm = 4
+)
+
+// Enum value maps for NormalizationForm.
+var (
+	NormalizationForm_name = map[int32]string{
+		0: "NORMALIZATION_FORM_UNSPECIFIED",
+		1: "NORMALIZATION_FORM_NFC",
+		2: "NORMALIZATION_FORM_NFD",
+		3: "NORMALIZATION_FORM_NFKC",
+		4: "NORMALIZATION_FORM_NFKD",
+	}
+	NormalizationForm_value = map[string]int32{
+		"NORMALIZATION_FORM_UNSPECIFIED": 0,
+		"NORMALIZATION_FORM_NFC":         1,
+		"NORMALIZATION_FORM_NFD":         2,
+		"NORMALIZATION_FORM_NFKC":        3,
+		"NORMALIZATION_FORM_NFKD":        4,
+	}
+)
+
+func (x NormalizationForm) Enum() *NormalizationForm {
+	p := new(NormalizationForm)
+	*p = x
+	return p
+}
+
+func (x NormalizationForm) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NormalizationForm) Descriptor() protoreflect.EnumDescriptor {
+	return file_stringinspect_v1_service_proto_enumTypes[0].Descriptor()
+}
+
+func (NormalizationForm) Type() protoreflect.EnumType {
+	return &file_stringinspect_v1_service_proto_enumTypes[0]
+}
+
+func (x NormalizationForm) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NormalizationForm.Descriptor instead.
+func (NormalizationForm) EnumDescriptor() ([]byte, []int) {
+	return file_stringinspect_v1_service_proto_rawDescGZIP(), []int{0}
+}
+
+type AnalyzeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Input:
+	//
+	//	*AnalyzeRequest_Text
+	//	*AnalyzeRequest_Data
+	Input         isAnalyzeRequest_Input `protobuf_oneof:"input"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnalyzeRequest) Reset() {
+	*x = AnalyzeRequest{}
+	mi := &file_stringinspect_v1_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyzeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeRequest) ProtoMessage() {}
+
+func (x *AnalyzeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stringinspect_v1_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeRequest.ProtoReflect.Descriptor instead.
+func (*AnalyzeRequest) Descriptor() ([]byte, []int) {
+	return file_stringinspect_v1_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AnalyzeRequest) GetInput() isAnalyzeRequest_Input {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *AnalyzeRequest) GetText() string {
+	if x != nil {
+		if x, ok := x.Input.(*AnalyzeRequest_Text); ok {
+			return x.Text
+		}
+	}
+	return ""
+}
+
+func (x *AnalyzeRequest) GetData() []byte {
+	if x != nil {
+		if x, ok := x.Input.(*AnalyzeRequest_Data); ok {
+			return x.Data
+		}
+	}
+	return nil
+}
+
+type isAnalyzeRequest_Input interface {
+	isAnalyzeRequest_Input()
+}
+
+type AnalyzeRequest_Text struct {
+	// Text to analyze.
+	Text string `protobuf:"bytes,1,opt,name=text,proto3,oneof"`
+}
+
+type AnalyzeRequest_Data struct {
+	// Bytes to analyze as a file would be: decoded as UTF-8, UTF-16, or
+	// UTF-32, or analyzed byte by byte when they are binary.
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3,oneof"`
+}
+
+func (*AnalyzeRequest_Text) isAnalyzeRequest_Input() {}
+
+func (*AnalyzeRequest_Data) isAnalyzeRequest_Input() {}
+
+// AnalyzeChunk is part of the bytes of a streamed text, read as a file
+// would be.
+type AnalyzeChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnalyzeChunk) Reset() {
+	*x = AnalyzeChunk{}
+	mi := &file_stringinspect_v1_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyzeChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeChunk) ProtoMessage() {}
+
+func (x *AnalyzeChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_stringinspect_v1_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeChunk.ProtoReflect.Descriptor instead.
+func (*AnalyzeChunk) Descriptor() ([]byte, []int) {
+	return file_stringinspect_v1_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AnalyzeChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type LookupRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A codepoint (U+00A0 or 0xA0), a single character, or words of a
+	// character name ("no-break space").
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Maximum number of name matches; 0 means 20.
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupRequest) Reset() {
+	*x = LookupRequest{}
+	mi := &file_stringinspect_v1_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupRequest) ProtoMessage() {}
+
+func (x *LookupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stringinspect_v1_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupRequest.ProtoReflect.Descriptor instead.
+func (*LookupRequest) Descriptor() ([]byte, []int) {
+	return file_stringinspect_v1_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LookupRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *LookupRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// CharacterInfo describes a character beyond its encodings.
+type CharacterInfo struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Character *Character             `protobuf:"bytes,1,opt,name=character,proto3" json:"character,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// General category, e.g. "Zs".
+	Category string `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Script   string `protobuf:"bytes,4,opt,name=script,proto3" json:"script,omitempty"`
+	Block    string `protobuf:"bytes,5,opt,name=block,proto3" json:"block,omitempty"`
+	// Plain-English explanation of what the character is and its pitfalls.
+	Explanation string `protobuf:"bytes,6,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	// Warning names ("invisible", "bidi-control", ...).
+	Warnings      []string `protobuf:"bytes,7,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CharacterInfo) Reset() {
+	*x = CharacterInfo{}
+	mi := &file_stringinspect_v1_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CharacterInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CharacterInfo) ProtoMessage() {}
+
+func (x *CharacterInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_stringinspect_v1_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CharacterInfo.ProtoReflect.Descriptor instead.
+func (*CharacterInfo) Descriptor() ([]byte, []int) {
+	return file_stringinspect_v1_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CharacterInfo) GetCharacter() *Character {
+	if x != nil {
+		return x.Character
+	}
+	return nil
+}
+
+func (x *CharacterInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CharacterInfo) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CharacterInfo) GetScript() string {
+	if x != nil {
+		return x.Script
+	}
+	return ""
+}
+
+func (x *CharacterInfo) GetBlock() string {
+	if x != nil {
+		return x.Block
+	}
+	return ""
+}
+
+func (x *CharacterInfo) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *CharacterInfo) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type LookupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Characters    []*CharacterInfo       `protobuf:"bytes,1,rep,name=characters,proto3" json:"characters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupResponse) Reset() {
+	*x = LookupResponse{}
+	mi := &file_stringinspect_v1_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupResponse) ProtoMessage() {}
+
+func (x *LookupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stringinspect_v1_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupResponse.ProtoReflect.Descriptor instead.
+func (*LookupResponse) Descriptor() ([]byte, []int) {
+	return file_stringinspect_v1_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LookupResponse) GetCharacters() []*CharacterInfo {
+	if x != nil {
+		return x.Characters
+	}
+	return nil
+}
+
+type CheckRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Text  string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// ascii, latin1, cp1252, or gsm.
+	Charset       string `protobuf:"bytes,2,opt,name=charset,proto3" json:"charset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckRequest) Reset() {
+	*x = CheckRequest{}
+	mi := &file_stringinspect_v1_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckRequest) ProtoMessage() {}
+
+func (x *CheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stringinspect_v1_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckRequest.ProtoReflect.Descriptor instead.
+func (*CheckRequest) Descriptor() ([]byte, []int) {
+	return file_stringinspect_v1_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CheckRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *CheckRequest) GetCharset() string {
+	if x != nil {
+		return x.Charset
+	}
+	return ""
+}
+
+// Incompatibility is a character a charset cannot represent.
+type Incompatibility struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Position  int64                  `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
+	Codepoint uint32                 `protobuf:"varint,2,opt,name=codepoint,proto3" json:"codepoint,omitempty"`
+	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Stand-in the charset can represent, if there is one.
+	Suggestion    string `protobuf:"bytes,4,opt,name=suggestion,proto3" json:"suggestion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Incompatibility) Reset() {
+	*x = Incompatibility{}
+	mi := &file_stringinspect_v1_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Incompatibility) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Incompatibility) ProtoMessage() {}
+
+func (x *Incompatibility) ProtoReflect() protoreflect.Message {
+	mi := &file_stringinspect_v1_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Incompatibility.ProtoReflect.Descriptor instead.
+func (*Incompatibility) Descriptor() ([]byte, []int) {
+	return file_stringinspect_v1_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Incompatibility) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Incompatibility) GetCodepoint() uint32 {
+	if x != nil {
+		return x.Codepoint
+	}
+	return 0
+}
+
+func (x *Incompatibility) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Incompatibility) GetSuggestion() string {
+	if x != nil {
+		return x.Suggestion
+	}
+	return ""
+}
+
+type CheckResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the charset checked.
+	Charset string `protobuf:"bytes,1,opt,name=charset,proto3" json:"charset,omitempty"`
+	// True when every character survives.
+	Ok           bool               `protobuf:"varint,2,opt,name=ok,proto3" json:"ok,omitempty"`
+	Incompatible []*Incompatibility `protobuf:"bytes,3,rep,name=incompatible,proto3" json:"incompatible,omitempty"`
+	// SMS length summary, for the GSM charset only.
+	Sms           string `protobuf:"bytes,4,opt,name=sms,proto3" json:"sms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckResponse) Reset() {
+	*x = CheckResponse{}
+	mi := &file_stringinspect_v1_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckResponse) ProtoMessage() {}
+
+func (x *CheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stringinspect_v1_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckResponse.ProtoReflect.Descriptor instead.
+func (*CheckResponse) Descriptor() ([]byte, []int) {
+	return file_stringinspect_v1_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CheckResponse) GetCharset() string {
+	if x != nil {
+		return x.Charset
+	}
+	return ""
+}
+
+func (x *CheckResponse) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *CheckResponse) GetIncompatible() []*Incompatibility {
+	if x != nil {
+		return x.Incompatible
+	}
+	return nil
+}
+
+func (x *CheckResponse) GetSms() string {
+	if x != nil {
+		return x.Sms
+	}
+	return ""
+}
+
+type NormalizeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Text  string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// Defaults to NFC.
+	Form          NormalizationForm `protobuf:"varint,2,opt,name=form,proto3,enum=stringinspect.v1.NormalizationForm" json:"form,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NormalizeRequest) Reset() {
+	*x = NormalizeRequest{}
+	mi := &file_stringinspect_v1_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NormalizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NormalizeRequest) ProtoMessage() {}
+
+func (x *NormalizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stringinspect_v1_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NormalizeRequest.ProtoReflect.Descriptor instead.
+func (*NormalizeRequest) Descriptor() ([]byte, []int) {
+	return file_stringinspect_v1_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *NormalizeRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *NormalizeRequest) GetForm() NormalizationForm {
+	if x != nil {
+		return x.Form
+	}
+	return NormalizationForm_NORMALIZATION_FORM_UNSPECIFIED
+}
+
+type NormalizeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Text  string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// True when normalizing changed the text.
+	Changed       bool `protobuf:"varint,2,opt,name=changed,proto3" json:"changed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NormalizeResponse) Reset() {
+	*x = NormalizeResponse{}
+	mi := &file_stringinspect_v1_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NormalizeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NormalizeResponse) ProtoMessage() {}
+
+func (x *NormalizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stringinspect_v1_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NormalizeResponse.ProtoReflect.Descriptor instead.
+func (*NormalizeResponse) Descriptor() ([]byte, []int) {
+	return file_stringinspect_v1_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *NormalizeResponse) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *NormalizeResponse) GetChanged() bool {
+	if x != nil {
+		return x.Changed
+	}
+	return false
+}
+
+var File_stringinspect_v1_service_proto protoreflect.FileDescriptor
+
+const file_stringinspect_v1_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1estringinspect/v1/service.proto\x12\x10stringinspect.v1\x1a\x1fstringinspect/v1/analysis.proto\"E\n" +
+	"\x0eAnalyzeRequest\x12\x14\n" +
+	"\x04text\x18\x01 \x01(\tH\x00R\x04text\x12\x14\n" +
+	"\x04data\x18\x02 \x01(\fH\x00R\x04dataB\a\n" +
+	"\x05input\"\"\n" +
+	"\fAnalyzeChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\";\n" +
+	"\rLookupRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\xe6\x01\n" +
+	"\rCharacterInfo\x129\n" +
+	"\tcharacter\x18\x01 \x01(\v2\x1b.stringinspect.v1.CharacterR\tcharacter\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12\x16\n" +
+	"\x06script\x18\x04 \x01(\tR\x06script\x12\x14\n" +
+	"\x05block\x18\x05 \x01(\tR\x05block\x12 \n" +
+	"\vexplanation\x18\x06 \x01(\tR\vexplanation\x12\x1a\n" +
+	"\bwarnings\x18\a \x03(\tR\bwarnings\"Q\n" +
+	"\x0eLookupResponse\x12?\n" +
+	"\n" +
+	"characters\x18\x01 \x03(\v2\x1f.stringinspect.v1.CharacterInfoR\n" +
+	"characters\"<\n" +
+	"\fCheckRequest\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x18\n" +
+	"\acharset\x18\x02 \x01(\tR\acharset\"\x7f\n" +
+	"\x0fIncompatibility\x12\x1a\n" +
+	"\bposition\x18\x01 \x01(\x03R\bposition\x12\x1c\n" +
+	"\tcodepoint\x18\x02 \x01(\rR\tcodepoint\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"suggestion\x18\x04 \x01(\tR\n" +
+	"suggestion\"\x92\x01\n" +
+	"\rCheckResponse\x12\x18\n" +
+	"\acharset\x18\x01 \x01(\tR\acharset\x12\x0e\n" +
+	"\x02ok\x18\x02 \x01(\bR\x02ok\x12E\n" +
+	"\fincompatible\x18\x03 \x03(\v2!.stringinspect.v1.IncompatibilityR\fincompatible\x12\x10\n" +
+	"\x03sms\x18\x04 \x01(\tR\x03sms\"_\n" +
+	"\x10NormalizeRequest\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x127\n" +
+	"\x04form\x18\x02 \x01(\x0e2#.stringinspect.v1.NormalizationFormR\x04form\"A\n" +
+	"\x11NormalizeResponse\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x18\n" +
+	"\achanged\x18\x02 \x01(\bR\achanged*\xa9\x01\n" +
+	"\x11NormalizationForm\x12\"\n" +
+	"\x1eNORMALIZATION_FORM_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16NORMALIZATION_FORM_NFC\x10\x01\x12\x1a\n" +
+	"\x16NORMALIZATION_FORM_NFD\x10\x02\x12\x1b\n" +
+	"\x17NORMALIZATION_FORM_NFKC\x10\x03\x12\x1b\n" +
+	"\x17NORMALIZATION_FORM_NFKD\x10\x042\x98\x03\n" +
+	"\x0fAnalysisService\x12G\n" +
+	"\aAnalyze\x12 .stringinspect.v1.AnalyzeRequest\x1a\x1a.stringinspect.v1.Analysis\x12O\n" +
+	"\rAnalyzeStream\x12\x1e.stringinspect.v1.AnalyzeChunk\x1a\x1a.stringinspect.v1.Analysis(\x010\x01\x12K\n" +
+	"\x06Lookup\x12\x1f.stringinspect.v1.LookupRequest\x1a .stringinspect.v1.LookupResponse\x12H\n" +
+	"\x05Check\x12\x1e.stringinspect.v1.CheckRequest\x1a\x1f.stringinspect.v1.CheckResponse\x12T\n" +
+	"\tNormalize\x12\".stringinspect.v1.NormalizeRequest\x1a#.stringinspect.v1.NormalizeResponseB\x1bZ\x19stringinspect/internal/pbb\x06proto3"
+
+var (
+	file_stringinspect_v1_service_proto_rawDescOnce sync.Once
+	file_stringinspect_v1_service_proto_rawDescData []byte
+)
+
+func file_stringinspect_v1_service_proto_rawDescGZIP() []byte {
+	file_stringinspect_v1_service_proto_rawDescOnce.Do(func() {
+		file_stringinspect_v1_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_stringinspect_v1_service_proto_rawDesc), len(file_stringinspect_v1_service_proto_rawDesc)))
+	})
+	return file_stringinspect_v1_service_proto_rawDescData
+}
+
+var file_stringinspect_v1_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_stringinspect_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_stringinspect_v1_service_proto_goTypes = []any{
+	(NormalizationForm)(0),    // 0: stringinspect.v1.NormalizationForm
+	(*AnalyzeRequest)(nil),    // 1: stringinspect.v1.AnalyzeRequest
+	(*AnalyzeChunk)(nil),      // 2: stringinspect.v1.AnalyzeChunk
+	(*LookupRequest)(nil),     // 3: stringinspect.v1.LookupRequest
+	(*CharacterInfo)(nil),     // 4: stringinspect.v1.CharacterInfo
+	(*LookupResponse)(nil),    // 5: stringinspect.v1.LookupResponse
+	(*CheckRequest)(nil),      // 6: stringinspect.v1.CheckRequest
+	(*Incompatibility)(nil),   // 7: stringinspect.v1.Incompatibility
+	(*CheckResponse)(nil),     // 8: stringinspect.v1.CheckResponse
+	(*NormalizeRequest)(nil),  // 9: stringinspect.v1.NormalizeRequest
+	(*NormalizeResponse)(nil), // 10: stringinspect.v1.NormalizeResponse
+	(*Character)(nil),         // 11: stringinspect.v1.Character
+	(*Analysis)(nil),          // 12: stringinspect.v1.Analysis
+}
+var file_stringinspect_v1_service_proto_depIdxs = []int32{
+	11, // 0: stringinspect.v1.CharacterInfo.character:type_name -> stringinspect.v1.Character
+	4,  // 1: stringinspect.v1.LookupResponse.characters:type_name -> stringinspect.v1.CharacterInfo
+	7,  // 2: stringinspect.v1.CheckResponse.incompatible:type_name -> stringinspect.v1.Incompatibility
+	0,  // 3: stringinspect.v1.NormalizeRequest.form:type_name -> stringinspect.v1.NormalizationForm
+	1,  // 4: stringinspect.v1.AnalysisService.Analyze:input_type -> stringinspect.v1.AnalyzeRequest
+	2,  // 5: stringinspect.v1.AnalysisService.AnalyzeStream:input_type -> stringinspect.v1.AnalyzeChunk
+	3,  // 6: stringinspect.v1.AnalysisService.Lookup:input_type -> stringinspect.v1.LookupRequest
+	6,  // 7: stringinspect.v1.AnalysisService.Check:input_type -> stringinspect.v1.CheckRequest
+	9,  // 8: stringinspect.v1.AnalysisService.Normalize:input_type -> stringinspect.v1.NormalizeRequest
+	12, // 9: stringinspect.v1.AnalysisService.Analyze:output_type -> stringinspect.v1.Analysis
+	12, // 10: stringinspect.v1.AnalysisService.AnalyzeStream:output_type -> stringinspect.v1.Analysis
+	5,  // 11: stringinspect.v1.AnalysisService.Lookup:output_type -> stringinspect.v1.LookupResponse
+	8,  // 12: stringinspect.v1.AnalysisService.Check:output_type -> stringinspect.v1.CheckResponse
+	10, // 13: stringinspect.v1.AnalysisService.Normalize:output_type -> stringinspect.v1.NormalizeResponse
+	9,  // [9:14] is the sub-list for method output_type
+	4,  // [4:9] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_stringinspect_v1_service_proto_init() }
+func file_stringinspect_v1_service_proto_init() {
+	if File_stringinspect_v1_service_proto != nil {
+		return
+	}
+	file_stringinspect_v1_analysis_proto_init()
+	file_stringinspect_v1_service_proto_msgTypes[0].OneofWrappers = []any{
+		(*AnalyzeRequest_Text)(nil),
+		(*AnalyzeRequest_Data)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_stringinspect_v1_service_proto_rawDesc), len(file_stringinspect_v1_service_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_stringinspect_v1_service_proto_goTypes,
+		DependencyIndexes: file_stringinspect_v1_service_proto_depIdxs,
+		EnumInfos:         file_stringinspect_v1_service_proto_enumTypes,
+		MessageInfos:      file_stringinspect_v1_service_proto_msgTypes,
+	}.Build()
+	File_stringinspect_v1_service_proto = out.File
+	file_stringinspect_v1_service_proto_goTypes = nil
+	file_stringinspect_v1_service_proto_depIdxs = nil
+}
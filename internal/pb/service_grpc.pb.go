@@ -0,0 +1,296 @@
+// gRPC service for analyzing text with StringInspect from other programs.
+//
+// Regenerate the Go types with `make proto` after editing this file.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: stringinspect/v1/service.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AnalysisService_Analyze_FullMethodName       = "/stringinspect.v1.AnalysisService/Analyze"
+	AnalysisService_AnalyzeStream_FullMethodName = "/stringinspect.v1.AnalysisService/AnalyzeStream"
+	AnalysisService_Lookup_FullMethodName        = "/stringinspect.v1.AnalysisService/Lookup"
+	AnalysisService_Check_FullMethodName         = "/stringinspect.v1.AnalysisService/Check"
+	AnalysisService_Normalize_FullMethodName     = "/stringinspect.v1.AnalysisService/Normalize"
+)
+
+// AnalysisServiceClient is the client API for AnalysisService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AnalysisService analyzes text as the StringInspect TUI does.
+type AnalysisServiceClient interface {
+	// Analyze returns the characters of a text and its summary.
+	Analyze(ctx context.Context, in *AnalyzeRequest, opts ...grpc.CallOption) (*Analysis, error)
+	// AnalyzeStream analyzes a text too large for one message. The client
+	// sends it in chunks of any size, split anywhere, and closes its side.
+	// The server replies as it reads, with Analysis messages holding the
+	// characters in batches, then one Analysis holding the count and summary
+	// but not the original text. The encoding is detected from the first
+	// 8 KiB.
+	AnalyzeStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[AnalyzeChunk, Analysis], error)
+	// Lookup finds characters by codepoint, literal character, or name.
+	Lookup(ctx context.Context, in *LookupRequest, opts ...grpc.CallOption) (*LookupResponse, error)
+	// Check reports the characters of a text a charset cannot represent.
+	Check(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*CheckResponse, error)
+	// Normalize returns a text in a Unicode normalization form.
+	Normalize(ctx context.Context, in *NormalizeRequest, opts ...grpc.CallOption) (*NormalizeResponse, error)
+}
+
+type analysisServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAnalysisServiceClient(cc grpc.ClientConnInterface) AnalysisServiceClient {
+	return &analysisServiceClient{cc}
+}
+
+func (c *analysisServiceClient) Analyze(ctx context.Context, in *AnalyzeRequest, opts ...grpc.CallOption) (*Analysis, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Analysis)
+	err := c.cc.Invoke(ctx, AnalysisService_Analyze_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analysisServiceClient) AnalyzeStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[AnalyzeChunk, Analysis], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AnalysisService_ServiceDesc.Streams[0], AnalysisService_AnalyzeStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[AnalyzeChunk, Analysis]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AnalysisService_AnalyzeStreamClient = grpc.BidiStreamingClient[AnalyzeChunk, Analysis]
+
+func (c *analysisServiceClient) Lookup(ctx context.Context, in *LookupRequest, opts ...grpc.CallOption) (*LookupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LookupResponse)
+	err := c.cc.Invoke(ctx, AnalysisService_Lookup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analysisServiceClient) Check(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*CheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckResponse)
+	err := c.cc.Invoke(ctx, AnalysisService_Check_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analysisServiceClient) Normalize(ctx context.Context, in *NormalizeRequest, opts ...grpc.CallOption) (*NormalizeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NormalizeResponse)
+	err := c.cc.Invoke(ctx, AnalysisService_Normalize_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AnalysisServiceServer is the server API for AnalysisService service.
+// All implementations must embed UnimplementedAnalysisServiceServer
+// for forward compatibility.
+//
+// AnalysisService analyzes text as the StringInspect TUI does.
+type AnalysisServiceServer interface {
+	// Analyze returns the characters of a text and its summary.
+	Analyze(context.Context, *AnalyzeRequest) (*Analysis, error)
+	// AnalyzeStream analyzes a text too large for one message. The client
+	// sends it in chunks of any size, split anywhere, and closes its side.
+	// The server replies as it reads, with Analysis messages holding the
+	// characters in batches, then one Analysis holding the count and summary
+	// but not the original text. The encoding is detected from the first
+	// 8 KiB.
+	AnalyzeStream(grpc.BidiStreamingServer[AnalyzeChunk, Analysis]) error
+	// Lookup finds characters by codepoint, literal character, or name.
+	Lookup(context.Context, *LookupRequest) (*LookupResponse, error)
+	// Check reports the characters of a text a charset cannot represent.
+	Check(context.Context, *CheckRequest) (*CheckResponse, error)
+	// Normalize returns a text in a Unicode normalization form.
+	Normalize(context.Context, *NormalizeRequest) (*NormalizeResponse, error)
+	mustEmbedUnimplementedAnalysisServiceServer()
+}
+
+// UnimplementedAnalysisServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAnalysisServiceServer struct{}
+
+func (UnimplementedAnalysisServiceServer) Analyze(context.Context, *AnalyzeRequest) (*Analysis, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Analyze not implemented")
+}
+func (UnimplementedAnalysisServiceServer) AnalyzeStream(grpc.BidiStreamingServer[AnalyzeChunk, Analysis]) error {
+	return status.Errorf(codes.Unimplemented, "method AnalyzeStream not implemented")
+}
+func (UnimplementedAnalysisServiceServer) Lookup(context.Context, *LookupRequest) (*LookupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lookup not implemented")
+}
+func (UnimplementedAnalysisServiceServer) Check(context.Context, *CheckRequest) (*CheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Check not implemented")
+}
+func (UnimplementedAnalysisServiceServer) Normalize(context.Context, *NormalizeRequest) (*NormalizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Normalize not implemented")
+}
+func (UnimplementedAnalysisServiceServer) mustEmbedUnimplementedAnalysisServiceServer() {}
+func (UnimplementedAnalysisServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeAnalysisServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AnalysisServiceServer will
+// result in compilation errors.
+type UnsafeAnalysisServiceServer interface {
+	mustEmbedUnimplementedAnalysisServiceServer()
+}
+
+func RegisterAnalysisServiceServer(s grpc.ServiceRegistrar, srv AnalysisServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAnalysisServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AnalysisService_ServiceDesc, srv)
+}
+
+func _AnalysisService_Analyze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).Analyze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalysisService_Analyze_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).Analyze(ctx, req.(*AnalyzeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalysisService_AnalyzeStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AnalysisServiceServer).AnalyzeStream(&grpc.GenericServerStream[AnalyzeChunk, Analysis]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AnalysisService_AnalyzeStreamServer = grpc.BidiStreamingServer[AnalyzeChunk, Analysis]
+
+func _AnalysisService_Lookup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).Lookup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalysisService_Lookup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).Lookup(ctx, req.(*LookupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalysisService_Check_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).Check(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalysisService_Check_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).Check(ctx, req.(*CheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalysisService_Normalize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NormalizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).Normalize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalysisService_Normalize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).Normalize(ctx, req.(*NormalizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AnalysisService_ServiceDesc is the grpc.ServiceDesc for AnalysisService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AnalysisService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "stringinspect.v1.AnalysisService",
+	HandlerType: (*AnalysisServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Analyze",
+			Handler:    _AnalysisService_Analyze_Handler,
+		},
+		{
+			MethodName: "Lookup",
+			Handler:    _AnalysisService_Lookup_Handler,
+		},
+		{
+			MethodName: "Check",
+			Handler:    _AnalysisService_Check_Handler,
+		},
+		{
+			MethodName: "Normalize",
+			Handler:    _AnalysisService_Normalize_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "AnalyzeStream",
+			Handler:       _AnalysisService_AnalyzeStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "stringinspect/v1/service.proto",
+}
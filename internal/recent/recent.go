@@ -0,0 +1,109 @@
+// Package recent keeps the list of recently opened files between
+// sessions.
+package recent
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"stringinspect/internal/config"
+)
+
+// Entry is one recently opened file.
+type Entry struct {
+	Path   string    `json:"path"` // Absolute path
+	Opened time.Time `json:"opened"`
+}
+
+// List holds recently opened files, most recent first.
+type List struct {
+	Files []Entry `json:"files"`
+	limit int
+}
+
+// New creates an empty List holding at most limit files.
+func New(limit int) *List {
+	if limit < 1 {
+		limit = 20
+	}
+	return &List{limit: limit}
+}
+
+// DefaultPath returns the list's location in the user's state directory
+// (see config.StateDir), e.g. ~/.local/state/stringinspect/recent.json.
+func DefaultPath() (string, error) {
+	dir, err := config.StateDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "recent.json"), nil
+}
+
+// Load reads the list at path. A missing file is not an error and yields
+// an empty List.
+func Load(path string, limit int) (*List, error) {
+	l := New(limit)
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return l, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, l); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	l.trim()
+	return l, nil
+}
+
+// Save writes the list to path, creating its directory if needed.
+func (l *List) Save(path string) error {
+	data, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// Add records path as opened at the given time, moving it to the front
+// if it is already listed. Relative paths are made absolute.
+func (l *List) Add(path string, opened time.Time) {
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	l.Remove(path)
+	l.Files = slices.Insert(l.Files, 0, Entry{Path: path, Opened: opened})
+	l.trim()
+}
+
+// Remove drops path from the list.
+func (l *List) Remove(path string) {
+	l.Files = slices.DeleteFunc(l.Files, func(e Entry) bool { return e.Path == path })
+}
+
+// Last returns the most recently opened file that still exists.
+func (l *List) Last() (string, bool) {
+	for _, e := range l.Files {
+		if info, err := os.Stat(e.Path); err == nil && !info.IsDir() {
+			return e.Path, true
+		}
+	}
+	return "", false
+}
+
+// trim drops the oldest files beyond the limit.
+func (l *List) trim() {
+	if len(l.Files) > l.limit {
+		l.Files = l.Files[:l.limit]
+	}
+}
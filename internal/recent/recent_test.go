@@ -0,0 +1,68 @@
+package recent
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestAddMovesToFront(t *testing.T) {
+	l := New(3)
+	now := time.Now()
+	for _, p := range []string{"/a", "/b", "/c", "/a", "/d"} {
+		l.Add(p, now)
+	}
+
+	want := []string{"/d", "/a", "/c"}
+	if len(l.Files) != len(want) {
+		t.Fatalf("Files = %v, want %v", l.Files, want)
+	}
+	for i, w := range want {
+		if l.Files[i].Path != w {
+			t.Errorf("Files[%d] = %q, want %q", i, l.Files[i].Path, w)
+		}
+	}
+}
+
+func TestSaveLoadRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "state", "recent.json")
+
+	l := New(10)
+	opened := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	l.Add("/x/one.txt", opened)
+	l.Add("/x/two.txt", opened.Add(time.Hour))
+	if err := l.Save(path); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	got, err := Load(path, 10)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(got.Files) != 2 || got.Files[0].Path != "/x/two.txt" || !got.Files[1].Opened.Equal(opened) {
+		t.Errorf("Load() = %+v, want %+v", got.Files, l.Files)
+	}
+
+	missing, err := Load(filepath.Join(dir, "missing.json"), 10)
+	if err != nil || len(missing.Files) != 0 {
+		t.Errorf("Load(missing) = %v, %v, want empty list", missing.Files, err)
+	}
+}
+
+func TestLastSkipsMissingFiles(t *testing.T) {
+	dir := t.TempDir()
+	present := filepath.Join(dir, "present.txt")
+	if err := os.WriteFile(present, []byte("x"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	l := New(10)
+	l.Add(present, time.Now())
+	l.Add(filepath.Join(dir, "deleted.txt"), time.Now())
+
+	if got, ok := l.Last(); !ok || got != present {
+		t.Errorf("Last() = %q, %v, want %q", got, ok, present)
+	}
+}
@@ -0,0 +1,202 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// maxClients bounds the rate limit buckets kept; past it, buckets of
+// clients that have been idle long enough to be full again are dropped,
+// and then the bucket of the client idle longest.
+const maxClients = 10000
+
+// Access controls who may call the server and how often.
+type Access struct {
+	// Tokens are the bearer tokens accepted in the authorization header;
+	// none means calls are not authenticated
+	Tokens []string
+
+	// Rate is the calls per second each client may make, a client being a
+	// token or, without tokens, an IP address; 0 means no limit. A stream
+	// counts as one call
+	Rate float64
+
+	// Burst is the calls a client may make at once after being idle;
+	// it defaults to Rate, and at least 1
+	Burst int
+}
+
+// LoadTokens reads bearer tokens from a file, one per line. Blank lines and
+// lines starting with # are skipped.
+func LoadTokens(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var tokens []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" && !strings.HasPrefix(line, "#") {
+			tokens = append(tokens, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("no tokens in %s", path)
+	}
+	return tokens, nil
+}
+
+// ServerOptions returns the interceptors enforcing access, for
+// NewGRPCServer.
+func (acc Access) ServerOptions() []grpc.ServerOption {
+	var lim *limiter
+	if acc.Rate > 0 {
+		burst := acc.Burst
+		if burst <= 0 {
+			burst = max(int(acc.Rate), 1)
+		}
+		lim = &limiter{rate: acc.Rate, burst: burst, buckets: make(map[string]*bucket), now: time.Now}
+	}
+
+	check := func(ctx context.Context) error {
+		client, err := acc.authenticate(ctx)
+		if err != nil {
+			return err
+		}
+		if lim != nil && !lim.allow(client) {
+			return status.Errorf(codes.ResourceExhausted, "rate limit of %g calls per second exceeded", acc.Rate)
+		}
+		return nil
+	}
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			if err := check(ctx); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}),
+		grpc.ChainStreamInterceptor(func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if err := check(ss.Context()); err != nil {
+				return err
+			}
+			return handler(srv, ss)
+		}),
+	}
+}
+
+// authenticate checks the call's bearer token and returns the client it
+// identifies for rate limiting: the token, or the caller's IP address when
+// calls are not authenticated.
+func (acc Access) authenticate(ctx context.Context) (string, error) {
+	if len(acc.Tokens) == 0 {
+		p, ok := peer.FromContext(ctx)
+		if !ok {
+			return "", nil
+		}
+		addr := p.Addr.String()
+		if host, _, err := net.SplitHostPort(addr); err == nil {
+			addr = host
+		}
+		return "addr:" + addr, nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, v := range md.Get("authorization") {
+		token, ok := strings.CutPrefix(v, "Bearer ")
+		if !ok {
+			continue
+		}
+		for _, t := range acc.Tokens {
+			if subtle.ConstantTimeCompare([]byte(token), []byte(t)) == 1 {
+				return "token:" + t, nil
+			}
+		}
+	}
+	return "", status.Error(codes.Unauthenticated, "missing or unknown bearer token")
+}
+
+// bucket is a client's token bucket: calls take a token, and tokens come
+// back at the rate up to the burst.
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// limiter rate limits calls per client.
+type limiter struct {
+	mu      sync.Mutex
+	rate    float64
+	burst   int
+	buckets map[string]*bucket
+	now     func() time.Time
+}
+
+// allow takes a token from the client's bucket, reporting whether there
+// was one.
+func (l *limiter) allow(client string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	b, ok := l.buckets[client]
+	if !ok {
+		if len(l.buckets) >= maxClients {
+			l.prune(now)
+		}
+		if len(l.buckets) >= maxClients {
+			l.evictIdlest()
+		}
+		b = &bucket{tokens: float64(l.burst), last: now}
+		l.buckets[client] = b
+	}
+	b.tokens = min(float64(l.burst), b.tokens+now.Sub(b.last).Seconds()*l.rate)
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// prune drops the buckets that would be full by now, which are the same
+// as no bucket.
+func (l *limiter) prune(now time.Time) {
+	for client, b := range l.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()*l.rate >= float64(l.burst) {
+			delete(l.buckets, client)
+		}
+	}
+}
+
+// evictIdlest drops the bucket of the client that called least recently,
+// so clients calling from ever new addresses cannot grow the buckets
+// without bound. The client starts over with a full bucket if it returns.
+func (l *limiter) evictIdlest() {
+	idlest := ""
+	var last time.Time
+	for client, b := range l.buckets {
+		if idlest == "" || b.last.Before(last) {
+			idlest, last = client, b.last
+		}
+	}
+	delete(l.buckets, idlest)
+}
@@ -0,0 +1,188 @@
+// Package server serves StringInspect's analysis over gRPC, for programs
+// that want its results without the TUI.
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+
+	"stringinspect/internal/analysis"
+	"stringinspect/internal/export"
+	"stringinspect/internal/pb"
+)
+
+const (
+	// lookupLimit is the number of name matches Lookup returns by default.
+	lookupLimit = 20
+
+	// batchSize is the most characters an AnalyzeStream reply holds.
+	batchSize = 1000
+)
+
+// Server implements the AnalysisService.
+type Server struct {
+	pb.UnimplementedAnalysisServiceServer
+	analyzer *analysis.Analyzer
+}
+
+// New creates a Server.
+func New() *Server {
+	return &Server{analyzer: analysis.NewAnalyzer()}
+}
+
+// NewGRPCServer returns a gRPC server with the AnalysisService and server
+// reflection registered, so tools like grpcurl can list the methods.
+func NewGRPCServer(opts ...grpc.ServerOption) *grpc.Server {
+	g := grpc.NewServer(opts...)
+	pb.RegisterAnalysisServiceServer(g, New())
+	reflection.Register(g)
+	return g
+}
+
+// analyzeData analyzes bytes as a file is analyzed: decoded as the
+// detected encoding, or byte by byte when they are binary.
+func (s *Server) analyzeData(data []byte) []analysis.Character {
+	det := analysis.DetectEncoding(data)
+	if det.Binary != "" {
+		return s.analyzer.AnalyzeBytes(data)
+	}
+	return s.analyzer.AnalyzeString(analysis.DecodeText(data, det.Encoding))
+}
+
+// Analyze returns the characters of a text and its summary.
+func (s *Server) Analyze(_ context.Context, req *pb.AnalyzeRequest) (*pb.Analysis, error) {
+	if data, ok := req.Input.(*pb.AnalyzeRequest_Data); ok {
+		return export.NewProtoAnalysis(s.analyzeData(data.Data)), nil
+	}
+	return export.NewProtoAnalysis(s.analyzer.AnalyzeString(req.GetText())), nil
+}
+
+// AnalyzeStream reads a text in chunks and replies with its characters in
+// batches as they are analyzed, then with the count and summary once the
+// client closes its side. Chunks are analyzed a piece at a time, so memory
+// does not grow with the text.
+func (s *Server) AnalyzeStream(stream grpc.BidiStreamingServer[pb.AnalyzeChunk, pb.Analysis]) error {
+	a := newStreamAnalysis(s.analyzer)
+	send := func(chars []analysis.Character) error {
+		for len(chars) > 0 {
+			n := min(batchSize, len(chars))
+			batch := &pb.Analysis{Count: int64(n), Characters: make([]*pb.Character, n)}
+			for i, c := range chars[:n] {
+				batch.Characters[i] = export.NewProtoCharacter(c)
+			}
+			if err := stream.Send(batch); err != nil {
+				return err
+			}
+			chars = chars[n:]
+		}
+		return nil
+	}
+
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		for data := chunk.Data; len(data) > 0; {
+			n := min(pieceBytes, len(data))
+			if err := send(a.write(data[:n], false)); err != nil {
+				return err
+			}
+			data = data[n:]
+		}
+	}
+	if err := send(a.write(nil, true)); err != nil {
+		return err
+	}
+
+	return stream.Send(&pb.Analysis{
+		Count:      int64(a.summary.Characters),
+		ExportedAt: time.Now().Format(time.RFC3339),
+		Summary:    export.NewProtoSummary(a.summary),
+	})
+}
+
+// Lookup finds characters by codepoint, literal character, or name.
+func (s *Server) Lookup(_ context.Context, req *pb.LookupRequest) (*pb.LookupResponse, error) {
+	// A lone space is a character too, so only longer queries are trimmed
+	query := req.Query
+	if utf8.RuneCountInString(query) > 1 {
+		query = strings.TrimSpace(query)
+	}
+	if query == "" {
+		return nil, status.Error(codes.InvalidArgument, "query is empty")
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = lookupLimit
+	}
+	runes, err := analysis.Lookup(query, limit)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp := &pb.LookupResponse{}
+	for _, r := range runes {
+		resp.Characters = append(resp.Characters, export.NewProtoCharacterInfo(s.analyzer.AnalyzeString(string(r))[0]))
+	}
+	return resp, nil
+}
+
+// Check reports the characters of a text a charset cannot represent.
+func (s *Server) Check(_ context.Context, req *pb.CheckRequest) (*pb.CheckResponse, error) {
+	cs, err := analysis.ParseCharset(req.Charset)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	chars := s.analyzer.AnalyzeString(req.Text)
+	runes := []rune(req.Text)
+
+	failures := cs.Incompatible(chars)
+	resp := &pb.CheckResponse{Charset: cs.Name, Ok: len(failures) == 0}
+	for _, i := range failures {
+		c := chars[i]
+		suggestion, _ := cs.Suggest(runes, i)
+		resp.Incompatible = append(resp.Incompatible, &pb.Incompatibility{
+			Position:   int64(c.RuneOffset),
+			Codepoint:  uint32(c.Rune),
+			Name:       analysis.Name(c.Rune),
+			Suggestion: suggestion,
+		})
+	}
+	if cs.Name == "GSM 03.38" {
+		resp.Sms = analysis.SMSLengthOf(req.Text).String()
+	}
+	return resp, nil
+}
+
+// normalizationForms names the protocol buffer forms as ParseNormalForm
+// does.
+var normalizationForms = map[pb.NormalizationForm]string{
+	pb.NormalizationForm_NORMALIZATION_FORM_UNSPECIFIED: "NFC",
+	pb.NormalizationForm_NORMALIZATION_FORM_NFC:         "NFC",
+	pb.NormalizationForm_NORMALIZATION_FORM_NFD:         "NFD",
+	pb.NormalizationForm_NORMALIZATION_FORM_NFKC:        "NFKC",
+	pb.NormalizationForm_NORMALIZATION_FORM_NFKD:        "NFKD",
+}
+
+// Normalize returns a text in a Unicode normalization form.
+func (s *Server) Normalize(_ context.Context, req *pb.NormalizeRequest) (*pb.NormalizeResponse, error) {
+	form, err := analysis.ParseNormalForm(normalizationForms[req.Form])
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown normalization form %v", req.Form)
+	}
+	text := form.String(req.Text)
+	return &pb.NormalizeResponse{Text: text, Changed: text != req.Text}, nil
+}
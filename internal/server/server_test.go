@@ -0,0 +1,377 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf16"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	"stringinspect/internal/pb"
+)
+
+// dial starts a server with opts on an in-memory listener and returns a
+// client for it.
+func dial(t *testing.T, opts ...grpc.ServerOption) pb.AnalysisServiceClient {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	g := NewGRPCServer(opts...)
+	go g.Serve(lis)
+	t.Cleanup(g.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewAnalysisServiceClient(conn)
+}
+
+func TestAnalyze(t *testing.T) {
+	client := dial(t)
+	ctx := context.Background()
+
+	got, err := client.Analyze(ctx, &pb.AnalyzeRequest{Input: &pb.AnalyzeRequest_Text{Text: "héllo"}})
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+	if got.Original != "héllo" || got.Count != 5 || got.Summary.Bytes != 6 {
+		t.Errorf("Analyze() = %q, %d chars, %d bytes, want héllo, 5, 6", got.Original, got.Count, got.Summary.Bytes)
+	}
+
+	// Bytes are decoded as a file would be
+	utf16 := []byte{0xFF, 0xFE, 'h', 0, 'i', 0}
+	got, err = client.Analyze(ctx, &pb.AnalyzeRequest{Input: &pb.AnalyzeRequest_Data{Data: utf16}})
+	if err != nil {
+		t.Fatalf("Analyze(data) error = %v", err)
+	}
+	if got.Original != "hi" {
+		t.Errorf("Analyze(UTF-16 data) = %q, want %q", got.Original, "hi")
+	}
+}
+
+// analyzeStream sends data to AnalyzeStream in chunks of size and returns
+// the characters of the replies and the closing summary reply.
+func analyzeStream(t *testing.T, client pb.AnalysisServiceClient, data []byte, size int) ([]*pb.Character, *pb.Analysis) {
+	t.Helper()
+	stream, err := client.AnalyzeStream(context.Background())
+	if err != nil {
+		t.Fatalf("AnalyzeStream() error = %v", err)
+	}
+	for i := 0; i < len(data); i += size {
+		if err := stream.Send(&pb.AnalyzeChunk{Data: data[i:min(i+size, len(data))]}); err != nil {
+			t.Fatalf("Send() error = %v", err)
+		}
+	}
+	if err := stream.CloseSend(); err != nil {
+		t.Fatalf("CloseSend() error = %v", err)
+	}
+
+	var chars []*pb.Character
+	var last *pb.Analysis
+	for {
+		reply, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Recv() error = %v", err)
+		}
+		if last != nil {
+			t.Fatal("a reply followed the summary")
+		}
+		if reply.Summary != nil {
+			last = reply
+			continue
+		}
+		if len(reply.Characters) > batchSize || int(reply.Count) != len(reply.Characters) {
+			t.Errorf("batch of %d characters counts %d", len(reply.Characters), reply.Count)
+		}
+		chars = append(chars, reply.Characters...)
+	}
+	if last == nil {
+		t.Fatal("no summary reply")
+	}
+	return chars, last
+}
+
+func TestAnalyzeStream(t *testing.T) {
+	client := dial(t)
+	text := strings.Repeat("añ😀e\u0301\r\n", 6000)
+	wide := []byte{0xFF, 0xFE}
+	for _, u := range utf16.Encode([]rune(text[:3000])) {
+		wide = append(wide, byte(u), byte(u>>8))
+	}
+	binary := make([]byte, 20000)
+	for i := range binary {
+		binary[i] = byte(i * 7)
+	}
+
+	// Chunks split inside characters, clusters, and CRLFs, and large chunks
+	// are analyzed in pieces
+	tests := []struct {
+		name string
+		data []byte
+		size int
+	}{
+		{"UTF-8", []byte(text), 7},
+		{"UTF-8 in one chunk", []byte(text), len(text)},
+		{"short", []byte("e\u0301"), 1},
+		{"UTF-16LE", wide, 3},
+		{"binary", binary, 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want, err := client.Analyze(context.Background(), &pb.AnalyzeRequest{Input: &pb.AnalyzeRequest_Data{Data: tt.data}})
+			if err != nil {
+				t.Fatalf("Analyze() error = %v", err)
+			}
+			chars, last := analyzeStream(t, client, tt.data, tt.size)
+			if last.Count != want.Count || !proto.Equal(last.Summary, want.Summary) {
+				t.Errorf("streamed %d chars summarized as %v, want %d, %v", last.Count, last.Summary, want.Count, want.Summary)
+			}
+			if len(chars) != len(want.Characters) {
+				t.Fatalf("streamed %d characters, want %d", len(chars), len(want.Characters))
+			}
+			for i, c := range chars {
+				if !proto.Equal(c, want.Characters[i]) {
+					t.Fatalf("streamed character %d = %v, want %v", i, c, want.Characters[i])
+				}
+			}
+		})
+	}
+}
+
+func TestAnalyzeStreamReplies(t *testing.T) {
+	client := dial(t)
+	stream, err := client.AnalyzeStream(context.Background())
+	if err != nil {
+		t.Fatalf("AnalyzeStream() error = %v", err)
+	}
+
+	// Characters come back before the text ends
+	if err := stream.Send(&pb.AnalyzeChunk{Data: []byte(strings.Repeat("abc ", 5000))}); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	reply, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv() error = %v", err)
+	}
+	if len(reply.Characters) != batchSize || reply.Characters[0].Char != "a" {
+		t.Errorf("first reply holds %d characters, want %d from the start", len(reply.Characters), batchSize)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	client := dial(t)
+	tests := []struct {
+		query string
+		want  uint32
+	}{
+		{"U+00A0", 0xA0},
+		{"0x200b", 0x200B},
+		{"é", 0xE9},
+		{" ", 0x20},
+		{"no-break space", 0xA0},
+	}
+	for _, tt := range tests {
+		got, err := client.Lookup(context.Background(), &pb.LookupRequest{Query: tt.query})
+		if err != nil {
+			t.Errorf("Lookup(%q) error = %v", tt.query, err)
+			continue
+		}
+		if len(got.Characters) == 0 || got.Characters[0].Character.Codepoint != tt.want {
+			t.Errorf("Lookup(%q) = %v, want U+%04X first", tt.query, got.Characters, tt.want)
+		}
+	}
+
+	got, err := client.Lookup(context.Background(), &pb.LookupRequest{Query: "zero width space"})
+	if err != nil || len(got.Characters) == 0 {
+		t.Fatalf("Lookup(zero width space) = %v, %v", got, err)
+	}
+	if info := got.Characters[0]; info.Name != "ZERO WIDTH SPACE" || info.Category != "Cf" || len(info.Warnings) == 0 {
+		t.Errorf("Lookup(zero width space) = %q %s %v, want name, Cf, and warnings", info.Name, info.Category, info.Warnings)
+	}
+
+	_, err = client.Lookup(context.Background(), &pb.LookupRequest{Query: "U+ZZ"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Lookup(U+ZZ) error = %v, want InvalidArgument", err)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	client := dial(t)
+	got, err := client.Check(context.Background(), &pb.CheckRequest{Text: "Hellо “world”", Charset: "ascii"})
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if got.Ok || len(got.Incompatible) != 3 {
+		t.Fatalf("Check() = %v, want 3 incompatible characters", got)
+	}
+	if c := got.Incompatible[0]; c.Position != 4 || c.Suggestion != "o" {
+		t.Errorf("Incompatible[0] = %v, want position 4 suggested as o", c)
+	}
+
+	got, err = client.Check(context.Background(), &pb.CheckRequest{Text: "hi", Charset: "gsm"})
+	if err != nil || !got.Ok || got.Sms == "" {
+		t.Errorf("Check(gsm) = %v, %v, want ok with an SMS length", got, err)
+	}
+
+	_, err = client.Check(context.Background(), &pb.CheckRequest{Text: "hi", Charset: "ebcdic"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Check(ebcdic) error = %v, want InvalidArgument", err)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	client := dial(t)
+	tests := []struct {
+		text    string
+		form    pb.NormalizationForm
+		want    string
+		changed bool
+	}{
+		{"e\u0301", pb.NormalizationForm_NORMALIZATION_FORM_UNSPECIFIED, "é", true},
+		{"é", pb.NormalizationForm_NORMALIZATION_FORM_NFD, "e\u0301", true},
+		{"ﬁ", pb.NormalizationForm_NORMALIZATION_FORM_NFKC, "fi", true},
+		{"abc", pb.NormalizationForm_NORMALIZATION_FORM_NFC, "abc", false},
+	}
+	for _, tt := range tests {
+		got, err := client.Normalize(context.Background(), &pb.NormalizeRequest{Text: tt.text, Form: tt.form})
+		if err != nil {
+			t.Errorf("Normalize(%q, %v) error = %v", tt.text, tt.form, err)
+			continue
+		}
+		if got.Text != tt.want || got.Changed != tt.changed {
+			t.Errorf("Normalize(%q, %v) = %q, %v, want %q, %v", tt.text, tt.form, got.Text, got.Changed, tt.want, tt.changed)
+		}
+	}
+}
+
+func TestAuthentication(t *testing.T) {
+	client := dial(t, Access{Tokens: []string{"s3cret", "other"}}.ServerOptions()...)
+	req := &pb.NormalizeRequest{Text: "x"}
+
+	tests := []struct {
+		name   string
+		header string
+		want   codes.Code
+	}{
+		{"no token", "", codes.Unauthenticated},
+		{"wrong token", "Bearer nope", codes.Unauthenticated},
+		{"not a bearer token", "s3cret", codes.Unauthenticated},
+		{"token", "Bearer s3cret", codes.OK},
+		{"second token", "Bearer other", codes.OK},
+	}
+	for _, tt := range tests {
+		ctx := context.Background()
+		if tt.header != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, "authorization", tt.header)
+		}
+		_, err := client.Normalize(ctx, req)
+		if status.Code(err) != tt.want {
+			t.Errorf("%s: Normalize() error = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+
+	// Streams are checked too
+	stream, err := client.AnalyzeStream(context.Background())
+	if err == nil {
+		_, err = stream.Recv()
+	}
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("AnalyzeStream() without token error = %v, want Unauthenticated", err)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	client := dial(t, Access{Rate: 0.001, Burst: 2}.ServerOptions()...)
+	req := &pb.NormalizeRequest{Text: "x"}
+	for i, want := range []codes.Code{codes.OK, codes.OK, codes.ResourceExhausted} {
+		if _, err := client.Normalize(context.Background(), req); status.Code(err) != want {
+			t.Errorf("call %d: Normalize() error = %v, want %v", i+1, err, want)
+		}
+	}
+}
+
+func TestLimiterRefills(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := &limiter{rate: 2, burst: 1, buckets: make(map[string]*bucket), now: func() time.Time { return now }}
+
+	if !l.allow("a") || l.allow("a") {
+		t.Fatal("allow() should admit one call, then refuse")
+	}
+	if !l.allow("b") {
+		t.Error("allow() refused another client")
+	}
+	now = now.Add(500 * time.Millisecond)
+	if !l.allow("a") {
+		t.Error("allow() refused a call after the bucket refilled")
+	}
+
+	// Idle clients are dropped once there are too many
+	for i := range maxClients {
+		l.buckets[fmt.Sprint(i)] = &bucket{last: now}
+	}
+	now = now.Add(time.Minute)
+	l.allow("c")
+	if len(l.buckets) > 3 {
+		t.Errorf("%d buckets kept after pruning, want at most 3", len(l.buckets))
+	}
+}
+
+func TestLimiterEvicts(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := &limiter{rate: 0.001, burst: 1, buckets: make(map[string]*bucket), now: func() time.Time { return now }}
+
+	// Busy clients are evicted, least recent first, rather than growing
+	// the buckets past the limit
+	for i := range maxClients {
+		now = now.Add(time.Millisecond)
+		l.allow(fmt.Sprint(i))
+	}
+	l.allow("new")
+	if len(l.buckets) > maxClients {
+		t.Errorf("%d buckets kept, want at most %d", len(l.buckets), maxClients)
+	}
+	if _, ok := l.buckets["0"]; ok {
+		t.Error("the least recent client was not evicted")
+	}
+	if _, ok := l.buckets["new"]; !ok {
+		t.Error("the new client has no bucket")
+	}
+}
+
+func TestLoadTokens(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tokens.txt")
+	if err := os.WriteFile(path, []byte("# shared service\nalpha\n\n  beta  \n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	got, err := LoadTokens(path)
+	if err != nil || len(got) != 2 || got[0] != "alpha" || got[1] != "beta" {
+		t.Errorf("LoadTokens() = %q, %v, want [alpha beta]", got, err)
+	}
+
+	if err := os.WriteFile(path, []byte("# none yet\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadTokens(path); err == nil {
+		t.Error("LoadTokens() of a file without tokens succeeded")
+	}
+}
@@ -0,0 +1,105 @@
+package server
+
+import (
+	"slices"
+	"unicode/utf8"
+
+	"github.com/rivo/uniseg"
+
+	"stringinspect/internal/analysis"
+)
+
+const (
+	// sniffBytes is how much of a streamed text its encoding is detected
+	// from, as much as DetectEncoding looks at to tell binary data.
+	sniffBytes = 8192
+
+	// pieceBytes is how much of a chunk is analyzed at a time, which bounds
+	// the characters held in memory.
+	pieceBytes = 64 << 10
+)
+
+// streamAnalysis analyzes a text as it arrives in pieces, giving the
+// characters and summary that analyzing it whole would.
+type streamAnalysis struct {
+	analyzer *analysis.Analyzer
+	head     []byte                // Bytes read before the encoding is detected
+	decoder  *analysis.TextDecoder // nil until the encoding is detected
+	binary   bool                  // The text is analyzed byte by byte
+	held     []analysis.Character  // The last grapheme cluster, which may go on
+	summary  analysis.Summary
+
+	byteOffset, runeOffset int // Offsets of the next character
+}
+
+func newStreamAnalysis(analyzer *analysis.Analyzer) *streamAnalysis {
+	return &streamAnalysis{analyzer: analyzer, summary: analysis.Summarize(nil)}
+}
+
+// write analyzes data following the text so far and returns the
+// characters it completes. With final set, the text ends after data and
+// every character left is returned.
+func (a *streamAnalysis) write(data []byte, final bool) []analysis.Character {
+	if a.decoder == nil {
+		a.head = append(a.head, data...)
+		if len(a.head) < sniffBytes && !final {
+			return nil
+		}
+		// UTF-32 is only detected in whole 4-byte units
+		sample := a.head
+		if !final {
+			sample = sample[:len(sample)&^3]
+		}
+		det := analysis.DetectEncoding(sample)
+		a.decoder = &analysis.TextDecoder{Encoding: det.Encoding}
+		a.binary = det.Binary != ""
+		data, a.head = a.head, nil
+	}
+
+	var chars []analysis.Character
+	if a.binary {
+		chars = a.analyzer.AnalyzeBytes(data)
+	} else {
+		chars = a.analyzer.AnalyzeString(a.decoder.Decode(data, final))
+	}
+	for i := range chars {
+		chars[i].ByteOffset += a.byteOffset
+		chars[i].RuneOffset += a.runeOffset
+	}
+	if n := len(chars); n > 0 {
+		a.byteOffset = chars[n-1].ByteOffset + len(chars[n-1].UTF8Bytes)
+		a.runeOffset = chars[n-1].RuneOffset + 1
+	}
+
+	// The last cluster is held back, unless it grows past a batch, so the
+	// pieces summarized split between clusters
+	chars = append(a.held, chars...)
+	cut := len(chars)
+	if !final {
+		if start := lastClusterStart(chars); cut-start <= batchSize {
+			cut = start
+		}
+	}
+	a.held = slices.Clone(chars[cut:])
+	chars = chars[:cut]
+	a.summary.Add(analysis.Summarize(chars))
+	return chars
+}
+
+// lastClusterStart returns the index of the first character of the last
+// grapheme cluster of chars.
+func lastClusterStart(chars []analysis.Character) int {
+	runes := make([]rune, len(chars))
+	for i, c := range chars {
+		runes[i] = c.Rune
+	}
+	start, i := 0, 0
+	rest, state := string(runes), -1
+	for rest != "" {
+		var cluster string
+		cluster, rest, _, state = uniseg.FirstGraphemeClusterInString(rest, state)
+		start = i
+		i += utf8.RuneCountInString(cluster)
+	}
+	return start
+}
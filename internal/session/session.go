@@ -0,0 +1,148 @@
+// Package session autosaves the open tabs of a running session, so they
+// can be restored after the terminal or the program dies.
+package session
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"stringinspect/internal/config"
+)
+
+// Tab is the saved state of one tab.
+type Tab struct {
+	Name     string `json:"name,omitempty"`
+	Input    string `json:"input,omitempty"` // Text of the tab
+	Cursor   int    `json:"cursor"`
+	Path     string `json:"path,omitempty"`     // File analyzed byte by byte, "" for stdin
+	Data     []byte `json:"data,omitempty"`     // Its bytes, with any unsaved edits
+	Original []byte `json:"original,omitempty"` // Its bytes as loaded, when edited
+}
+
+// Session is the saved state of a running instance.
+type Session struct {
+	PID    int       `json:"pid"` // Process that saved it
+	Saved  time.Time `json:"saved"`
+	Active int       `json:"active"` // Index of the active tab
+	Tabs   []Tab     `json:"tabs"`
+}
+
+// DefaultDir returns where sessions are saved, next to the recent files
+// list in the user's state directory (see config.StateDir), e.g.
+// ~/.local/state/stringinspect/sessions.
+func DefaultDir() (string, error) {
+	dir, err := config.StateDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "sessions"), nil
+}
+
+// Path returns the file the process pid saves its session to in dir.
+func Path(dir string, pid int) string {
+	return filepath.Join(dir, fmt.Sprintf("session-%d.json", pid))
+}
+
+// Save writes the session to path, creating its directory if needed. The
+// file is replaced in one step, so a crash while saving leaves the
+// previous save intact.
+func (s *Session) Save(path string) error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // No-op once renamed
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// Load reads the session saved at path.
+func Load(path string) (*Session, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var s Session
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return &s, nil
+}
+
+// Remove deletes the session saved at path, if any.
+func Remove(path string) error {
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// Orphaned returns the most recent session in dir left by a process that
+// is no longer running, with its path, or nil when there is none.
+// Sessions that cannot be read are skipped.
+func Orphaned(dir string) (*Session, string, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, "", nil
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	var latest *Session
+	var latestPath string
+	for _, e := range entries {
+		name := e.Name()
+		pid, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(name, "session-"), ".json"))
+		if err != nil || !strings.HasSuffix(name, ".json") || running(pid) {
+			continue
+		}
+		path := filepath.Join(dir, name)
+		s, err := Load(path)
+		if err != nil || len(s.Tabs) == 0 {
+			continue
+		}
+		if latest == nil || s.Saved.After(latest.Saved) {
+			latest, latestPath = s, path
+		}
+	}
+	return latest, latestPath, nil
+}
+
+// running reports whether a process with the given ID exists.
+func running(pid int) bool {
+	if pid == os.Getpid() {
+		return true
+	}
+	p, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	if runtime.GOOS == "windows" {
+		// FindProcess fails there for processes that do not exist
+		return true
+	}
+	return p.Signal(syscall.Signal(0)) == nil
+}
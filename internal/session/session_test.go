@@ -0,0 +1,70 @@
+package session
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSaveLoadRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sessions", "session-1.json")
+	s := &Session{
+		PID:    1,
+		Saved:  time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+		Active: 1,
+		Tabs: []Tab{
+			{Input: "héllo", Cursor: 2},
+			{Name: "blob.bin", Path: "/x/blob.bin", Data: []byte{0, 0xFF}, Original: []byte{0, 1}},
+		},
+	}
+	if err := s.Save(path); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	got, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if !got.Saved.Equal(s.Saved) || got.Active != 1 || len(got.Tabs) != 2 {
+		t.Fatalf("Load() = %+v, want %+v", got, s)
+	}
+	if got.Tabs[0].Input != "héllo" || got.Tabs[0].Cursor != 2 || string(got.Tabs[1].Data) != "\x00\xFF" {
+		t.Errorf("tabs = %+v", got.Tabs)
+	}
+
+	if err := Remove(path); err != nil {
+		t.Errorf("Remove() error = %v", err)
+	}
+	if err := Remove(path); err != nil {
+		t.Errorf("Remove() of a removed session: %v", err)
+	}
+}
+
+func TestOrphaned(t *testing.T) {
+	dir := t.TempDir()
+	if s, _, err := Orphaned(filepath.Join(dir, "missing")); s != nil || err != nil {
+		t.Errorf("Orphaned() of a missing directory = %v, %v", s, err)
+	}
+
+	// Our own session is in use; the other process is gone
+	const gone = 99999999
+	now := time.Now()
+	for _, s := range []*Session{
+		{PID: os.Getpid(), Saved: now, Tabs: []Tab{{Input: "mine"}}},
+		{PID: gone, Saved: now.Add(-time.Hour), Tabs: []Tab{{Input: "lost"}}},
+	} {
+		if err := s.Save(Path(dir, s.PID)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("not a session"), 0o644)
+
+	s, path, err := Orphaned(dir)
+	if err != nil {
+		t.Fatalf("Orphaned() error = %v", err)
+	}
+	if s == nil || s.Tabs[0].Input != "lost" || path != Path(dir, gone) {
+		t.Errorf("Orphaned() = %+v at %q, want the session of process %d", s, path, gone)
+	}
+}
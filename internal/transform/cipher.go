@@ -0,0 +1,120 @@
+package transform
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// englishFreq is the relative frequency of each letter in English text.
+var englishFreq = [26]float64{
+	0.0817, 0.0149, 0.0278, 0.0425, 0.1270, 0.0223, 0.0202, 0.0609, 0.0697,
+	0.0015, 0.0077, 0.0403, 0.0241, 0.0675, 0.0751, 0.0193, 0.0010, 0.0599,
+	0.0633, 0.0906, 0.0276, 0.0098, 0.0236, 0.0015, 0.0197, 0.0007,
+}
+
+// shiftLetters rotates ASCII letters forward by n places, keeping case.
+func shiftLetters(s string, n int) string {
+	n = (n%26 + 26) % 26
+	out := []rune(s)
+	for i, r := range out {
+		switch {
+		case r >= 'a' && r <= 'z':
+			out[i] = 'a' + (r-'a'+rune(n))%26
+		case r >= 'A' && r <= 'Z':
+			out[i] = 'A' + (r-'A'+rune(n))%26
+		}
+	}
+	return string(out)
+}
+
+// rot13 rotates letters by 13 places; applying it twice restores the
+// input.
+func rot13(s string) Result {
+	out := shiftLetters(s, 13)
+	return Result{Output: out, Report: []string{fmt.Sprintf("%d letters rotated", runeChanges(s, out))}}
+}
+
+// rot47 rotates the printable ASCII characters ! through ~ by 47 places,
+// covering digits and punctuation as well as letters.
+func rot47(s string) Result {
+	out := []rune(s)
+	n := 0
+	for i, r := range out {
+		if r >= '!' && r <= '~' {
+			out[i] = '!' + (r-'!'+47)%94
+			n++
+		}
+	}
+	return Result{Output: string(out), Report: []string{fmt.Sprintf("%d characters rotated", n)}}
+}
+
+// englishScore is the chi-squared distance between the letter frequencies
+// of s and English; lower is more English-like.
+func englishScore(s string) float64 {
+	var counts [26]int
+	total := 0
+	for _, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z':
+			counts[r-'a']++
+		case r >= 'A' && r <= 'Z':
+			counts[r-'A']++
+		default:
+			continue
+		}
+		total++
+	}
+	if total == 0 {
+		return 0
+	}
+	score := 0.0
+	for i, c := range counts {
+		expected := englishFreq[i] * float64(total)
+		score += (float64(c) - expected) * (float64(c) - expected) / expected
+	}
+	return score
+}
+
+// caesarGuess is a candidate decryption with its English score.
+type caesarGuess struct {
+	shift int // Places the letters were shifted when encrypting
+	text  string
+	score float64
+}
+
+// guessCaesar ranks all 26 shifts of s by how English the decryption
+// reads, best first.
+func guessCaesar(s string) []caesarGuess {
+	guesses := make([]caesarGuess, 26)
+	for shift := range guesses {
+		text := shiftLetters(s, -shift)
+		guesses[shift] = caesarGuess{shift, text, englishScore(text)}
+	}
+	slices.SortStableFunc(guesses, func(x, y caesarGuess) int {
+		return cmp.Compare(x.score, y.score)
+	})
+	return guesses
+}
+
+// caesar decrypts a Caesar cipher with the most likely shift, listing the
+// runners-up so a wrong guess can be spotted.
+func caesar(s string) Result {
+	guesses := guessCaesar(s)
+	best := guesses[0]
+	report := []string{fmt.Sprintf("Best guess: shift %d", best.shift)}
+	for _, g := range guesses[1:4] {
+		report = append(report, fmt.Sprintf("shift %2d: %s", g.shift, truncate(g.text, 40)))
+	}
+	return Result{Output: best.text, Report: report}
+}
+
+// truncate shortens s to at most n runes, marking the cut with an
+// ellipsis.
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n-1]) + "…"
+}
@@ -0,0 +1,23 @@
+package transform
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// stripDiacritics removes combining marks after canonical decomposition,
+// so "café" becomes "cafe". Letters without a decomposition, such as ø
+// or ł, are kept.
+func stripDiacritics(s string) Result {
+	return mapRunes(s, func(r rune) string {
+		base := strings.Map(func(r rune) rune {
+			if unicode.Is(unicode.Mn, r) {
+				return -1
+			}
+			return r
+		}, norm.NFD.String(string(r)))
+		return norm.NFC.String(base)
+	})
+}
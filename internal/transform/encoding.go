@@ -0,0 +1,107 @@
+package transform
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// encodedResult reports an encoding of s.
+func encodedResult(s, out string) Result {
+	return Result{Output: out, Report: []string{fmt.Sprintf("%d bytes → %d characters", len(s), len(out))}}
+}
+
+// decodedResult reports data decoded from s, noting when it is not text.
+func decodedResult(s string, data []byte) Result {
+	report := []string{fmt.Sprintf("%d characters → %d bytes", len(s), len(data))}
+	if !utf8.Valid(data) {
+		report = append(report, "Result is not valid UTF-8; invalid bytes are shown individually")
+	}
+	return Result{Output: string(data), Report: report}
+}
+
+// stripSpace removes all whitespace, which encoded data is often wrapped
+// or grouped with.
+func stripSpace(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+func toBase64(s string) Result {
+	return encodedResult(s, base64.StdEncoding.EncodeToString([]byte(s)))
+}
+
+// fromBase64 decodes standard or URL-safe base64, with or without
+// padding.
+func fromBase64(s string) Result {
+	data, err := decodeBase64(s)
+	if err != nil {
+		return Result{Output: s, Err: err}
+	}
+	return decodedResult(s, data)
+}
+
+func decodeBase64(s string) ([]byte, error) {
+	s = strings.TrimRight(stripSpace(s), "=")
+	enc := base64.RawStdEncoding
+	if strings.ContainsAny(s, "-_") {
+		enc = base64.RawURLEncoding
+	}
+	data, err := enc.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64: %w", err)
+	}
+	return data, nil
+}
+
+func toHex(s string) Result {
+	return encodedResult(s, hex.EncodeToString([]byte(s)))
+}
+
+// fromHex decodes hex digits, ignoring whitespace, colons, and 0x
+// prefixes.
+func fromHex(s string) Result {
+	data, err := decodeHex(s)
+	if err != nil {
+		return Result{Output: s, Err: err}
+	}
+	return decodedResult(s, data)
+}
+
+func decodeHex(s string) ([]byte, error) {
+	s = strings.NewReplacer("0x", "", "0X", "", ":", "").Replace(stripSpace(s))
+	data, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hex: %w", err)
+	}
+	return data, nil
+}
+
+// toPercent percent-encodes everything except unreserved characters, so
+// the result is safe in any part of a URL.
+func toPercent(s string) Result {
+	return encodedResult(s, strings.ReplaceAll(url.QueryEscape(s), "+", "%20"))
+}
+
+// fromPercent decodes %XX escapes. A + is kept as is, since it only means
+// a space in form data.
+func fromPercent(s string) Result {
+	out, err := url.PathUnescape(s)
+	if err != nil {
+		var esc url.EscapeError
+		if errors.As(err, &esc) {
+			err = fmt.Errorf("invalid escape %q", string(esc))
+		}
+		return Result{Output: s, Err: err}
+	}
+	return decodedResult(s, []byte(out))
+}
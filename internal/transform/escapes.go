@@ -0,0 +1,18 @@
+package transform
+
+import (
+	"stringinspect/internal/detect"
+	"stringinspect/internal/export"
+)
+
+// interpretEscapes replaces literal backslash escapes (\n, \u00e9, \x1b)
+// with the characters they stand for.
+func interpretEscapes(s string) Result {
+	return replaceMatches(s, detect.EscapePattern, func(m string) string {
+		out, err := export.Unescape(m)
+		if err != nil {
+			return m
+		}
+		return out
+	}, "escapes interpreted")
+}
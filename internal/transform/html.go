@@ -0,0 +1,13 @@
+package transform
+
+import (
+	"html"
+
+	"stringinspect/internal/detect"
+)
+
+// unescapeEntities replaces HTML character references with the characters
+// they stand for. Unknown names are left alone.
+func unescapeEntities(s string) Result {
+	return replaceMatches(s, detect.EntityPattern, html.UnescapeString, "entities replaced")
+}
@@ -0,0 +1,14 @@
+package transform
+
+import "stringinspect/internal/analysis"
+
+// smartPunctuation replaces curly quotes, dashes, and ellipses with their
+// ASCII equivalents.
+func smartPunctuation(s string) Result {
+	return mapRunes(s, func(r rune) string {
+		if ascii, ok := analysis.ASCIIPunctuation(r); ok {
+			return ascii
+		}
+		return string(r)
+	})
+}
@@ -0,0 +1,105 @@
+package transform
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"stringinspect/internal/analysis"
+)
+
+// Change is one kind of character replacement made by a transform.
+type Change struct {
+	From  string
+	To    string // Empty when the character was removed
+	Count int
+}
+
+// String describes the change, e.g. "2× é → e".
+func (c Change) String() string {
+	to := "removed"
+	if c.To != "" {
+		to = describe(c.To)
+	}
+	return fmt.Sprintf("%d× %s → %s", c.Count, describe(c.From), to)
+}
+
+// describe quotes text for a report, spelling out characters that would
+// be invisible or confusing on their own.
+func describe(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	if size == len(s) && (!unicode.IsGraphic(r) || unicode.Is(unicode.Mn, r) || unicode.Is(unicode.Zs, r) && r != ' ') {
+		return fmt.Sprintf("U+%04X %s", r, analysis.Name(r))
+	}
+	for _, r := range s {
+		if !unicode.IsGraphic(r) || unicode.IsSpace(r) || unicode.Is(unicode.Mn, r) {
+			return strconv.QuoteToASCII(s)
+		}
+	}
+	return s
+}
+
+// tally collects changes in order of first appearance.
+type tally struct {
+	changes []Change
+	index   map[string]int
+}
+
+// add counts one replacement of from by to.
+func (t *tally) add(from, to string) {
+	if t.index == nil {
+		t.index = make(map[string]int)
+	}
+	i, ok := t.index[from]
+	if !ok {
+		i = len(t.changes)
+		t.index[from] = i
+		t.changes = append(t.changes, Change{From: from, To: to})
+	}
+	t.changes[i].Count++
+}
+
+// mapRunes replaces each rune of s with fn(r), tallying the changes.
+func mapRunes(s string, fn func(r rune) string) Result {
+	var b strings.Builder
+	var t tally
+	for _, r := range s {
+		to := fn(r)
+		b.WriteString(to)
+		if to != string(r) {
+			t.add(string(r), to)
+		}
+	}
+	return changeResult(b.String(), t.changes, "characters altered")
+}
+
+// replaceMatches replaces each match of re in s with fn(match), tallying
+// the changes.
+func replaceMatches(s string, re *regexp.Regexp, fn func(m string) string, what string) Result {
+	var t tally
+	out := re.ReplaceAllStringFunc(s, func(m string) string {
+		to := fn(m)
+		if to != m {
+			t.add(m, to)
+		}
+		return to
+	})
+	return changeResult(out, t.changes, what)
+}
+
+// changeResult reports the total number of changes, e.g. "3 characters
+// altered", followed by one line per kind of change.
+func changeResult(out string, changes []Change, what string) Result {
+	total := 0
+	for _, c := range changes {
+		total += c.Count
+	}
+	report := []string{fmt.Sprintf("%d %s", total, what)}
+	for _, c := range changes {
+		report = append(report, c.String())
+	}
+	return Result{Output: out, Report: report, Changes: changes}
+}
@@ -0,0 +1,52 @@
+package transform
+
+import (
+	"slices"
+	"unicode"
+)
+
+// invisibleClasses groups the characters the sanitize transform can
+// remove, by config name.
+var invisibleClasses = map[string]*unicode.RangeTable{
+	"zero_width": {R16: []unicode.Range16{
+		{Lo: 0x180E, Hi: 0x180E, Stride: 1}, // Mongolian vowel separator
+		{Lo: 0x200B, Hi: 0x200D, Stride: 1}, // ZWSP, ZWNJ, ZWJ
+		{Lo: 0x2060, Hi: 0x2064, Stride: 1}, // Word joiner and invisible operators
+	}},
+	"bidi": {R16: []unicode.Range16{
+		{Lo: 0x061C, Hi: 0x061C, Stride: 1}, // Arabic letter mark
+		{Lo: 0x200E, Hi: 0x200F, Stride: 1}, // LRM, RLM
+		{Lo: 0x202A, Hi: 0x202E, Stride: 1}, // Embeddings and overrides
+		{Lo: 0x2066, Hi: 0x2069, Stride: 1}, // Isolates
+	}},
+	"bom": {R16: []unicode.Range16{
+		{Lo: 0xFEFF, Hi: 0xFEFF, Stride: 1},
+	}},
+}
+
+// InvisibleClasses lists the config names of the removable character
+// classes.
+var InvisibleClasses = []string{"zero_width", "bidi", "bom"}
+
+// sanitize removes the characters of the given classes; no classes means
+// all of them. Unknown class names are ignored.
+func sanitize(classes []string) func(string) Result {
+	if len(classes) == 0 {
+		classes = InvisibleClasses
+	}
+	var tables []*unicode.RangeTable
+	for _, name := range classes {
+		if t, ok := invisibleClasses[name]; ok && !slices.Contains(tables, t) {
+			tables = append(tables, t)
+		}
+	}
+
+	return func(s string) Result {
+		return mapRunes(s, func(r rune) string {
+			if unicode.IsOneOf(tables, r) {
+				return ""
+			}
+			return string(r)
+		})
+	}
+}
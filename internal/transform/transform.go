@@ -0,0 +1,148 @@
+// Package transform provides string operations that can be applied to
+// the input, each reporting what it changed.
+package transform
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/rivo/uniseg"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+// Result is the output of a transform.
+type Result struct {
+	Output string
+	Report []string // Human-readable summary of the changes, one per line
+
+	// Changes lists per-character replacements, when the transform works
+	// character by character
+	Changes []Change
+
+	// Err is set when the input could not be transformed, e.g. when it is
+	// not valid base64
+	Err error
+}
+
+// Changed reports whether the transform altered its input.
+func (r Result) Changed(input string) bool {
+	return r.Err == nil && r.Output != input
+}
+
+// Transform is a named string operation.
+type Transform struct {
+	Key   string // Menu hotkey
+	Name  string
+	Apply func(s string) Result
+}
+
+// Options configures the transforms that have settings.
+type Options struct {
+	// Invisible lists the classes removed by the sanitize transform (see
+	// InvisibleClasses); empty means all of them
+	Invisible []string
+}
+
+// List returns the available transforms in menu order.
+func List(opts Options) []Transform {
+	return []Transform{
+		{"u", "Upper case", upper},
+		{"l", "Lower case", lower},
+		{"t", "Title case", title},
+		{"r", "Reverse", reverse},
+		{"w", "Trim surrounding whitespace", trim},
+		{"d", "Strip diacritics", stripDiacritics},
+		{"z", "Remove invisible characters", sanitize(opts.Invisible)},
+		{"p", "ASCII punctuation", smartPunctuation},
+		{"s", "Normalize whitespace", normalizeWhitespace},
+		{"3", "ROT13", rot13},
+		{"7", "ROT47", rot47},
+		{"c", "Caesar decode (guess shift)", caesar},
+		{"b", "To base64", toBase64},
+		{"B", "From base64", fromBase64},
+		{"x", "To hex", toHex},
+		{"X", "From hex", fromHex},
+		{"e", "To percent-encoding", toPercent},
+		{"E", "From percent-encoding", fromPercent},
+		{"&", "Unescape HTML entities", unescapeEntities},
+		{"\\", "Interpret backslash escapes", interpretEscapes},
+	}
+}
+
+// Find returns the transform in list with the given menu hotkey.
+func Find(list []Transform, key string) (Transform, bool) {
+	for _, t := range list {
+		if t.Key == key {
+			return t, true
+		}
+	}
+	return Transform{}, false
+}
+
+// runeChanges counts the positions where two strings of equal rune
+// length differ.
+func runeChanges(before, after string) int {
+	a, b := []rune(before), []rune(after)
+	if len(a) != len(b) {
+		return -1
+	}
+	n := 0
+	for i := range a {
+		if a[i] != b[i] {
+			n++
+		}
+	}
+	return n
+}
+
+// caseResult reports a case mapping. Mappings can change the length
+// (ß → SS), in which case only the new length is reported.
+func caseResult(before, after string) Result {
+	n := runeChanges(before, after)
+	if n < 0 {
+		return Result{Output: after, Report: []string{
+			fmt.Sprintf("%d → %d characters", utf8.RuneCountInString(before), utf8.RuneCountInString(after)),
+		}}
+	}
+	return Result{Output: after, Report: []string{fmt.Sprintf("%d characters changed", n)}}
+}
+
+func upper(s string) Result {
+	return caseResult(s, cases.Upper(language.Und).String(s))
+}
+
+func lower(s string) Result {
+	return caseResult(s, cases.Lower(language.Und).String(s))
+}
+
+func title(s string) Result {
+	return caseResult(s, cases.Title(language.Und).String(s))
+}
+
+// reverse reverses the order of grapheme clusters, so combining marks
+// and emoji sequences stay attached to their base characters.
+func reverse(s string) Result {
+	var clusters []string
+	g := uniseg.NewGraphemes(s)
+	for g.Next() {
+		clusters = append(clusters, g.Str())
+	}
+	var b strings.Builder
+	for i := len(clusters) - 1; i >= 0; i-- {
+		b.WriteString(clusters[i])
+	}
+	return Result{Output: b.String(), Report: []string{fmt.Sprintf("%d graphemes reversed", len(clusters))}}
+}
+
+func trim(s string) Result {
+	left := strings.TrimLeftFunc(s, unicode.IsSpace)
+	out := strings.TrimRightFunc(left, unicode.IsSpace)
+	leading := utf8.RuneCountInString(s) - utf8.RuneCountInString(left)
+	trailing := utf8.RuneCountInString(left) - utf8.RuneCountInString(out)
+	return Result{Output: out, Report: []string{
+		fmt.Sprintf("%d leading and %d trailing whitespace characters removed", leading, trailing),
+	}}
+}
@@ -0,0 +1,256 @@
+package transform
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestTransforms(t *testing.T) {
+	tests := []struct {
+		key   string
+		input string
+		want  string
+	}{
+		{"u", "héllo", "HÉLLO"},
+		{"u", "straße", "STRASSE"},
+		{"l", "ÀBC", "àbc"},
+		{"t", "hello wide world", "Hello Wide World"},
+		{"r", "abc", "cba"},
+		{"r", "éx👍🏽", "👍🏽xé"}, // Clusters stay intact
+		{"w", " \t hi  \n", "hi"},
+		{"w", "hi", "hi"},
+	}
+
+	for _, tt := range tests {
+		tr, ok := Find(List(Options{}), tt.key)
+		if !ok {
+			t.Fatalf("Find(%q) not found", tt.key)
+		}
+		if got := tr.Apply(tt.input).Output; got != tt.want {
+			t.Errorf("%s(%q) = %q, want %q", tr.Name, tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestReports(t *testing.T) {
+	tests := []struct {
+		key   string
+		input string
+		want  string
+	}{
+		{"u", "abC", "2 characters changed"},
+		{"u", "ß", "1 → 2 characters"},
+		{"r", "éx", "2 graphemes reversed"},
+		{"w", "  hi ", "2 leading and 1 trailing"},
+	}
+
+	for _, tt := range tests {
+		tr, _ := Find(List(Options{}), tt.key)
+		report := strings.Join(tr.Apply(tt.input).Report, "\n")
+		if !strings.Contains(report, tt.want) {
+			t.Errorf("%s(%q) report = %q, want %q", tr.Name, tt.input, report, tt.want)
+		}
+	}
+}
+
+func TestKeysUnique(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, tr := range List(Options{}) {
+		if seen[tr.Key] {
+			t.Errorf("duplicate key %q", tr.Key)
+		}
+		seen[tr.Key] = true
+	}
+}
+
+func TestStripDiacritics(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"café", "cafe"},
+		{"cafe\u0301", "cafe"}, // Already decomposed
+		{"Ångström naïve", "Angstrom naive"},
+		{"łódź", "łodz"}, // ł has no decomposition
+		{"한글", "한글"},     // Hangul syllables decompose into letters, not marks
+	}
+
+	for _, tt := range tests {
+		if got := stripDiacritics(tt.input).Output; got != tt.want {
+			t.Errorf("stripDiacritics(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+
+	res := stripDiacritics("\u00e9e\u0301\u00e9")
+	want := []Change{{"\u00e9", "e", 2}, {"\u0301", "", 1}}
+	if len(res.Changes) != len(want) {
+		t.Fatalf("changes = %v, want %v", res.Changes, want)
+	}
+	for i := range want {
+		if res.Changes[i] != want[i] {
+			t.Errorf("change %d = %v, want %v", i, res.Changes[i], want[i])
+		}
+	}
+	wantReport := []string{"3 characters altered", "2× é → e", "1× U+0301 COMBINING ACUTE ACCENT → removed"}
+	if !slices.Equal(res.Report, wantReport) {
+		t.Errorf("report = %q", res.Report)
+	}
+}
+
+func TestSanitize(t *testing.T) {
+	input := "\ufeffa\u200bb\u200bc\u202ed\u2066"
+
+	res := sanitize(nil)(input)
+	if res.Output != "abcd" {
+		t.Errorf("sanitize(all) = %q, want %q", res.Output, "abcd")
+	}
+	want := []string{
+		"5 characters altered",
+		"1× U+FEFF ZERO WIDTH NO-BREAK SPACE → removed",
+		"2× U+200B ZERO WIDTH SPACE → removed",
+		"1× U+202E RIGHT-TO-LEFT OVERRIDE → removed",
+		"1× U+2066 LEFT-TO-RIGHT ISOLATE → removed",
+	}
+	if !slices.Equal(res.Report, want) {
+		t.Errorf("report = %q, want %q", res.Report, want)
+	}
+
+	// Only the configured classes are removed
+	if got := sanitize([]string{"bidi", "unknown"})(input).Output; got != "\ufeffa\u200bb\u200bcd" {
+		t.Errorf("sanitize(bidi) = %q", got)
+	}
+	if got := sanitize([]string{"bom"})("\ufeffx\u00a0").Output; got != "x\u00a0" {
+		t.Errorf("sanitize(bom) = %q", got)
+	}
+}
+
+func TestSmartPunctuation(t *testing.T) {
+	res := smartPunctuation("\u201cIt\u2019s\u201d \u2014 1\u20132\u2026 it\u2019s")
+	if want := `"It's" -- 1-2... it's`; res.Output != want {
+		t.Errorf("output = %q, want %q", res.Output, want)
+	}
+	want := []string{
+		"7 characters altered",
+		`1× “ → "`,
+		"2× ’ → '",
+		`1× ” → "`,
+		"1× — → --",
+		"1× – → -",
+		"1× … → ...",
+	}
+	if !slices.Equal(res.Report, want) {
+		t.Errorf("report = %q, want %q", res.Report, want)
+	}
+}
+
+func TestNormalizeWhitespace(t *testing.T) {
+	input := "  if x {\r\n\t\treturn  a\u00a0+\tb \t\r\n}\u2028end\u3000"
+	res := normalizeWhitespace(input)
+	if want := "  if x {\n\t\treturn a + b\n}\nend"; res.Output != want {
+		t.Errorf("output = %q, want %q", res.Output, want)
+	}
+	want := []string{
+		"3 line endings converted to LF",
+		"2 exotic spaces replaced with U+0020",
+		"3 trailing whitespace characters removed",
+		"2 whitespace runs collapsed",
+	}
+	if !slices.Equal(res.Report, want) {
+		t.Errorf("report = %q, want %q", res.Report, want)
+	}
+}
+
+func TestRotations(t *testing.T) {
+	if got := rot13("Hello, World!").Output; got != "Uryyb, Jbeyq!" {
+		t.Errorf("rot13 = %q", got)
+	}
+	if got := rot47("Hello, World!").Output; got != "w6==@[ (@C=5P" {
+		t.Errorf("rot47 = %q", got)
+	}
+
+	// Both are their own inverse
+	for _, s := range []string{"The quick brown fox", "p@ss-w0rd~{}", "naïve"} {
+		if got := rot13(rot13(s).Output).Output; got != s {
+			t.Errorf("rot13 twice = %q, want %q", got, s)
+		}
+		if got := rot47(rot47(s).Output).Output; got != s {
+			t.Errorf("rot47 twice = %q, want %q", got, s)
+		}
+	}
+}
+
+func TestCaesar(t *testing.T) {
+	plain := "Meet me near the old oak tree at seven tonight"
+	for _, shift := range []int{1, 3, 13, 25} {
+		res := caesar(shiftLetters(plain, shift))
+		if res.Output != plain {
+			t.Errorf("shift %d: decoded %q", shift, res.Output)
+		}
+		if want := fmt.Sprintf("Best guess: shift %d", shift); res.Report[0] != want {
+			t.Errorf("shift %d: report %q", shift, res.Report[0])
+		}
+	}
+}
+
+func TestEncodings(t *testing.T) {
+	tests := []struct {
+		key   string
+		input string
+		want  string
+	}{
+		{"b", "héllo", "aMOpbGxv"},
+		{"B", "aMOpbGxv", "héllo"},
+		{"B", "aGk=\n", "hi"},
+		{"B", "-_8", "\xfb\xff"}, // URL-safe alphabet
+		{"x", "hé", "68c3a9"},
+		{"X", "0x68 0xC3:A9", "hé"},
+		{"e", "a b/ç?", "a%20b%2F%C3%A7%3F"},
+		{"E", "a%20b%2F%C3%A7+", "a b/ç+"},
+	}
+
+	for _, tt := range tests {
+		tr, _ := Find(List(Options{}), tt.key)
+		res := tr.Apply(tt.input)
+		if res.Err != nil || res.Output != tt.want {
+			t.Errorf("%s(%q) = %q, %v; want %q", tr.Name, tt.input, res.Output, res.Err, tt.want)
+		}
+	}
+
+	for _, fn := range []func(string) Result{fromBase64, fromHex, fromPercent} {
+		if res := fn("%zz!"); res.Err == nil || res.Changed("%zz!") {
+			t.Errorf("invalid input decoded to %q", res.Output)
+		}
+	}
+	if res := fromHex("ff"); len(res.Report) != 2 {
+		t.Errorf("invalid UTF-8 not reported: %q", res.Report)
+	}
+}
+
+func TestUnescapeEntities(t *testing.T) {
+	res := unescapeEntities("caf&#233; &amp; cr&egrave;me &#x1F600; &amp; &bogus; AT&T")
+	if want := "café & crème 😀 & &bogus; AT&T"; res.Output != want {
+		t.Errorf("output = %q, want %q", res.Output, want)
+	}
+	want := []string{
+		"5 entities replaced",
+		"1× &#233; → é",
+		"2× &amp; → &",
+		"1× &egrave; → è",
+		"1× &#x1F600; → 😀",
+	}
+	if !slices.Equal(res.Report, want) {
+		t.Errorf("report = %q, want %q", res.Report, want)
+	}
+}
+
+func TestInterpretEscapes(t *testing.T) {
+	res := interpretEscapes(`a\tb\nc\u00e9 \U0001F600 \x41\q \\n`)
+	if want := "a\tb\nc\u00e9 \U0001F600 A\\q \\n"; res.Output != want {
+		t.Errorf("output = %q, want %q", res.Output, want)
+	}
+	if res.Report[0] != "6 escapes interpreted" {
+		t.Errorf("report = %q", res.Report)
+	}
+}
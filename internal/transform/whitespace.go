@@ -0,0 +1,85 @@
+package transform
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// lineEndings lists the line breaks converted to LF, longest first.
+var lineEndings = []string{"\r\n", "\r", "\u0085", "\u2028", "\u2029"}
+
+// normalizeWhitespace converts line endings to LF and exotic spaces to
+// U+0020, trims trailing whitespace from each line, and collapses runs of
+// spaces and tabs into a single space. Leading indentation is kept.
+func normalizeWhitespace(s string) Result {
+	var endings, exotic, trailing, collapsed int
+
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		n := 0
+		for _, eol := range lineEndings {
+			if strings.HasPrefix(s[i:], eol) {
+				n = len(eol)
+				break
+			}
+		}
+		if n > 0 {
+			b.WriteByte('\n')
+			endings++
+			i += n
+		} else {
+			b.WriteByte(s[i])
+			i++
+		}
+	}
+
+	lines := strings.Split(b.String(), "\n")
+	for i, line := range lines {
+		line = strings.Map(func(r rune) rune {
+			if r == '\v' || r == '\f' || unicode.Is(unicode.Zs, r) && r != ' ' {
+				exotic++
+				return ' '
+			}
+			return r
+		}, line)
+
+		trimmed := strings.TrimRight(line, " \t")
+		trailing += len(line) - len(trimmed)
+
+		body := strings.TrimLeft(trimmed, " \t")
+		var out strings.Builder
+		out.WriteString(trimmed[:len(trimmed)-len(body)])
+		for j, field := range strings.FieldsFunc(body, isBlank) {
+			if j > 0 {
+				out.WriteByte(' ')
+			}
+			out.WriteString(field)
+		}
+		collapsed += countRuns(body)
+		lines[i] = out.String()
+	}
+
+	return Result{Output: strings.Join(lines, "\n"), Report: []string{
+		fmt.Sprintf("%d line endings converted to LF", endings),
+		fmt.Sprintf("%d exotic spaces replaced with U+0020", exotic),
+		fmt.Sprintf("%d trailing whitespace characters removed", trailing),
+		fmt.Sprintf("%d whitespace runs collapsed", collapsed),
+	}}
+}
+
+// isBlank reports whether r is a space or tab.
+func isBlank(r rune) bool {
+	return r == ' ' || r == '\t'
+}
+
+// countRuns counts the runs of blanks in s that are not a single space.
+func countRuns(s string) int {
+	n := 0
+	for _, field := range strings.FieldsFunc(s, func(r rune) bool { return !isBlank(r) }) {
+		if field != " " {
+			n++
+		}
+	}
+	return n
+}
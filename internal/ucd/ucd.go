@@ -0,0 +1,99 @@
+// Package ucd downloads Unicode Character Database files, from which
+// StringInspect reads block, name, and confusable data newer than the data
+// it was built with.
+package ucd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// BaseURL is where the Unicode Consortium publishes the data files.
+const BaseURL = "https://www.unicode.org/Public"
+
+// Files are the data files StringInspect reads.
+var Files = []string{"Blocks.txt", "UnicodeData.txt", "confusables.txt"}
+
+// DefaultDir returns where downloaded files are kept, under the user's
+// config directory (e.g. ~/.config/stringinspect/ucd).
+func DefaultDir() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "stringinspect", "ucd"), nil
+}
+
+// URL returns the address of a data file of a Unicode version, such as
+// "16.0.0", or of the newest version when version is "latest".
+func URL(base, version, name string) string {
+	if name == "confusables.txt" {
+		return fmt.Sprintf("%s/security/%s/%s", base, version, name)
+	}
+	if version == "latest" {
+		return fmt.Sprintf("%s/UCD/latest/ucd/%s", base, name)
+	}
+	return fmt.Sprintf("%s/%s/ucd/%s", base, version, name)
+}
+
+// Download fetches the named data files of a Unicode version from base
+// into dir, all of Files when names is empty. Each file replaces the one
+// in dir only once it has been downloaded completely.
+func Download(ctx context.Context, client *http.Client, base, version, dir string, names []string) error {
+	if len(names) == 0 {
+		names = Files
+	}
+	for _, name := range names {
+		if !slices.Contains(Files, name) {
+			return fmt.Errorf("unknown data file %q (want %s)", name, strings.Join(Files, ", "))
+		}
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for _, name := range names {
+		if err := download(ctx, client, URL(base, version, name), filepath.Join(dir, name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// download fetches url to path through a temporary file.
+func download(ctx context.Context, client *http.Client, url, path string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: %s", url, resp.Status)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // No-op once renamed
+	if _, err := io.Copy(tmp, resp.Body); err != nil {
+		tmp.Close()
+		return fmt.Errorf("%s: %w", url, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,65 @@
+package ucd
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestURL(t *testing.T) {
+	tests := []struct {
+		version, name, want string
+	}{
+		{"16.0.0", "Blocks.txt", BaseURL + "/16.0.0/ucd/Blocks.txt"},
+		{"latest", "UnicodeData.txt", BaseURL + "/UCD/latest/ucd/UnicodeData.txt"},
+		{"16.0.0", "confusables.txt", BaseURL + "/security/16.0.0/confusables.txt"},
+		{"latest", "confusables.txt", BaseURL + "/security/latest/confusables.txt"},
+	}
+	for _, tt := range tests {
+		if got := URL(BaseURL, tt.version, tt.name); got != tt.want {
+			t.Errorf("URL(%q, %q) = %q, want %q", tt.version, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDownload(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/16.0.0/ucd/Blocks.txt":
+			w.Write([]byte("# Blocks-16.0.0.txt\n"))
+		case "/security/16.0.0/confusables.txt":
+			w.Write([]byte("# confusables.txt\n"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	dir := filepath.Join(t.TempDir(), "ucd")
+	err := Download(context.Background(), srv.Client(), srv.URL, "16.0.0", dir, []string{"Blocks.txt", "confusables.txt"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "Blocks.txt"))
+	if err != nil || string(data) != "# Blocks-16.0.0.txt\n" {
+		t.Errorf("Blocks.txt = %q, %v", data, err)
+	}
+
+	// A failed download leaves the previous file and no temporary ones
+	if err := Download(context.Background(), srv.Client(), srv.URL, "99.0.0", dir, []string{"Blocks.txt"}); err == nil {
+		t.Error("Download of a missing version succeeded")
+	}
+	if data, _ := os.ReadFile(filepath.Join(dir, "Blocks.txt")); string(data) != "# Blocks-16.0.0.txt\n" {
+		t.Errorf("Blocks.txt after a failed download = %q", data)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 2 {
+		t.Errorf("%d files in %s, want 2", len(entries), dir)
+	}
+
+	if err := Download(context.Background(), srv.Client(), srv.URL, "16.0.0", dir, []string{"Scripts.txt"}); err == nil {
+		t.Error("Download of an unknown file succeeded")
+	}
+}
@@ -0,0 +1,75 @@
+// Package undo provides a multi-level undo/redo stack for input text.
+package undo
+
+// Stack records previous input states for undo and redo.
+type Stack struct {
+	undo      []string
+	redo      []string
+	limit     int    // Maximum undo steps to keep
+	lastGroup string // Group of the most recent Record, for coalescing
+}
+
+// New creates a new Stack keeping at most limit undo steps.
+func New(limit int) *Stack {
+	if limit < 1 {
+		limit = 100
+	}
+	return &Stack{limit: limit}
+}
+
+// Record saves the text as it was before a change and clears the redo
+// stack. Consecutive records in the same non-empty group (e.g. "typing")
+// are coalesced into a single undo step.
+func (s *Stack) Record(before, group string) {
+	s.redo = nil
+	if group != "" && group == s.lastGroup && len(s.undo) > 0 {
+		return
+	}
+	s.lastGroup = group
+
+	s.undo = append(s.undo, before)
+	if len(s.undo) > s.limit {
+		s.undo = s.undo[len(s.undo)-s.limit:]
+	}
+}
+
+// Break ends the current group so the next Record starts a new step.
+func (s *Stack) Break() {
+	s.lastGroup = ""
+}
+
+// Undo returns the previous text and saves current for redo.
+// It returns false if there is nothing to undo.
+func (s *Stack) Undo(current string) (string, bool) {
+	if len(s.undo) == 0 {
+		return "", false
+	}
+	prev := s.undo[len(s.undo)-1]
+	s.undo = s.undo[:len(s.undo)-1]
+	s.redo = append(s.redo, current)
+	s.lastGroup = ""
+	return prev, true
+}
+
+// Redo returns the text of the last undone change and saves current for
+// undo. It returns false if there is nothing to redo.
+func (s *Stack) Redo(current string) (string, bool) {
+	if len(s.redo) == 0 {
+		return "", false
+	}
+	next := s.redo[len(s.redo)-1]
+	s.redo = s.redo[:len(s.redo)-1]
+	s.undo = append(s.undo, current)
+	s.lastGroup = ""
+	return next, true
+}
+
+// UndoLen returns the number of available undo steps.
+func (s *Stack) UndoLen() int {
+	return len(s.undo)
+}
+
+// RedoLen returns the number of available redo steps.
+func (s *Stack) RedoLen() int {
+	return len(s.redo)
+}
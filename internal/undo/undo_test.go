@@ -0,0 +1,61 @@
+package undo
+
+import "testing"
+
+func TestUndoRedo(t *testing.T) {
+	s := New(10)
+	s.Record("", "")
+	s.Record("a", "")
+
+	got, ok := s.Undo("ab")
+	if !ok || got != "a" {
+		t.Fatalf("Undo() = %q, %v, want %q, true", got, ok, "a")
+	}
+	got, ok = s.Undo("a")
+	if !ok || got != "" {
+		t.Fatalf("Undo() = %q, %v, want %q, true", got, ok, "")
+	}
+	if _, ok := s.Undo(""); ok {
+		t.Error("Undo() on empty stack succeeded")
+	}
+
+	got, ok = s.Redo("")
+	if !ok || got != "a" {
+		t.Fatalf("Redo() = %q, %v, want %q, true", got, ok, "a")
+	}
+
+	// A new change clears the redo stack
+	s.Record("a", "")
+	if s.RedoLen() != 0 {
+		t.Errorf("RedoLen() = %d after Record, want 0", s.RedoLen())
+	}
+}
+
+func TestRecordCoalescesGroups(t *testing.T) {
+	s := New(10)
+	s.Record("", "typing")
+	s.Record("h", "typing")
+	s.Record("hi", "typing")
+	if s.UndoLen() != 1 {
+		t.Fatalf("UndoLen() = %d after typing burst, want 1", s.UndoLen())
+	}
+
+	s.Break()
+	s.Record("hi!", "typing")
+	if s.UndoLen() != 2 {
+		t.Errorf("UndoLen() = %d after Break, want 2", s.UndoLen())
+	}
+}
+
+func TestLimit(t *testing.T) {
+	s := New(3)
+	for _, text := range []string{"a", "b", "c", "d", "e"} {
+		s.Record(text, "")
+	}
+	if s.UndoLen() != 3 {
+		t.Fatalf("UndoLen() = %d, want 3", s.UndoLen())
+	}
+	if got, _ := s.Undo("f"); got != "e" {
+		t.Errorf("Undo() = %q, want %q", got, "e")
+	}
+}
@@ -1,48 +1,875 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
+	"unicode/utf8"
 
+	"github.com/atotto/clipboard"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"golang.org/x/text/unicode/norm"
 
+	"stringinspect/internal/analysis"
 	"stringinspect/internal/app"
+	"stringinspect/internal/config"
+	"stringinspect/internal/export"
+	"stringinspect/internal/i18n"
+	"stringinspect/internal/recent"
+	"stringinspect/internal/server"
+	"stringinspect/internal/session"
+	"stringinspect/internal/ucd"
 )
 
+// recentLimit is the number of recently opened files remembered.
+const recentLimit = 20
+
+// version is set at build time with -ldflags "-X main.version=v1.2.3";
+// otherwise binaryVersion takes it from the build information.
+var version string
+
 func main() {
 	// Parse command line flags
 	filePath := flag.String("f", "", "Path to file to analyze")
+	templatePath := flag.String("template", "", "Path to a text/template file for the Template export format")
+	printMode := flag.Bool("print", false, "Print the analysis to stdout instead of starting the TUI")
+	noColor := flag.Bool("no-color", false, "Do not color --print output, even on a terminal")
+	importMode := flag.Bool("import", false, "Treat the input file as a previous JSON export and restore it")
+	formatName := flag.String("format", "text", "Output format for --print (text, json, csv, xlsx, svg, go, c, escaped, raw, skeleton, protobuf, template, dump)")
+	toName := flag.String("to", "utf-8", "Target encoding for --format raw (utf-8, utf-8-bom, utf-16le, utf-16le-bom, utf-16be, utf-16be-bom, latin-1, windows-1252, shift-jis)")
+	placeholders := flag.String("placeholders", "", "Display style of non-printable characters (glyphs, pictures, escapes, names); defaults to the config setting")
+	checkName := flag.String("check", "", "Check whether the input survives in a charset (ascii, latin1, cp1252, gsm) and exit 1 if not")
+	clipboardMode := flag.Bool("clipboard", false, "Start with the current clipboard contents as the input")
+	listenAddr := flag.String("listen", "localhost:50051", "Address the gRPC server listens on, for serve")
+	tokenFile := flag.String("token-file", "", "File of bearer tokens serve accepts, one per line; without it calls are not authenticated")
+	rateLimit := flag.Float64("rate", 10, "Calls per second each client may make to serve; 0 for no limit")
+	encodingName := flag.String("encoding", "auto", "Encoding of the input file (auto, utf-8, utf-16le, utf-16be, utf-32le, utf-32be)")
+	dataDir := flag.String("data-dir", "", "Directory of Unicode data files from update-data; defaults to ucd in the config directory")
+	unicodeVersion := flag.String("unicode-version", "latest", "Unicode version update-data downloads (e.g. 16.0.0)")
+	showVersion := flag.Bool("version", false, "Print the version and the Unicode data in use, then exit")
+	debugLog := flag.String("debug", "", "Log key events, analysis timings, and exports of the TUI to this file")
 	flag.Usage = func() {
 		fmt.Fprintf(os.Stderr, "StringInspect - Interactive Character Encoding Analyzer\n\n")
-		fmt.Fprintf(os.Stderr, "Usage: %s [options]\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Usage: %s [options] [file...]\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s [options] diff a.txt b.txt\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s [options] serve\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s [options] explain [text...]\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s [options] normcheck [file|text...]\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s [options] spoofcheck [name...]\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s [options] update-data [file...]\n\n", os.Args[0])
 		fmt.Fprintf(os.Stderr, "Options:\n")
 		flag.PrintDefaults()
 		fmt.Fprintf(os.Stderr, "\nExamples:\n")
 		fmt.Fprintf(os.Stderr, "  %s                    # Start interactive mode\n", os.Args[0])
 		fmt.Fprintf(os.Stderr, "  %s -f file.txt        # Analyze file contents\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s a.txt b.txt        # Open each file in a tab\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s --clipboard        # What did I just copy?\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s diff a.txt b.txt   # Compare two files side by side\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s --print diff a.txt b.txt  # List every difference, for a ticket\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s -template rpt.tmpl  # Enable custom template export\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s --print --format csv file.txt | column -t -s,\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s -f report.json --import  # Reopen a JSON export\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s -f win.txt -encoding utf-16le  # Override the detected encoding\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s --print --format raw --to shift-jis a.txt > sjis.txt  # Re-encode\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s --check gsm sms.txt  # Will this fit in an SMS?\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s explain \"héllo\"    # One line per character, no TUI\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s normcheck locales/*.json  # Where is the text not NFC?\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s spoofcheck \"pаypal\"  # Lookalike letters, as JSON\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s -unicode-version 16.0.0 update-data  # Newer block, name, and lookalike data\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s --debug debug.log big.txt  # Trace a slow session for a bug report\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s -listen :50051 serve  # Serve the analysis over gRPC\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s -listen :50051 -token-file tokens.txt -rate 5 serve  # As a shared service\n", os.Args[0])
 	}
 	flag.Parse()
 
+	// Unicode data files downloaded by update-data replace the built-in
+	// block, name, and confusable tables
+	ucdDir := *dataDir
+	if ucdDir == "" {
+		ucdDir, _ = ucd.DefaultDir()
+	}
+
+	// "update-data" downloads the data files of a newer Unicode version
+	if flag.Arg(0) == "update-data" {
+		if ucdDir == "" {
+			fmt.Fprintln(os.Stderr, "Error: no config directory to keep the data in; use -data-dir")
+			os.Exit(1)
+		}
+		if err := runUpdateData(ucdDir, *unicodeVersion, flag.Args()[1:]); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+	if ucdDir != "" {
+		if _, err := analysis.LoadData(ucdDir); err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading Unicode data: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	if *showVersion {
+		printVersion(os.Stdout)
+		return
+	}
+
+	// Subcommands read files too, so -encoding is checked before any runs
+	if err := checkEncoding(*encodingName); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	// "diff a b" compares two files in the split view, or with --print
+	// reports every difference
+	diffMode := flag.Arg(0) == "diff"
+	if diffMode && (flag.NArg() != 3 || *filePath != "" || *importMode) {
+		fmt.Fprintln(os.Stderr, "Error: diff takes exactly two files and no -f or --import")
+		os.Exit(1)
+	}
+	if diffMode && *printMode {
+		if err := runDiffPrint(flag.Arg(1), flag.Arg(2), *formatName, *encodingName); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	// "serve" runs the gRPC analysis service instead of the TUI
+	if flag.Arg(0) == "serve" {
+		if flag.NArg() != 1 || *filePath != "" {
+			fmt.Fprintln(os.Stderr, "Error: serve takes no files")
+			os.Exit(1)
+		}
+		if err := runServe(*listenAddr, *tokenFile, *rateLimit); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	// "explain" prints a line per character of its arguments, or of stdin
+	if flag.Arg(0) == "explain" {
+		if *filePath != "" {
+			fmt.Fprintln(os.Stderr, "Error: explain takes text, not -f")
+			os.Exit(1)
+		}
+		if err := runExplain(flag.Args()[1:], *placeholders); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	// "normcheck" lists where files or text differ from NFC
+	if flag.Arg(0) == "normcheck" {
+		if *filePath != "" {
+			fmt.Fprintln(os.Stderr, "Error: normcheck takes its files as arguments, not -f")
+			os.Exit(1)
+		}
+		ok, err := runNormcheck(flag.Args()[1:], *encodingName)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if !ok {
+			os.Exit(1)
+		}
+		return
+	}
+
+	// "spoofcheck" vets names for lookalikes, printing JSON
+	if flag.Arg(0) == "spoofcheck" {
+		if *filePath != "" {
+			fmt.Fprintln(os.Stderr, "Error: spoofcheck takes names, not -f")
+			os.Exit(1)
+		}
+		ok, err := runSpoofcheck(flag.Args()[1:])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if !ok {
+			os.Exit(1)
+		}
+		return
+	}
+
+	// Positional arguments are accepted in place of (or after) -f; each
+	// file opens in its own tab
+	files := flag.Args()
+	if diffMode {
+		files = files[1:]
+	}
+	if *filePath != "" {
+		files = append([]string{*filePath}, files...)
+	}
+	if len(files) > 0 {
+		*filePath = files[0]
+	}
+	if len(files) > 1 && (*printMode || *importMode || *checkName != "") {
+		fmt.Fprintln(os.Stderr, "Error: --print, --import, and --check take a single file")
+		os.Exit(1)
+	}
+
+	if *clipboardMode && (len(files) > 0 || diffMode || *printMode || *importMode || *checkName != "") {
+		fmt.Fprintln(os.Stderr, "Error: --clipboard takes no files and no --print, --import, or --check")
+		os.Exit(1)
+	}
+
+	// Preferences are optional; without a config directory nothing is saved
+	cfg, cfgPath, err := loadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading config: %v\n", err)
+		os.Exit(1)
+	}
+	// The TUI speaks the configured language, else that of the locale
+	_ = i18n.SetLocale(i18n.Detect(cfg.Language)) // Detect only returns known locales
+	if *placeholders != "" {
+		if _, err := analysis.ParsePlaceholders(*placeholders); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		cfg.Placeholders = *placeholders
+	}
+
+	if *checkName != "" {
+		ok, err := runCheck(*filePath, *checkName, *encodingName)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if !ok {
+			os.Exit(1)
+		}
+		return
+	}
+
+	if *printMode {
+		if err := runPrint(*filePath, *formatName, *toName, *templatePath, cfg.Placeholders, *encodingName, *importMode, !*noColor); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	// Recently opened files; without a state directory nothing is saved
+	recentFiles, recentPath := loadRecent()
+	if len(files) == 0 && !*importMode && !*clipboardMode && cfg.ReopenLast {
+		if last, ok := recentFiles.Last(); ok {
+			files = []string{last}
+		}
+	}
+
 	// Create the application
 	var a *app.App
-	if *filePath != "" {
-		// Read file contents
-		content, err := os.ReadFile(*filePath)
+	if *filePath != "" && *importMode {
+		content, err := readImport(*filePath)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error reading file: %v\n", err)
 			os.Exit(1)
 		}
-		a = app.NewWithContent(string(content))
+		if utf8.ValidString(content) {
+			a = app.NewWithContent(content)
+		} else {
+			// An export made in byte mode
+			a = app.New()
+			a.LoadImport(*filePath, content)
+		}
+	} else if *clipboardMode {
+		text, err := clipboard.ReadAll()
+		if err == nil && text == "" {
+			err = fmt.Errorf("clipboard is empty")
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading clipboard: %v\n", err)
+			os.Exit(1)
+		}
+		a = app.NewWithContent(text)
 	} else {
 		a = app.New()
 	}
 
-	// Create and run the program
-	p := tea.NewProgram(a, tea.WithAltScreen())
+	if *templatePath != "" {
+		a.SetTemplatePath(*templatePath)
+	}
+
+	a.SetConfig(cfg, cfgPath)
+	a.SetRecent(recentFiles, recentPath)
+	a.SetVersion(binaryVersion())
+
+	// Files are read as bytes so UTF-16 and UTF-32 can be decoded
+	if !*importMode {
+		var sides []app.DiffFile
+		for i, path := range files {
+			data, err := readBytes(path)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error reading file: %v\n", err)
+				os.Exit(1)
+			}
+			det := detectEncoding(data, *encodingName)
+			if diffMode {
+				sides = append(sides, app.DiffFile{Path: path, Data: data, Detection: det})
+			} else {
+				if i > 0 {
+					a.NewTab()
+				}
+				a.LoadSource(path, data, det)
+			}
+			if path != "-" {
+				recentFiles.Add(path, time.Now())
+			}
+		}
+		if diffMode {
+			a.LoadDiff(sides[0], sides[1])
+		}
+		if len(files) > 0 && recentPath != "" {
+			_ = recentFiles.Save(recentPath) // Not worth failing the startup over
+		}
+	}
+
+	// Create and run the program, reading keys through a tap so the key
+	// capture screen can show their raw bytes
+	opts := []tea.ProgramOption{tea.WithAltScreen()}
+	if tap := terminalTap(); tap != nil {
+		a.SetInputTap(tap)
+		opts = append(opts, tea.WithInput(tap))
+	}
+	// The session is autosaved until a normal exit, and one left by an
+	// instance that crashed is offered for restoring
+	sessionPath := ""
+	if dir, err := session.DefaultDir(); err == nil {
+		sessionPath = session.Path(dir, os.Getpid())
+		a.SetSessionPath(sessionPath)
+		if s, path, err := session.Orphaned(dir); err == nil && s != nil && !diffMode {
+			a.OfferRestore(s, path)
+		}
+	}
+
+	// Bubble Tea owns the terminal, so the debug log goes to a file
+	var logFile *os.File
+	if *debugLog != "" {
+		f, err := tea.LogToFile(*debugLog, "stringinspect")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error opening debug log: %v\n", err)
+			os.Exit(1)
+		}
+		logFile = f
+		defer logFile.Close()
+		log.SetFlags(log.LstdFlags | log.Lmicroseconds)
+		a.SetDebug(true)
+	}
+
+	p := tea.NewProgram(a, opts...)
 
 	if _, err := p.Run(); err != nil {
+		// os.Exit skips deferred calls, and the log's last lines are the
+		// ones a crash report needs
+		if logFile != nil {
+			logFile.Close()
+		}
+
+		// Bubble Tea has restored the terminal and printed the trace; the
+		// session file is kept for restoring
+		if errors.Is(err, tea.ErrProgramPanic) {
+			reportCrash(a, sessionPath)
+			os.Exit(2)
+		}
 		fmt.Fprintf(os.Stderr, "Error running application: %v\n", err)
 		os.Exit(1)
 	}
+	if sessionPath != "" {
+		_ = session.Remove(sessionPath) // Nothing to restore after a normal exit
+	}
+}
+
+// reportCrash writes the crash report of a and tells where it is. Reports
+// go to a crashes directory beside the sessions, or the temporary
+// directory when there is no state directory.
+func reportCrash(a *app.App, sessionPath string) {
+	dir := filepath.Join(os.TempDir(), "stringinspect")
+	if sessionPath != "" {
+		dir = filepath.Join(filepath.Dir(filepath.Dir(sessionPath)), "crashes")
+	}
+	fmt.Fprintln(os.Stderr, "StringInspect crashed.")
+	path, err := a.WriteCrashReport(dir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Could not write a crash report: %v\n", err)
+	} else {
+		fmt.Fprintf(os.Stderr, "A diagnostic report was written to %s\n", path)
+	}
+	if _, err := os.Stat(sessionPath); sessionPath != "" && err == nil {
+		fmt.Fprintln(os.Stderr, "Your open tabs were autosaved and will be offered for restoring next time.")
+	}
+}
+
+// terminalTap returns the terminal input, tapped: stdin, or the terminal
+// itself when stdin is a pipe, as Bubble Tea would pick. It returns nil on
+// Windows, where keys are read as console events rather than bytes.
+func terminalTap() *app.InputTap {
+	if runtime.GOOS == "windows" {
+		return nil
+	}
+	if info, err := os.Stdin.Stat(); err == nil && info.Mode()&os.ModeCharDevice != 0 {
+		return app.NewInputTap(os.Stdin)
+	}
+	tty, err := os.Open("/dev/tty")
+	if err != nil {
+		return nil
+	}
+	return app.NewInputTap(tty)
+}
+
+// loadConfig reads the user's preferences. The returned path is empty
+// when the platform has no config directory, so nothing gets saved.
+func loadConfig() (*config.Config, string, error) {
+	path, err := config.DefaultPath()
+	if err != nil {
+		return &config.Config{}, "", nil
+	}
+	cfg, err := config.Load(path)
+	return cfg, path, err
+}
+
+// loadRecent reads the recently opened files. The returned path is empty
+// when the platform has no state directory, so nothing gets saved. An
+// unreadable list starts over empty.
+func loadRecent() (*recent.List, string) {
+	path, err := recent.DefaultPath()
+	if err != nil {
+		return recent.New(recentLimit), ""
+	}
+	list, err := recent.Load(path, recentLimit)
+	if err != nil {
+		return recent.New(recentLimit), path
+	}
+	return list, path
+}
+
+// runPrint analyzes the file (or stdin when no file is given) and writes
+// the export in the requested format to stdout. With color, the text
+// format is colored by character type when stdout is a terminal and
+// NO_COLOR is not set.
+func runPrint(filePath, formatName, toName, templatePath, placeholders, encodingName string, importMode, color bool) error {
+	format, err := export.ParseFormat(formatName)
+	if err != nil {
+		return err
+	}
+	to, err := export.ParseTranscoding(toName)
+	if err != nil {
+		return err
+	}
+	analyzer := analysis.NewAnalyzer()
+	if placeholders != "" {
+		if analyzer.Placeholders, err = analysis.ParsePlaceholders(placeholders); err != nil {
+			return err
+		}
+	}
+
+	var chars []analysis.Character
+	if importMode {
+		content, err := readImport(filePath)
+		if err != nil {
+			return fmt.Errorf("reading input: %w", err)
+		}
+		// Exports made in byte mode import as bytes that are not UTF-8
+		if utf8.ValidString(content) {
+			chars = analyzer.AnalyzeString(content)
+		} else {
+			chars = analyzer.AnalyzeBytes([]byte(content))
+		}
+	} else {
+		data, err := readBytes(filePath)
+		if err != nil {
+			return fmt.Errorf("reading input: %w", err)
+		}
+		// Binary files are analyzed byte by byte, as in the TUI
+		if det := detectEncoding(data, encodingName); det.Binary != "" {
+			chars = analyzer.AnalyzeBytes(data)
+		} else {
+			chars = analyzer.AnalyzeString(analysis.DecodeText(data, det.Encoding))
+		}
+	}
+
+	if len(chars) == 0 {
+		return fmt.Errorf("no characters to export")
+	}
+
+	exporter := export.NewExporter()
+	exporter.TemplatePath = templatePath
+	exporter.Transcoding = to
+	if color {
+		exporter.Renderer = lipgloss.NewRenderer(os.Stdout)
+	}
+	if err := exporter.Write(os.Stdout, chars, format); err != nil {
+		return err
+	}
+
+	// Report what the target encoding could not represent
+	if format == export.FormatRaw {
+		var text strings.Builder
+		for _, c := range chars {
+			text.WriteRune(c.Rune)
+		}
+		if _, losses := export.Transcode(text.String(), to); len(losses) > 0 {
+			fmt.Fprintf(os.Stderr, "Warning: %d characters not in %s were replaced:\n", len(losses), to)
+			for _, l := range losses {
+				fmt.Fprintf(os.Stderr, "  position %d: %c U+%04X %s → %s\n", l.Position, l.Char, l.Char, analysis.Name(l.Char), l.Suggestion)
+			}
+		}
+	}
+	return nil
+}
+
+// runDiffPrint writes the differences between two files to stdout as a
+// text report or JSON.
+func runDiffPrint(pathA, pathB, formatName, encodingName string) error {
+	format, err := export.ParseFormat(formatName)
+	if err != nil {
+		return err
+	}
+	var sides []export.DiffSide
+	for _, path := range []string{pathA, pathB} {
+		data, err := readBytes(path)
+		if err != nil {
+			return fmt.Errorf("reading input: %w", err)
+		}
+		det := detectEncoding(data, encodingName)
+		encoding := det.Encoding.String()
+		if det.BOM {
+			encoding += " with BOM"
+		}
+		sides = append(sides, export.DiffSide{Name: path, Encoding: encoding, Text: analysis.DecodeText(data, det.Encoding)})
+	}
+	return export.WriteDiff(os.Stdout, sides[0], sides[1], format)
+}
+
+// runServe serves the gRPC analysis service on addr until interrupted,
+// letting calls in progress finish. Calls need one of the tokens in
+// tokenFile, when given, and each client is limited to rate calls per
+// second.
+func runServe(addr, tokenFile string, rate float64) error {
+	access := server.Access{Rate: rate}
+	if tokenFile != "" {
+		tokens, err := server.LoadTokens(tokenFile)
+		if err != nil {
+			return fmt.Errorf("reading tokens: %w", err)
+		}
+		access.Tokens = tokens
+	}
+
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	g := server.NewGRPCServer(access.ServerOptions()...)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		g.GracefulStop()
+	}()
+
+	fmt.Fprintf(os.Stderr, "Serving stringinspect.v1.AnalysisService on %s\n", lis.Addr())
+	if tcp, ok := lis.Addr().(*net.TCPAddr); ok && !tcp.IP.IsLoopback() && len(access.Tokens) == 0 {
+		fmt.Fprintln(os.Stderr, "Warning: reachable from other machines without authentication; use -token-file")
+	}
+	return g.Serve(lis)
+}
+
+// binaryVersion returns the version of the binary: the one set at build
+// time, that of the module when installed with go install, or the VCS
+// revision it was built from.
+func binaryVersion() string {
+	if version != "" {
+		return version
+	}
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "unknown"
+	}
+	if v := info.Main.Version; v != "" && v != "(devel)" {
+		return v
+	}
+	var revision string
+	var modified bool
+	for _, s := range info.Settings {
+		switch s.Key {
+		case "vcs.revision":
+			revision = s.Value
+		case "vcs.modified":
+			modified = s.Value == "true"
+		}
+	}
+	if revision == "" {
+		return "devel"
+	}
+	v := "devel-" + revision[:min(len(revision), 12)]
+	if modified {
+		v += "-dirty"
+	}
+	return v
+}
+
+// printVersion prints the version of the binary and where each kind of
+// character data comes from, with its Unicode version.
+func printVersion(w io.Writer) {
+	fmt.Fprintf(w, "stringinspect %s (%s %s/%s)\n\n", binaryVersion(), runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, src := range analysis.DataSources() {
+		unicodeVersion := "Unicode " + src.Unicode
+		if src.Unicode == "" {
+			unicodeVersion = "Unicode ?"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", src.Data, unicodeVersion, src.Source)
+	}
+	tw.Flush()
+}
+
+// runUpdateData downloads the named Unicode data files (all of them by
+// default) of a Unicode version into dir, then reads them back to check
+// that they parse.
+func runUpdateData(dir, version string, names []string) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	client := &http.Client{Timeout: 2 * time.Minute}
+	if err := ucd.Download(ctx, client, ucd.BaseURL, version, dir, names); err != nil {
+		return err
+	}
+	loaded, err := analysis.LoadData(dir)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Updated %s in %s (blocks from Unicode %s)\n", strings.Join(loaded, ", "), dir, analysis.DataVersion())
+	return nil
+}
+
+// runExplain prints a compact breakdown of text, one line per character
+// with its codepoint, UTF-8 bytes, name, and warnings, colored as in the
+// TUI when stdout is a terminal. Without arguments stdin is read.
+func runExplain(args []string, placeholders string) error {
+	text := strings.Join(args, " ")
+	if len(args) == 0 {
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return fmt.Errorf("reading input: %w", err)
+		}
+		text = string(data)
+	}
+	analyzer := analysis.NewAnalyzer()
+	if placeholders != "" {
+		var err error
+		if analyzer.Placeholders, err = analysis.ParsePlaceholders(placeholders); err != nil {
+			return err
+		}
+	}
+	chars := analyzer.AnalyzeString(text)
+	if len(chars) == 0 {
+		return fmt.Errorf("no characters to explain")
+	}
+
+	styles := app.DefaultStyles()
+	flagged := 0
+	for _, c := range chars {
+		char := c.Char + strings.Repeat(" ", max(7-lipgloss.Width(c.Char), 1))
+		line := fmt.Sprintf("%4d  %s%s  %s  %s",
+			c.RuneOffset,
+			styles.CharStyle(int(c.Type)).Render(char),
+			styles.Title.Render(fmt.Sprintf("%-8s", c.Unicode)),
+			styles.Muted.Render(fmt.Sprintf("%-11s", c.UTF8Hex)),
+			analysis.Name(c.Rune))
+		if warnings := c.Warnings(); len(warnings) > 0 {
+			flagged++
+			names := make([]string, len(warnings))
+			for i, w := range warnings {
+				names[i] = w.String()
+			}
+			line += "  " + styles.Error.Render("⚠ "+strings.Join(names, ", "))
+		}
+		fmt.Println(line)
+	}
+
+	summary := fmt.Sprintf("%d characters · %d bytes", len(chars), len(text))
+	if flagged > 0 {
+		summary += fmt.Sprintf(" · %d with warnings", flagged)
+	}
+	fmt.Println(styles.Muted.Render(summary))
+	return nil
+}
+
+// runNormcheck reports every place the arguments differ from their NFC
+// form, with the characters involved, and whether none do. An argument
+// naming a file checks the file; any other is checked as text. Without
+// arguments stdin is checked.
+func runNormcheck(args []string, encodingName string) (bool, error) {
+	if len(args) == 0 {
+		args = []string{"-"}
+	}
+	allOK := true
+	for i, arg := range args {
+		label, text := arg, arg
+		if info, err := os.Stat(arg); arg == "-" || (err == nil && info.Mode().IsRegular()) {
+			data, err := readBytes(arg)
+			if err != nil {
+				return false, fmt.Errorf("reading %s: %w", arg, err)
+			}
+			text = analysis.DecodeText(data, detectEncoding(data, encodingName).Encoding)
+			if arg == "-" {
+				label = "stdin"
+			}
+		} else {
+			label = fmt.Sprintf("argument %d", i+1)
+		}
+
+		diffs := analysis.NormDiffs(text, norm.NFC)
+		if len(diffs) == 0 {
+			fmt.Printf("✓ %s is in NFC\n", label)
+			continue
+		}
+		allOK = false
+		plural := "s"
+		if len(diffs) == 1 {
+			plural = ""
+		}
+		fmt.Printf("✗ %s differs from NFC in %d place%s\n", label, len(diffs), plural)
+		for _, d := range diffs {
+			fmt.Printf("  %s:%d:%d: %s %q → %s %q\n", label, d.Line, d.Column, codepoints(d.Original), d.Original, codepoints(d.Normalized), d.Normalized)
+		}
+	}
+	return allOK, nil
+}
+
+// runSpoofcheck checks each argument, or each line of stdin without
+// arguments, for mixed scripts, lookalike characters, bidi controls, and
+// invisible characters. It prints one JSON object per name and reports
+// whether none were suspicious.
+func runSpoofcheck(args []string) (bool, error) {
+	names := args
+	if len(names) == 0 {
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return false, fmt.Errorf("reading input: %w", err)
+		}
+		names = strings.Split(strings.TrimSuffix(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n"), "\n")
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetEscapeHTML(false)
+	allOK := true
+	for _, name := range names {
+		report := analysis.SpoofCheck(name)
+		if report.Suspicious() {
+			allOK = false
+		}
+		if err := enc.Encode(export.NewJSONSpoofReport(name, report)); err != nil {
+			return false, err
+		}
+	}
+	return allOK, nil
+}
+
+// codepoints lists the codepoints of s, e.g. "U+0065 U+0301".
+func codepoints(s string) string {
+	var cps []string
+	for _, r := range s {
+		cps = append(cps, fmt.Sprintf("U+%04X", r))
+	}
+	return strings.Join(cps, " ")
+}
+
+// runCheck reports whether the file (or stdin) survives in the named
+// charset, listing every character that does not.
+func runCheck(filePath, charsetName, encodingName string) (bool, error) {
+	cs, err := analysis.ParseCharset(charsetName)
+	if err != nil {
+		return false, err
+	}
+	data, err := readBytes(filePath)
+	if err != nil {
+		return false, fmt.Errorf("reading input: %w", err)
+	}
+	text := analysis.DecodeText(data, detectEncoding(data, encodingName).Encoding)
+	chars := analysis.Analyze(text)
+
+	failures := cs.Incompatible(chars)
+	if len(failures) == 0 {
+		fmt.Printf("✓ All %d characters survive in %s\n", len(chars), cs.Name)
+	} else {
+		fmt.Printf("✗ %d of %d characters do not survive in %s\n", len(failures), len(chars), cs.Name)
+	}
+	if cs.Name == "GSM 03.38" {
+		fmt.Printf("SMS %s\n", analysis.SMSLengthOf(text))
+	}
+	for _, i := range failures {
+		c := chars[i]
+		fmt.Printf("  position %d: %s %s %s\n", c.RuneOffset, c.Char, c.Unicode, analysis.Name(c.Rune))
+	}
+	return len(failures) == 0, nil
+}
+
+// openInput opens filePath, or stdin for "" and "-".
+func openInput(filePath string) (io.ReadCloser, error) {
+	if filePath == "" || filePath == "-" {
+		return io.NopCloser(os.Stdin), nil
+	}
+	return os.Open(filePath)
+}
+
+// readBytes returns the raw contents of filePath, or of stdin for "" and
+// "-".
+func readBytes(filePath string) ([]byte, error) {
+	r, err := openInput(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+// readImport parses filePath (or stdin) as a JSON export and reconstructs
+// the original string from it.
+func readImport(filePath string) (string, error) {
+	r, err := openInput(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+	return export.Import(r)
+}
+
+// checkEncoding validates the -encoding flag: "auto" or an encoding name.
+func checkEncoding(encodingName string) error {
+	if encodingName == "auto" {
+		return nil
+	}
+	_, err := analysis.ParseTextEncoding(encodingName)
+	return err
+}
+
+// detectEncoding guesses the encoding of data, unless encodingName names
+// one explicitly. The name must already have been checked by
+// checkEncoding.
+func detectEncoding(data []byte, encodingName string) analysis.Detection {
+	if encodingName == "auto" {
+		return analysis.DetectEncoding(data)
+	}
+	e, _ := analysis.ParseTextEncoding(encodingName)
+	return analysis.Detection{Encoding: e, Reason: "-encoding flag"}
 }
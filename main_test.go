@@ -0,0 +1,16 @@
+package main
+
+import "testing"
+
+func TestCheckEncoding(t *testing.T) {
+	for _, name := range []string{"auto", "utf-8", "UTF-16LE", "utf32be"} {
+		if err := checkEncoding(name); err != nil {
+			t.Errorf("checkEncoding(%q) = %v, want nil", name, err)
+		}
+	}
+	for _, name := range []string{"bogus", "", "latin-1"} {
+		if err := checkEncoding(name); err == nil {
+			t.Errorf("checkEncoding(%q) = nil, want an error", name)
+		}
+	}
+}